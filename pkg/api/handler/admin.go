@@ -160,7 +160,7 @@ func (i *AdminHandler) NewPaymentMethod(c *gin.Context) {
 		return
 	}
 
-	err := i.adminUseCase.NewPaymentMethod(method.PaymentMethod)
+	err := i.adminUseCase.NewPaymentMethod(method)
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not add the payment method", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
@@ -212,7 +212,8 @@ func (a *AdminHandler) ValidateRefreshTokenAndCreateNewAccess(c *gin.Context) {
 	refreshToken := c.Request.Header.Get("RefreshToken")
 
 	// Check if the refresh token is valid.
-	_, err := jwt.Parse(refreshToken, func(token *jwt.Token) (interface{}, error) {
+	refreshClaims := &helper.AuthCustomClaims{}
+	_, err := jwt.ParseWithClaims(refreshToken, refreshClaims, func(token *jwt.Token) (interface{}, error) {
 		return []byte("refreshsecret"), nil
 	})
 	if err != nil {
@@ -221,8 +222,11 @@ func (a *AdminHandler) ValidateRefreshTokenAndCreateNewAccess(c *gin.Context) {
 		return
 	}
 
+	// the admin id is carried over, the two factor routes need it
 	claims := &helper.AuthCustomClaims{
-		Role: "admin",
+		Id:    refreshClaims.Id,
+		Email: refreshClaims.Email,
+		Role:  "admin",
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(time.Minute).Unix(),
 			IssuedAt:  time.Now().Unix(),
@@ -237,3 +241,202 @@ func (a *AdminHandler) ValidateRefreshTokenAndCreateNewAccess(c *gin.Context) {
 
 	c.JSON(200, newAccessToken)
 }
+
+// @Summary		Admin Two Factor Login
+// @Description	admin completes the login with a code from the authenticator app or a recovery code, using the two factor token from login
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Param			code	body		models.TwoFactorCode	true	"code"
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/adminlogin/2fa [post]
+func (ad *AdminHandler) VerifyTwoFactorLogin(c *gin.Context) {
+
+	var code models.TwoFactorCode
+	if err := c.BindJSON(&code); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	admin, err := ad.adminUseCase.VerifyTwoFactorLogin(c.GetInt("id"), code.Code)
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusUnauthorized, "cannot authenticate user", nil, err.Error())
+		c.JSON(http.StatusUnauthorized, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Admin authenticated successfully", admin, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Enroll Two Factor Authentication
+// @Description	admin gets a new totp secret and the provisioning uri to show as a QR code in the authenticator app
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa/enroll [post]
+func (ad *AdminHandler) EnrollTwoFactor(c *gin.Context) {
+
+	enrollment, err := ad.adminUseCase.EnrollTwoFactor(c.GetInt("id"))
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "could not enroll two factor authentication", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Scan the QR code and confirm with a code", enrollment, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Confirm Two Factor Authentication
+// @Description	admin confirms the enrollment with the first code, 2FA gets enabled and the recovery codes are shown once
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Param			code	body		models.TwoFactorCode	true	"code"
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa/confirm [post]
+func (ad *AdminHandler) ConfirmTwoFactor(c *gin.Context) {
+
+	var code models.TwoFactorCode
+	if err := c.BindJSON(&code); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	enabled, err := ad.adminUseCase.ConfirmTwoFactor(c.GetInt("id"), code.Code)
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "could not enable two factor authentication", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Two factor authentication enabled, store the recovery codes safely", enabled, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Regenerate Recovery Codes
+// @Description	admin gets a new set of recovery codes, the old ones stop working
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Param			code	body		models.TwoFactorCode	true	"code"
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa/recovery-codes [post]
+func (ad *AdminHandler) RegenerateRecoveryCodes(c *gin.Context) {
+
+	var code models.TwoFactorCode
+	if err := c.BindJSON(&code); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	codes, err := ad.adminUseCase.RegenerateRecoveryCodes(c.GetInt("id"), code.Code)
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "could not create recovery codes", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully created new recovery codes", codes, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Disable Two Factor Authentication
+// @Description	admin can turn off 2FA when it is not mandatory
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Param			code	body		models.TwoFactorCode	true	"code"
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa [delete]
+func (ad *AdminHandler) DisableTwoFactor(c *gin.Context) {
+
+	var code models.TwoFactorCode
+	if err := c.BindJSON(&code); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	if err := ad.adminUseCase.DisableTwoFactor(c.GetInt("id"), code.Code); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "could not disable two factor authentication", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully disabled two factor authentication", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Two Factor Policy
+// @Description	admin can see whether 2FA is mandatory for admins
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa/policy [get]
+func (ad *AdminHandler) GetTwoFactorPolicy(c *gin.Context) {
+
+	policy, err := ad.adminUseCase.GetTwoFactorPolicy()
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusInternalServerError, "could not get the policy", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the policy", policy, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Update Two Factor Policy
+// @Description	admin can make 2FA mandatory, admins without it have to enroll on their next login
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		Bearer
+// @Param			policy	body		models.TwoFactorPolicy	true	"policy"
+// @Success		200		{object}	response.Response{}
+// @Failure		500		{object}	response.Response{}
+// @Router			/admin/2fa/policy [put]
+func (ad *AdminHandler) UpdateTwoFactorPolicy(c *gin.Context) {
+
+	var policy models.TwoFactorPolicy
+	if err := c.BindJSON(&policy); err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	if err := ad.adminUseCase.UpdateTwoFactorPolicy(policy); err != nil {
+		errRes := response.ClientResponse(http.StatusInternalServerError, "could not update the policy", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully updated the policy", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AlertHandler struct {
+	usecase services.AlertUseCase
+}
+
+func NewAlertHandler(use services.AlertUseCase) *AlertHandler {
+	return &AlertHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Get Alerts
+// @Description	user can see their back in stock and price drop alerts, wishlisted products are watched automatically
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/alerts [get]
+func (a *AlertHandler) GetAlerts(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	alerts, err := a.usecase.GetAlerts(userID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve alerts", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all alerts", alerts, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Subscribe Alert
+// @Description	user can get notified when an out of stock product is back or when a product gets cheaper
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			alert	body	models.AddProductAlert	true	"alert"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/alerts [post]
+func (a *AlertHandler) Subscribe(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var alert models.AddProductAlert
+	if err := c.BindJSON(&alert); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(alert); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := a.usecase.Subscribe(userID, alert)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the alert", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added the alert", gin.H{"alert_id": id}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Unsubscribe Alert
+// @Description	user can remove an alert
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"alert id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/alerts/{id} [delete]
+func (a *AlertHandler) Unsubscribe(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := a.usecase.Unsubscribe(userID, id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not remove the alert", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully removed the alert", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
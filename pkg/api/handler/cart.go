@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
 	"jerseyhub/pkg/utils/response"
@@ -8,12 +9,29 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 )
 
+// visitors who have not logged in send the token of their cart in this header
+const cartTokenHeader = "X-Cart-Token"
+
 type CartHandler struct {
 	usecase services.CartUseCase
 }
 
+// mergeGuestCart is called after a login went through, a cart that could not be merged does not fail the login
+func mergeGuestCart(c *gin.Context, cartUseCase services.CartUseCase, userID int) {
+
+	token := c.GetHeader(cartTokenHeader)
+	if token == "" {
+		return
+	}
+
+	if err := cartUseCase.MergeGuestCart(userID, token); err != nil {
+		fmt.Println("could not merge the guest cart of user", userID, ":", err)
+	}
+}
+
 func NewCartHandler(usecase services.CartUseCase) *CartHandler {
 	return &CartHandler{
 		usecase: usecase,
@@ -49,12 +67,152 @@ func (i *CartHandler) AddToCart(c *gin.Context) {
 
 }
 
+// @Summary		Get Cart
+// @Description	user can view their cart, lines whose price, offer or stock changed since they were added are flagged
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/cart [get]
+func (i *CartHandler) GetCart(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	products, err := i.usecase.GetCart(userID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all products in cart", products, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Set Quantity
+// @Description	user can set how many of a product they want, a quantity of 0 takes it out of the cart
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"inventory id"
+// @Param			quantity	body	models.SetCartQuantity	true	"quantity"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/cart/items/{id} [put]
+func (i *CartHandler) SetQuantity(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	inventoryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "check path parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var quantity models.SetCartQuantity
+	if err := c.BindJSON(&quantity); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(quantity); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	cart, err := i.usecase.SetQuantity(userID, inventoryID, quantity.Quantity)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not set the quantity", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully set the quantity", cart, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add quantity in cart by one
+// @Description	user can add 1 quantity of product to their cart, PUT /users/cart/items/{id} sets any quantity
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			inventory	query	string	true	"inv_id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/cart/updateQuantity/plus [put]
+func (i *CartHandler) UpdateQuantityAdd(c *gin.Context) {
+	i.changeQuantity(c, 1)
+}
+
+// @Summary		Subtract quantity in cart by one
+// @Description	user can subtract 1 quantity of product from their cart, PUT /users/cart/items/{id} sets any quantity
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			inventory	query	string	true	"inv_id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/cart/updateQuantity/minus [put]
+func (i *CartHandler) UpdateQuantityLess(c *gin.Context) {
+	i.changeQuantity(c, -1)
+}
+
+// changeQuantity serves the old plus and minus endpoints. They took the cart id as id, the cart of the
+// logged in user is used instead so it is ignored
+func (i *CartHandler) changeQuantity(c *gin.Context, change int) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	inv, err := strconv.Atoi(c.Query("inventory"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "check parameters properly", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	cart, err := i.usecase.ChangeQuantity(userID, inv, change)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not change the quantity", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully changed the quantity", cart, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
 // @Summary		Checkout section
 // @Description	Add products to carts  for the purchase
 // @Tags			User
 // @Accept			json
 // @Produce		    json
 // @Param			id	query	string	true	"id"
+// @Param			address_id	query	string	false	"address id"
 // @Security		Bearer
 // @Success		200	{object}	response.Response{}
 // @Failure		500	{object}	response.Response{}
@@ -67,7 +225,18 @@ func (i *CartHandler) CheckOut(c *gin.Context) {
 		return
 	}
 
-	products, err := i.usecase.CheckOut(id)
+	// address_id is optional, the default address is used without it
+	var addressID int
+	if c.Query("address_id") != "" {
+		addressID, err = strconv.Atoi(c.Query("address_id"))
+		if err != nil {
+			errorRes := response.ClientResponse(http.StatusBadRequest, "address_id not in right format", nil, err.Error())
+			c.JSON(http.StatusBadRequest, errorRes)
+			return
+		}
+	}
+
+	products, err := i.usecase.CheckOut(id, addressID)
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "could not open checkout", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
@@ -76,3 +245,171 @@ func (i *CartHandler) CheckOut(c *gin.Context) {
 	successRes := response.ClientResponse(http.StatusOK, "Successfully got all records", products, nil)
 	c.JSON(http.StatusOK, successRes)
 }
+
+// @Summary		Abandoned Carts
+// @Description	admin can see the carts nobody touched for a while with their value, and how the reminders did over the last days
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			idle_hours	query	string	false	"hours since the cart was last touched, defaults to the first reminder"
+// @Param			days	query	string	false	"days the reminder numbers cover, defaults to 30"
+// @Param			page	query	string	false	"page number"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/carts/abandoned [get]
+func (i *CartHandler) GetAbandonedCarts(c *gin.Context) {
+
+	idleHours, err := strconv.Atoi(c.DefaultQuery("idle_hours", "0"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "idle hours not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "days not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	report, err := i.usecase.GetAbandonedCarts(idleHours, days, page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve abandoned carts", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the abandoned carts", report, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Guest Cart
+// @Description	visitors who have not logged in can see their cart by the token they got when adding to it
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			X-Cart-Token	header	string	true	"cart token"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/guest-cart [get]
+func (i *CartHandler) GetGuestCart(c *gin.Context) {
+
+	cart, err := i.usecase.GetGuestCart(c.GetHeader(cartTokenHeader))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the cart", cart, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add To Guest Cart
+// @Description	visitors who have not logged in can add to a cart, the cart token that comes back has to be sent with the next requests and on login
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			X-Cart-Token	header	string	false	"cart token, a new cart is started without it"
+// @Param			cart	body	models.AddToGuestCart	true	"Add To Cart"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/guest-cart/items [post]
+func (i *CartHandler) AddToGuestCart(c *gin.Context) {
+
+	var item models.AddToGuestCart
+	if err := c.BindJSON(&item); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(item); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	cart, err := i.usecase.AddToGuestCart(c.GetHeader(cartTokenHeader), item)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not add the Cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added To cart", cart, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Remove From Guest Cart
+// @Description	visitors who have not logged in can take a product out of their cart
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			X-Cart-Token	header	string	true	"cart token"
+// @Param			id	path	string	true	"inventory id"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/guest-cart/items/{id} [delete]
+func (i *CartHandler) RemoveFromGuestCart(c *gin.Context) {
+
+	inventoryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "check path parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	cart, err := i.usecase.RemoveFromGuestCart(c.GetHeader(cartTokenHeader), inventoryID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not remove from the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully removed from cart", cart, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Merge Guest Cart
+// @Description	takes a guest cart into the cart of the logged in user, for logins that could not send the cart token along such as google
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			X-Cart-Token	header	string	true	"cart token"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/cart/merge [post]
+func (i *CartHandler) MergeGuestCart(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.usecase.MergeGuestCart(userID, c.GetHeader(cartTokenHeader)); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not merge the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully merged the cart", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
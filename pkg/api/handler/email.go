@@ -0,0 +1,72 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailHandler struct {
+	usecase services.EmailUseCase
+}
+
+func NewEmailHandler(use services.EmailUseCase) *EmailHandler {
+	return &EmailHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Verify Email
+// @Description	user can verify their email using the link sent to their email
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			token	query	string	true	"token"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/verify-email [get]
+func (e *EmailHandler) VerifyEmail(c *gin.Context) {
+
+	token := c.Query("token")
+
+	if err := e.usecase.VerifyEmail(token); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not verify the email", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully verified the email", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Resend Verification Email
+// @Description	user can ask for a new email verification link
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/verify-email [post]
+func (e *EmailHandler) ResendVerificationEmail(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := e.usecase.SendVerificationEmail(userID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not send verification email", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Verification email sent successfully", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
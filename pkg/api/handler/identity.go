@@ -0,0 +1,71 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IdentityHandler struct {
+	usecase services.IdentityUseCase
+}
+
+func NewIdentityHandler(use services.IdentityUseCase) *IdentityHandler {
+	return &IdentityHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Google Login
+// @Description	user gets the google sign in url, the browser should be sent there
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/login/google [get]
+func (i *IdentityHandler) GoogleLogin(c *gin.Context) {
+
+	url, err := i.usecase.GoogleLoginURL()
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusInternalServerError, "could not start google login", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully created google login url", url, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Google Login Callback
+// @Description	google redirects the user here after sign in, the user gets logged in or signed up
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			code	query	string	true	"code"
+// @Param			state	query	string	true	"state"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/login/google/callback [get]
+func (i *IdentityHandler) GoogleCallback(c *gin.Context) {
+
+	if errMsg := c.Query("error"); errMsg != "" {
+		errRes := response.ClientResponse(http.StatusBadRequest, "User could not be logged in", nil, errMsg)
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	user_details, err := i.usecase.GoogleCallback(c.Query("code"), c.Query("state"))
+	if err != nil {
+		errRes := response.ClientResponse(http.StatusBadRequest, "User could not be logged in", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "User successfully logged in", user_details, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -144,11 +144,12 @@ func (i *InventoryHandler) DeleteInventory(c *gin.Context) {
 }
 
 // @Summary		Show Product Details
-// @Description	user can view the details of the product
+// @Description	user can view the details of the product along with the questions asked about it, 5 in a page
 // @Tags			User
 // @Accept			json
 // @Produce		    json
 // @Param			id	query	string	true	"id"
+// @Param			qa_page	query	string	false	"page of questions"
 // @Security		Bearer
 // @Success		200	{object}	response.Response{}
 // @Failure		500	{object}	response.Response{}
@@ -156,7 +157,14 @@ func (i *InventoryHandler) DeleteInventory(c *gin.Context) {
 func (i *InventoryHandler) ShowIndividualProducts(c *gin.Context) {
 
 	id := c.Query("id")
-	product, err := i.InventoryUseCase.ShowIndividualProducts(id)
+	questionPage, err := strconv.Atoi(c.DefaultQuery("qa_page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	product, err := i.InventoryUseCase.ShowIndividualProducts(id, questionPage)
 
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "path variables in wrong format", nil, err.Error())
@@ -170,11 +178,12 @@ func (i *InventoryHandler) ShowIndividualProducts(c *gin.Context) {
 }
 
 // @Summary		List Products
-// @Description	user can view the list of available products
+// @Description	user can view the list of available products along with their ratings
 // @Tags			User
 // @Accept			json
 // @Produce		    json
 // @Param			page	query  string 	true	"page"
+// @Param			sort	query  string 	false	"rating to list the best rated first"
 // @Security		Bearer
 // @Success		200	{object}	response.Response{}
 // @Failure		500	{object}	response.Response{}
@@ -198,7 +207,7 @@ func (i *InventoryHandler) ListProductsForUser(c *gin.Context) {
 		return
 	}
 
-	products, err := i.InventoryUseCase.ListProductsForUser(page, userID)
+	products, err := i.InventoryUseCase.ListProductsForUser(page, userID, c.Query("sort"))
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type InvoiceHandler struct {
+	usecase services.InvoiceUseCase
+}
+
+func NewInvoiceHandler(use services.InvoiceUseCase) *InvoiceHandler {
+	return &InvoiceHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Get Category Taxes
+// @Description	admin can see the HSN code and GST rate of every category
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/category/tax [get]
+func (i *InvoiceHandler) GetCategoryTaxes(c *gin.Context) {
+
+	taxes, err := i.usecase.GetCategoryTaxes()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the category taxes", taxes, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Set Category Tax
+// @Description	admin can set the HSN code and GST rate of a category, orders placed after this are taxed with it
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			tax	body	models.CategoryTax	true	"tax"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/category/tax [put]
+func (i *InvoiceHandler) SetCategoryTax(c *gin.Context) {
+
+	var tax models.CategoryTax
+	if err := c.BindJSON(&tax); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(tax); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.usecase.SetCategoryTax(tax); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not update the category tax", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully updated the category tax", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Download Invoice
+// @Description	user can download the GST invoice of an order as pdf or html once it is paid for or delivered, canceled and returned units are left out
+// @Tags			User
+// @Accept			json
+// @Produce		    application/pdf
+// @Param			id	path	string	true	"order id"
+// @Param			format	query	string	false	"pdf or html, pdf by default"
+// @Security		Bearer
+// @Success		200	{file}	file
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/orders/{id}/invoice [get]
+func (i *InvoiceHandler) DownloadInvoice(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	i.sendInvoice(c, userID)
+
+}
+
+// @Summary		Download Invoice
+// @Description	admin can download the GST invoice of any order as pdf or html once it is paid for or delivered, canceled and returned units are left out
+// @Tags			Admin
+// @Accept			json
+// @Produce		    application/pdf
+// @Param			id	path	string	true	"order id"
+// @Param			format	query	string	false	"pdf or html, pdf by default"
+// @Security		Bearer
+// @Success		200	{file}	file
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/orders/{id}/invoice [get]
+func (i *InvoiceHandler) AdminDownloadInvoice(c *gin.Context) {
+
+	i.sendInvoice(c, 0)
+
+}
+
+func (i *InvoiceHandler) sendInvoice(c *gin.Context, userID int) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	document, err := i.usecase.GetInvoiceDocument(id, userID, c.Query("format"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not generate the invoice", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+document.FileName+`"`)
+	c.Data(http.StatusOK, document.ContentType, document.Content)
+
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobHandler struct {
+	usecase services.JobUseCase
+}
+
+func NewJobHandler(use services.JobUseCase) *JobHandler {
+	return &JobHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Get Jobs
+// @Description	admin can see the background jobs latest first, optionally only the ones in a status
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			status	query	string	false	"QUEUED, RUNNING, DONE or DEAD"
+// @Param			page	query	string	false	"page number"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/jobs [get]
+func (j *JobHandler) GetJobs(c *gin.Context) {
+
+	status := c.Query("status")
+	switch status {
+	case "", "QUEUED", "RUNNING", "DONE", "DEAD":
+	default:
+		errorRes := response.ClientResponse(http.StatusBadRequest, "status should be QUEUED, RUNNING, DONE or DEAD", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	jobs, err := j.usecase.GetJobs(status, page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve jobs", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the jobs", jobs, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Job Status
+// @Description	admin can see how many jobs of each kind are in each status and when the scheduled ones run next
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/jobs/status [get]
+func (j *JobHandler) GetJobStatus(c *gin.Context) {
+
+	status, err := j.usecase.GetJobStatus()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve job status", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the job status", status, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Retry Job
+// @Description	admin can queue a dead job again with a fresh set of attempts
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"job id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/jobs/{id}/retry [post]
+func (j *JobHandler) RetryJob(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := j.usecase.RetryJob(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retry the job", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully queued the job again", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type NotificationHandler struct {
+	usecase services.NotificationUseCase
+}
+
+func NewNotificationHandler(use services.NotificationUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Get Notifications
+// @Description	user can see their notifications 20 at a time, latest first
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			page	query	string	false	"page number"
+// @Param			unread	query	string	false	"only unread notifications when true"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications [get]
+func (n *NotificationHandler) GetNotifications(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	notifications, err := n.usecase.GetNotifications(userID, page, c.Query("unread") == "true")
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve notifications", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all notifications", notifications, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Unread Notification Count
+// @Description	user can see how many notifications they have not read
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications/unread-count [get]
+func (n *NotificationHandler) GetUnreadCount(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	count, err := n.usecase.GetUnreadCount(userID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not count the notifications", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the unread count", gin.H{"unread": count}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Mark Notification Read
+// @Description	user can mark a notification as read
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"notification id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications/{id}/read [put]
+func (n *NotificationHandler) MarkRead(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := n.usecase.MarkRead(userID, id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not mark the notification as read", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully marked the notification as read", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Mark All Notifications Read
+// @Description	user can mark all their notifications as read
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications/read-all [put]
+func (n *NotificationHandler) MarkAllRead(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := n.usecase.MarkAllRead(userID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not mark the notifications as read", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully marked all notifications as read", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Notification Preferences
+// @Description	user can see which notification categories are on and which of them also come by mail
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications/preferences [get]
+func (n *NotificationHandler) GetPreferences(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	preferences, err := n.usecase.GetPreferences(userID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve preferences", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the preferences", preferences, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Set Notification Preference
+// @Description	user can switch a notification category off, or keep it in the app only
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			preference	body	models.NotificationPreference	true	"preference"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/notifications/preferences [put]
+func (n *NotificationHandler) SetPreference(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var preference models.NotificationPreference
+	if err := c.BindJSON(&preference); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(preference); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := n.usecase.SetPreference(userID, preference); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not save the preference", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully saved the preference", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
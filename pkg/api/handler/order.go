@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 )
 
 type OrderHandler struct {
@@ -89,13 +90,20 @@ func (i *OrderHandler) OrderItemsFromCart(c *gin.Context) {
 // @Router			/users/profile/orders [delete]
 func (i *OrderHandler) CancelOrder(c *gin.Context) {
 
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
 	id, err := strconv.Atoi(c.Query("id"))
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "coonversion to integer not possible", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
 		return
 	}
-	if err := i.orderUseCase.CancelOrder(id); err != nil {
+	if err := i.orderUseCase.CancelOrder(userID, id); err != nil {
 		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
 		return
@@ -158,34 +166,68 @@ func (i *OrderHandler) AdminOrders(c *gin.Context) {
 	c.JSON(http.StatusOK, successRes)
 }
 
-// @Summary		Return Order
-// @Description	user can return the ordered products which is already delivered and then get the amount fot that particular purchase back in their wallet
+// @Summary		Cancel Order Items
+// @Description	user can cancel some of the items in an order before they are shipped, prepaid orders get the amount for them back in the wallet
 // @Tags			User
 // @Accept			json
 // @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Param			items	body	models.OrderItemsRequest	true	"items and quantities"
 // @Security		Bearer
 // @Success		200	{object}	response.Response{}
 // @Failure		500	{object}	response.Response{}
-// @Router			/users/profile/orders/return [put]
-func (i *OrderHandler) ReturnOrder(c *gin.Context) {
+// @Router			/users/profile/orders/{id}/cancel-items [post]
+func (i *OrderHandler) CancelOrderItems(c *gin.Context) {
 
-	id, err := strconv.Atoi(c.Query("id"))
-	if err != nil {
-		errorRes := response.ClientResponse(http.StatusBadRequest, "conversion to integer not possible", nil, err.Error())
-		c.JSON(http.StatusBadRequest, errorRes)
+	userID, orderID, request, ok := orderItemsRequest(c)
+	if !ok {
 		return
 	}
-	if err := i.orderUseCase.ReturnOrder(id); err != nil {
-		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+
+	if err := i.orderUseCase.CancelOrderItems(userID, orderID, request.Items); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not cancel the items", nil, err.Error())
 		c.JSON(http.StatusBadRequest, errorRes)
 		return
 	}
 
-	successRes := response.ClientResponse(http.StatusOK, "Return success.The amount will be Credited your wallet", nil, nil)
+	successRes := response.ClientResponse(http.StatusOK, "Successfully canceled the items", nil, nil)
 	c.JSON(http.StatusOK, successRes)
 
 }
 
+// orderItemsRequest reads the user, the order and the items for the cancel items endpoint
+func orderItemsRequest(c *gin.Context) (int, int, models.OrderItemsRequest, bool) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, models.OrderItemsRequest{}, false
+	}
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, models.OrderItemsRequest{}, false
+	}
+
+	var request models.OrderItemsRequest
+	if err := c.BindJSON(&request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, models.OrderItemsRequest{}, false
+	}
+
+	if err := validator.New().Struct(request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, models.OrderItemsRequest{}, false
+	}
+
+	return userID, orderID, request, true
+}
+
 func (i *OrderHandler) MakePaymentStatusAsPaid(c *gin.Context) {
 
 	id, err := strconv.Atoi(c.Query("id"))
@@ -11,12 +11,14 @@ import (
 )
 
 type OtpHandler struct {
-	otpUseCase services.OtpUseCase
+	otpUseCase  services.OtpUseCase
+	cartUseCase services.CartUseCase
 }
 
-func NewOtpHandler(useCase services.OtpUseCase) *OtpHandler {
+func NewOtpHandler(useCase services.OtpUseCase, cartUseCase services.CartUseCase) *OtpHandler {
 	return &OtpHandler{
-		otpUseCase: useCase,
+		otpUseCase:  useCase,
+		cartUseCase: cartUseCase,
 	}
 }
 
@@ -73,6 +75,8 @@ func (ot *OtpHandler) VerifyOTP(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c, ot.cartUseCase, users.Users.Id)
+
 	successRes := response.ClientResponse(http.StatusOK, "Successfully verified OTP", users, nil)
 	c.JSON(http.StatusOK, successRes)
 
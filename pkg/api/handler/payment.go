@@ -4,6 +4,7 @@ import (
 	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/response"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -65,3 +66,41 @@ func (p *PaymentHandler) MakePaymentFromWallet(c *gin.Context) {
 
 	c.HTML(http.StatusOK, "razorpay.html", orderDetail)
 }
+
+// @Summary		Retry Payment
+// @Description	user can get a new payment for an order they did not finish paying for while its payment window is open
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/orders/{id}/retry-payment [post]
+func (p *PaymentHandler) RetryPayment(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	payment, err := p.usecase.RetryPayment(userID, orderID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retry the payment", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully created the payment", payment, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
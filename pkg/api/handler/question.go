@@ -0,0 +1,284 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type QuestionHandler struct {
+	usecase services.QuestionUseCase
+}
+
+func NewQuestionHandler(use services.QuestionUseCase) *QuestionHandler {
+	return &QuestionHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Ask Question
+// @Description	user can ask a question about a product, admins and buyers of the product can answer it
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			question	body	models.AddQuestion	true	"question"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/questions [post]
+func (q *QuestionHandler) AskQuestion(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var question models.AddQuestion
+	if err := c.BindJSON(&question); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(question); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := q.usecase.AskQuestion(userID, question)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not post the question", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully posted the question", gin.H{"question_id": id}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Answer Question
+// @Description	user who has received the product can answer a question about it
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"question id"
+// @Param			answer	body	models.AddAnswer	true	"answer"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/questions/{id}/answers [post]
+func (q *QuestionHandler) AnswerQuestion(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	q.answerQuestion(c, userID)
+}
+
+// @Summary		Answer Question
+// @Description	admin can answer a question for the store
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"question id"
+// @Param			answer	body	models.AddAnswer	true	"answer"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/questions/{id}/answers [post]
+func (q *QuestionHandler) AdminAnswerQuestion(c *gin.Context) {
+	q.answerQuestion(c, 0)
+}
+
+func (q *QuestionHandler) answerQuestion(c *gin.Context, userID int) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var answer models.AddAnswer
+	if err := c.BindJSON(&answer); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(answer); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	answerID, err := q.usecase.AnswerQuestion(userID, id, answer.Answer)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not post the answer", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully posted the answer", gin.H{"answer_id": answerID}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Upvote Answer
+// @Description	user can upvote an answer they found useful
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"answer id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/questions/answers/{id}/upvote [post]
+func (q *QuestionHandler) UpvoteAnswer(c *gin.Context) {
+	q.voteAnswer(c, true)
+}
+
+// @Summary		Remove Answer Upvote
+// @Description	user can take back their upvote on an answer
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"answer id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/questions/answers/{id}/upvote [delete]
+func (q *QuestionHandler) RemoveAnswerUpvote(c *gin.Context) {
+	q.voteAnswer(c, false)
+}
+
+func (q *QuestionHandler) voteAnswer(c *gin.Context, upvote bool) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := q.usecase.VoteAnswer(userID, id, upvote); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not record the vote", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully recorded the vote", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Unanswered Questions
+// @Description	admin can see the questions nobody has answered yet, oldest first, 20 in a page
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			page	query  string 	false	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/questions/unanswered [get]
+func (q *QuestionHandler) GetUnansweredQuestions(c *gin.Context) {
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	questions, err := q.usecase.GetUnansweredQuestions(page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the unanswered questions", questions, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Question
+// @Description	admin can remove a question along with its answers
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"question id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/questions/{id} [delete]
+func (q *QuestionHandler) DeleteQuestion(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := q.usecase.DeleteQuestion(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the question", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the question", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Answer
+// @Description	admin can remove an answer
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"answer id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/questions/answers/{id} [delete]
+func (q *QuestionHandler) DeleteAnswer(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := q.usecase.DeleteAnswer(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the answer", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the answer", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
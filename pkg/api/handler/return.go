@@ -0,0 +1,481 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ReturnHandler struct {
+	usecase services.ReturnUseCase
+}
+
+func NewReturnHandler(use services.ReturnUseCase) *ReturnHandler {
+	return &ReturnHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Request Return
+// @Description	user can ask to return a delivered order or some of its items within the return window, the refund is issued after the items are picked up and inspected
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Param			return	body	models.ReturnRequest	true	"reason and items"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/orders/{id}/returns [post]
+func (r *ReturnHandler) RequestReturn(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var request models.ReturnRequest
+	if err := c.BindJSON(&request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := r.usecase.RequestReturn(userID, orderID, request)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not request the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Return requested, we will get back to you after reviewing it", gin.H{"return_id": id}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Request Exchange
+// @Description	user can swap a delivered item for another size of the same product costing the same or less, the new size ships on a replacement order once the item is back and passes the inspection, and any difference in price is refunded
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Param			exchange	body	models.ExchangeRequest	true	"item and the size wanted"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/orders/{id}/exchanges [post]
+func (r *ReturnHandler) RequestExchange(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var request models.ExchangeRequest
+	if err := c.BindJSON(&request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := r.usecase.RequestExchange(userID, orderID, request)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not request the exchange", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Exchange requested, the new size is kept aside for you", gin.H{"return_id": id}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add Return Photo
+// @Description	user can add photos of the items to a return until it is reviewed
+// @Tags			User
+// @Accept			multipart/form-data
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Param			image	formData	file	true	"photo"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/returns/{id}/images [post]
+func (r *ReturnHandler) AddReturnImage(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "retrieving image from form error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.AddReturnImage(userID, id, file); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the photo", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added the photo", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Returns
+// @Description	user can see their returns and where they are, 20 in a page
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			page	query  string 	false	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/returns [get]
+func (r *ReturnHandler) GetReturns(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	r.getReturns(c, userID)
+}
+
+// @Summary		Get Return
+// @Description	user can see a return along with its items and photos
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/returns/{id} [get]
+func (r *ReturnHandler) GetReturn(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	r.getReturn(c, userID)
+}
+
+// @Summary		Get Returns
+// @Description	admin can see the returns, optionally only those in a status, 20 in a page
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			status	query  string 	false	"REQUESTED, APPROVED, REJECTED, PICKUP_SCHEDULED, RECEIVED, REFUNDED, EXCHANGED or INSPECTION_FAILED"
+// @Param			page	query  string 	false	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns [get]
+func (r *ReturnHandler) AdminGetReturns(c *gin.Context) {
+	r.getReturns(c, 0)
+}
+
+// @Summary		Get Return
+// @Description	admin can see a return along with its items and photos
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id} [get]
+func (r *ReturnHandler) AdminGetReturn(c *gin.Context) {
+	r.getReturn(c, 0)
+}
+
+func (r *ReturnHandler) getReturns(c *gin.Context, userID int) {
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	returns, err := r.usecase.GetReturnRequests(userID, c.Query("status"), page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the returns", returns, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+func (r *ReturnHandler) getReturn(c *gin.Context, userID int) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	request, err := r.usecase.GetReturnRequest(userID, id)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the return", request, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Approve Return
+// @Description	admin can approve a requested return so a pickup can be scheduled
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Param			decision	body	models.ReturnDecision	true	"note for the user"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id}/approve [put]
+func (r *ReturnHandler) ApproveReturn(c *gin.Context) {
+
+	id, decision, ok := returnDecision(c)
+	if !ok {
+		return
+	}
+
+	if err := r.usecase.ApproveReturn(id, decision.Note); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not approve the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully approved the return", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Reject Return
+// @Description	admin can reject a requested return, the note tells the user why. The stock held for an exchange is put back
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Param			decision	body	models.ReturnDecision	true	"reason for the user"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id}/reject [put]
+func (r *ReturnHandler) RejectReturn(c *gin.Context) {
+
+	id, decision, ok := returnDecision(c)
+	if !ok {
+		return
+	}
+
+	if err := r.usecase.RejectReturn(id, decision.Note); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not reject the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully rejected the return", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+func returnDecision(c *gin.Context) (int, models.ReturnDecision, bool) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, models.ReturnDecision{}, false
+	}
+
+	var decision models.ReturnDecision
+	if err := c.BindJSON(&decision); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, models.ReturnDecision{}, false
+	}
+
+	return id, decision, true
+}
+
+// @Summary		Schedule Return Pickup
+// @Description	admin can schedule, or move, the pickup of an approved return
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Param			pickup	body	models.ReturnPickup	true	"pickup date"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id}/pickup [put]
+func (r *ReturnHandler) SchedulePickup(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var pickup models.ReturnPickup
+	if err := c.BindJSON(&pickup); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(pickup); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.SchedulePickup(id, pickup.PickupDate); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not schedule the pickup", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully scheduled the pickup", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Receive Return
+// @Description	admin can mark the items of a return as received at the warehouse
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id}/receive [put]
+func (r *ReturnHandler) ReceiveReturn(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.ReceiveReturn(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not receive the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully received the return", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Inspect Return
+// @Description	admin records the inspection of a received return, the refund goes to the wallet if it passed. A passed exchange places the replacement order and only gets back what the new size was cheaper by, a failed one puts back the stock held for it
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"return id"
+// @Param			inspection	body	models.ReturnInspection	true	"inspection result"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/returns/{id}/inspect [put]
+func (r *ReturnHandler) InspectReturn(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var inspection models.ReturnInspection
+	if err := c.BindJSON(&inspection); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(inspection); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.InspectReturn(id, *inspection.Passed, inspection.Note); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not record the inspection", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully recorded the inspection", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -0,0 +1,444 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ReviewHandler struct {
+	usecase services.ReviewUseCase
+}
+
+func NewReviewHandler(use services.ReviewUseCase) *ReviewHandler {
+	return &ReviewHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Add Review
+// @Description	user can rate and review a product delivered to them, it shows up once approved
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			review	body	models.AddReview	true	"review"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews [post]
+func (r *ReviewHandler) AddReview(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var review models.AddReview
+	if err := c.BindJSON(&review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := r.usecase.AddReview(userID, review)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the review", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Thanks for the review, it will show up once approved", gin.H{"review_id": id}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Edit Review
+// @Description	user can change their review, it goes back for approval
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Param			review	body	models.EditReview	true	"review"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews/{id} [put]
+func (r *ReviewHandler) EditReview(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var review models.EditReview
+	if err := c.BindJSON(&review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.EditReview(userID, id, review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not edit the review", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully edited the review", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Review
+// @Description	user can delete their review
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews/{id} [delete]
+func (r *ReviewHandler) DeleteReview(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.DeleteReview(userID, id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the review", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the review", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add Review Photo
+// @Description	user can add photos to their review
+// @Tags			User
+// @Accept			multipart/form-data
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Param			image	formData	file	true	"photo"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews/{id}/images [post]
+func (r *ReviewHandler) AddReviewImage(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "retrieving image from form error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.AddReviewImage(userID, id, file); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the photo", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added the photo", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get My Reviews
+// @Description	user can see the reviews they wrote and whether they are approved, 20 in a page
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			page	query  string 	false	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews [get]
+func (r *ReviewHandler) GetMyReviews(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	r.getReviews(c, userID, "")
+}
+
+// @Summary		Get Product Reviews
+// @Description	user can see the rating of a product and its reviews, 10 in a page
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	query  string 	true	"product id"
+// @Param			page	query  string 	false	"page"
+// @Param			sort	query  string 	false	"helpful to list the most helpful first, latest first otherwise"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/home/products/reviews [get]
+func (r *ReviewHandler) GetProductReviews(c *gin.Context) {
+
+	inventoryID, err := strconv.Atoi(c.Query("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	reviews, err := r.usecase.GetProductReviews(inventoryID, page, c.Query("sort"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the reviews", reviews, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Vote Review Helpful
+// @Description	user can mark a review as helpful
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews/{id}/helpful [post]
+func (r *ReviewHandler) VoteHelpful(c *gin.Context) {
+	r.voteHelpful(c, true)
+}
+
+// @Summary		Remove Helpful Vote
+// @Description	user can take back their helpful vote on a review
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reviews/{id}/helpful [delete]
+func (r *ReviewHandler) RemoveHelpfulVote(c *gin.Context) {
+	r.voteHelpful(c, false)
+}
+
+func (r *ReviewHandler) voteHelpful(c *gin.Context, helpful bool) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.VoteHelpful(userID, id, helpful); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not record the vote", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully recorded the vote", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Reviews
+// @Description	admin can see the reviews to moderate, optionally only those in a status, 20 in a page
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			status	query  string 	false	"PENDING, APPROVED or HIDDEN"
+// @Param			page	query  string 	false	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/reviews [get]
+func (r *ReviewHandler) AdminGetReviews(c *gin.Context) {
+	r.getReviews(c, 0, c.Query("status"))
+}
+
+func (r *ReviewHandler) getReviews(c *gin.Context, userID int, status string) {
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	reviews, err := r.usecase.GetReviews(userID, status, page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the reviews", reviews, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Approve Review
+// @Description	admin can approve a review so it shows up on the product and counts in its rating
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/reviews/{id}/approve [put]
+func (r *ReviewHandler) ApproveReview(c *gin.Context) {
+	r.moderateReview(c, "APPROVED")
+}
+
+// @Summary		Hide Review
+// @Description	admin can hide a review from the product
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/reviews/{id}/hide [put]
+func (r *ReviewHandler) HideReview(c *gin.Context) {
+	r.moderateReview(c, "HIDDEN")
+}
+
+func (r *ReviewHandler) moderateReview(c *gin.Context, status string) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.ModerateReview(id, status); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not moderate the review", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully moderated the review", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Reply To Review
+// @Description	admin can reply to a review, the reply shows under it
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"review id"
+// @Param			reply	body	models.ReviewReply	true	"reply"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/reviews/{id}/reply [put]
+func (r *ReviewHandler) ReplyToReview(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var reply models.ReviewReply
+	if err := c.BindJSON(&reply); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(reply); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := r.usecase.ReplyToReview(id, reply.Reply); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not reply to the review", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully replied to the review", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ShipmentHandler struct {
+	usecase services.ShipmentUseCase
+}
+
+func NewShipmentHandler(use services.ShipmentUseCase) *ShipmentHandler {
+	return &ShipmentHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Create Shipment
+// @Description	admin can ship an order or some of its items, without a tracking number the courier is booked automatically
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Param			shipment	body	models.CreateShipment	true	"shipment"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/orders/{id}/shipments [post]
+func (s *ShipmentHandler) CreateShipment(c *gin.Context) {
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var shipment models.CreateShipment
+	if err := c.BindJSON(&shipment); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := s.usecase.CreateShipment(orderID, shipment); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not create the shipment", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully created the shipment", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Shipments
+// @Description	admin can see the shipments of an order along with tracking
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"order id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/orders/{id}/shipments [get]
+func (s *ShipmentHandler) GetShipments(c *gin.Context) {
+
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	shipments, err := s.usecase.GetShipments(orderID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all shipments", shipments, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add Tracking Event
+// @Description	admin can record a tracking update for couriers that are not integrated
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"shipment id"
+// @Param			event	body	models.TrackingEvent	true	"event"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipments/{id}/events [post]
+func (s *ShipmentHandler) AddTrackingEvent(c *gin.Context) {
+
+	shipmentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var event models.TrackingEvent
+	if err := c.BindJSON(&event); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(event); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := s.usecase.AddTrackingEvent(shipmentID, event); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the tracking event", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added the tracking event", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Refresh Tracking
+// @Description	admin can pull the latest tracking events from the courier
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"shipment id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipments/{id}/refresh [post]
+func (s *ShipmentHandler) RefreshTracking(c *gin.Context) {
+
+	shipmentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := s.usecase.RefreshTracking(shipmentID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not refresh the tracking", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully refreshed the tracking", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
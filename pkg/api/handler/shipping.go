@@ -0,0 +1,272 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ShippingHandler struct {
+	usecase services.ShippingUseCase
+}
+
+func NewShippingHandler(use services.ShippingUseCase) *ShippingHandler {
+	return &ShippingHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Get Shipping Zones
+// @Description	admin can see the shipping zones with their rates
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipping-zones [get]
+func (s *ShippingHandler) GetShippingZones(c *gin.Context) {
+
+	zones, err := s.usecase.GetShippingZones()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all shipping zones", zones, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Add Shipping Zone
+// @Description	admin can add a shipping zone for states or PIN code prefixes with flat and per item rates, free shipping threshold and COD surcharge
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			zone	body	models.ShippingZone	true	"zone"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipping-zones [post]
+func (s *ShippingHandler) AddShippingZone(c *gin.Context) {
+
+	var zone models.ShippingZone
+	if err := c.BindJSON(&zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := s.usecase.AddShippingZone(zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not add the shipping zone", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully added the shipping zone", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Update Shipping Zone
+// @Description	admin can change the rates and areas of a shipping zone
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"zone id"
+// @Param			zone	body	models.ShippingZone	true	"zone"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipping-zones/{id} [put]
+func (s *ShippingHandler) UpdateShippingZone(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var zone models.ShippingZone
+	if err := c.BindJSON(&zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	zone.ID = id
+	if err := s.usecase.UpdateShippingZone(zone); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not update the shipping zone", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully updated the shipping zone", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Shipping Zone
+// @Description	admin can remove a shipping zone
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"zone id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/shipping-zones/{id} [delete]
+func (s *ShippingHandler) DeleteShippingZone(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := s.usecase.DeleteShippingZone(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the shipping zone", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the shipping zone", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Import Serviceable PIN Codes
+// @Description	admin can upload a csv of pin,cod_allowed,prepaid_allowed,delivery_days, existing PIN codes are updated
+// @Tags			Admin
+// @Accept			multipart/form-data
+// @Produce		    json
+// @Param           file      formData     file   true   "csv file"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/serviceability/import [post]
+func (s *ShippingHandler) ImportServiceablePins(c *gin.Context) {
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "retrieving file from form error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	result, err := s.usecase.ImportServiceablePins(file)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not import the PIN codes", result, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully imported the PIN codes", result, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Serviceable PIN Codes
+// @Description	admin can see the PIN codes we deliver to, 50 in a page
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			page	query  string 	true	"page"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/serviceability [get]
+func (s *ShippingHandler) GetServiceablePins(c *gin.Context) {
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	pins, err := s.usecase.GetServiceablePins(page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve records", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the serviceable PIN codes", pins, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Serviceable PIN Code
+// @Description	admin can stop delivering to a PIN code
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			pin	path	string	true	"pin"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/serviceability/{pin} [delete]
+func (s *ShippingHandler) DeleteServiceablePin(c *gin.Context) {
+
+	if err := s.usecase.DeleteServiceablePin(c.Param("pin")); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the PIN code", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the PIN code", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Check Delivery
+// @Description	user can check if we deliver to a PIN code, whether cash on delivery is available and when it would arrive
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			pin	query	string	true	"pin"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/home/products/delivery [get]
+func (s *ShippingHandler) CheckServiceability(c *gin.Context) {
+
+	pin := c.Query("pin")
+	if err := validator.New().Var(pin, "required,numeric,len=6"); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "pin should be 6 digits", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	delivery, err := s.usecase.CheckServiceability(pin)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not check the PIN code", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully checked the PIN code", delivery, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
@@ -14,6 +14,7 @@ import (
 
 type UserHandler struct {
 	userUseCase services.UserUseCase
+	cartUseCase services.CartUseCase
 }
 
 type Response struct {
@@ -22,9 +23,10 @@ type Response struct {
 	Surname string `copier:"must"`
 }
 
-func NewUserHandler(usecase services.UserUseCase) *UserHandler {
+func NewUserHandler(usecase services.UserUseCase, cartUseCase services.CartUseCase) *UserHandler {
 	return &UserHandler{
 		userUseCase: usecase,
+		cartUseCase: cartUseCase,
 	}
 }
 
@@ -67,6 +69,8 @@ func (u *UserHandler) UserSignUp(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c, u.cartUseCase, userCreated.Users.Id)
+
 	successRes := response.ClientResponse(http.StatusCreated, "User successfully signed up", userCreated, nil)
 	c.JSON(http.StatusCreated, successRes)
 
@@ -105,6 +109,8 @@ func (u *UserHandler) LoginHandler(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c, u.cartUseCase, user_details.Users.Id)
+
 	successRes := response.ClientResponse(http.StatusOK, "User successfully logged in", user_details, nil)
 	c.JSON(http.StatusOK, successRes)
 
@@ -177,6 +183,125 @@ func (i *UserHandler) GetAddresses(c *gin.Context) {
 	c.JSON(http.StatusOK, successRes)
 }
 
+// @Summary		Edit Address
+// @Description	user can edit one of their addresses, set default to make it the default address
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"address id"
+// @Param			address  body  models.AddAddress  true	"address"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/address/{id} [put]
+func (i *UserHandler) EditAddress(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	addressID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	var address models.AddAddress
+	if err := c.BindJSON(&address); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.userUseCase.EditAddress(userID, addressID, address); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not edit the address", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully edited the address", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Address
+// @Description	user can delete one of their addresses
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"address id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/address/{id} [delete]
+func (i *UserHandler) DeleteAddress(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	addressID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.userUseCase.DeleteAddress(userID, addressID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not delete the address", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the address", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Set Default Address
+// @Description	user can pick the address which is selected by default at checkout
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"address id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/profile/address/{id}/default [put]
+func (i *UserHandler) SetDefaultAddress(c *gin.Context) {
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	addressID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.userUseCase.SetDefaultAddress(userID, addressID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not change the default address", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully changed the default address", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
 // @Summary		Get User Details
 // @Description	user can get all their details
 // @Tags			User
@@ -304,6 +429,89 @@ func (i *UserHandler) ForgotPasswordVerifyAndChange(c *gin.Context) {
 
 }
 
+// @Summary		Forgot password Send Email
+// @Description	user can get a password reset link to their email if user forgot the password
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			model  body  models.ForgotPasswordEmail  true	"forgot-email"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/forgot-password/email [post]
+func (i *UserHandler) ForgotPasswordEmailSend(c *gin.Context) {
+
+	var model models.ForgotPasswordEmail
+	if err := c.BindJSON(&model); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(model); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.userUseCase.ForgotPasswordEmailSend(model.Email); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not send reset link", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "If an account exists for this email, a reset link has been sent", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Reset Password Page
+// @Description	the page the reset email links to, it posts the new password with the token to /users/reset-password
+// @Tags			User
+// @Produce		    html
+// @Param			token	query	string	true	"token"
+// @Success		200
+// @Router			/users/reset-password [get]
+func (i *UserHandler) ResetPasswordPage(c *gin.Context) {
+
+	c.HTML(http.StatusOK, "reset_password.html", gin.H{"Token": c.Query("token")})
+
+}
+
+// @Summary		Reset Password
+// @Description	user can set a new password using the token received in the reset email
+// @Tags			User
+// @Accept			json
+// @Produce		    json
+// @Param			model  body  models.ResetPassword  true	"reset-password"
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/users/reset-password [post]
+func (i *UserHandler) ResetPasswordWithToken(c *gin.Context) {
+
+	var model models.ResetPassword
+	if err := c.BindJSON(&model); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(model); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := i.userUseCase.ResetPasswordWithToken(model); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not reset the password", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully reset the password, login again", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
 // @Summary		Edit Name
 // @Description	user can change their name
 // @Tags			User
@@ -482,17 +690,9 @@ func (i *UserHandler) RemoveFromCart(c *gin.Context) {
 	c.JSON(http.StatusOK, successRes)
 }
 
-// @Summary		Add quantity in cart by one
-// @Description	user can add 1 quantity of product to their cart
-// @Tags			User
-// @Accept			json
-// @Produce		    json
-// @Param			id	query	string	true	"id"
-// @Param			inventory	query	string	true	"inv_id"
-// @Security		Bearer
-// @Success		200	{object}	response.Response{}
-// @Failure		500	{object}	response.Response{}
-// @Router			/users/cart/updateQuantity/plus [put]
+// UpdateQuantityAdd and UpdateQuantityLess are no longer routed, the cart handler serves the plus and minus
+// endpoints with the stock and order limits checked
+
 func (i *UserHandler) UpdateQuantityAdd(c *gin.Context) {
 	id, err := strconv.Atoi(c.Query("id"))
 	if err != nil {
@@ -518,17 +718,6 @@ func (i *UserHandler) UpdateQuantityAdd(c *gin.Context) {
 	c.JSON(http.StatusOK, successRes)
 }
 
-// @Summary		Subtract quantity in cart by one
-// @Description	user can subtract 1 quantity of product from their cart
-// @Tags			User
-// @Accept			json
-// @Produce		    json
-// @Param			id	query	string	true	"id"
-// @Param			inventory	query	string	true	"inv_id"
-// @Security		Bearer
-// @Success		200	{object}	response.Response{}
-// @Failure		500	{object}	response.Response{}
-// @Router			/users/cart/updateQuantity/minus [put]
 func (i *UserHandler) UpdateQuantityLess(c *gin.Context) {
 	id, err := strconv.Atoi(c.Query("id"))
 	if err != nil {
@@ -119,7 +119,7 @@ func TestUserSignup(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase, test.input)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/signup", userHandler.UserSignUp)
@@ -231,7 +231,7 @@ func TestLoginHandler(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase, test.input)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/login", userHandler.LoginHandler)
@@ -331,7 +331,7 @@ func TestAddAddress(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase, test.input)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/add_address", userHandler.AddAddress)
@@ -403,7 +403,7 @@ func TestGetAddresses(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/getAddresses", userHandler.GetAddresses)
@@ -470,7 +470,7 @@ func TestGetUserDetails(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/get", userHandler.GetUserDetails)
@@ -561,7 +561,7 @@ func TestChangePassword(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/change_password", userHandler.ChangePassword)
@@ -639,7 +639,7 @@ func TestForgotPasswordSend(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/forgot_password_send", userHandler.ForgotPasswordSend)
@@ -721,7 +721,7 @@ func TestForgotPasswordVerifyAndChange(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase, test.input)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/forgot_password_verify", userHandler.ForgotPasswordVerifyAndChange)
@@ -808,7 +808,7 @@ func TestEditName(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/edit_name", userHandler.EditName)
@@ -897,7 +897,7 @@ func TestEditEmail(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/edit_email", userHandler.EditEmail)
@@ -985,7 +985,7 @@ func TestEditPhone(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/edit_phone", userHandler.EditPhone)
@@ -1056,7 +1056,7 @@ func TestGetCart(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/get_cart", userHandler.GetCart)
@@ -1122,7 +1122,7 @@ func TestGetCart(t *testing.T) {
 // 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 // 			test.buildStub(mockUseCase)
 
-// 			userHandler := NewUserHandler(mockUseCase)
+// 			userHandler := NewUserHandler(mockUseCase, nil)
 
 // 			server := gin.Default()
 // 			server.POST("/remove_from_cart", userHandler.RemoveFromCart)
@@ -1198,7 +1198,7 @@ func TestUpdateQuantityAdd(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/remove_from_cart", userHandler.UpdateQuantityAdd)
@@ -1278,7 +1278,7 @@ func TestUpdateQuantityLess(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/remove_from_cart", userHandler.UpdateQuantityLess)
@@ -1348,7 +1348,7 @@ func TestGetMyReferenceLink(t *testing.T) {
 			mockUseCase := mockusecase.NewMockUserUseCase(ctrl)
 			test.buildStub(mockUseCase)
 
-			userHandler := NewUserHandler(mockUseCase)
+			userHandler := NewUserHandler(mockUseCase, nil)
 
 			server := gin.Default()
 			server.POST("/remove_from_cart", userHandler.GetMyReferenceLink)
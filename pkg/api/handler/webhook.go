@@ -0,0 +1,213 @@
+package handler
+
+import (
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	"jerseyhub/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type WebhookHandler struct {
+	usecase services.WebhookUseCase
+}
+
+func NewWebhookHandler(use services.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		usecase: use,
+	}
+}
+
+// @Summary		Register Webhook
+// @Description	admin can register an endpoint for order, payment, inventory and user events, the signing secret is only shown here
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			webhook	body	models.AddWebhook	true	"webhook"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks [post]
+func (w *WebhookHandler) RegisterWebhook(c *gin.Context) {
+
+	var webhook models.AddWebhook
+	if err := c.BindJSON(&webhook); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := validator.New().Struct(webhook); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	registered, err := w.usecase.RegisterWebhook(webhook)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not register the webhook", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully registered the webhook", registered, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Webhooks
+// @Description	admin can see the registered webhooks
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks [get]
+func (w *WebhookHandler) GetWebhooks(c *gin.Context) {
+
+	webhooks, err := w.usecase.GetWebhooks()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve webhooks", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got all webhooks", webhooks, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Delete Webhook
+// @Description	admin can remove a webhook along with its delivery log
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"webhook id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks/{id} [delete]
+func (w *WebhookHandler) DeleteWebhook(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := w.usecase.DeleteWebhook(id); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not delete the webhook", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully deleted the webhook", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Ping Webhook
+// @Description	admin can send a signed test delivery to a webhook and see the answer
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"webhook id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks/{id}/ping [post]
+func (w *WebhookHandler) Ping(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	delivery, err := w.usecase.Ping(id)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not ping the webhook", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Ping sent", delivery, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Get Webhook Deliveries
+// @Description	admin can see the delivery log of a webhook, latest first
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"webhook id"
+// @Param			page	query	string	false	"page number"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks/{id}/deliveries [get]
+func (w *WebhookHandler) GetDeliveries(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "page number not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	deliveries, err := w.usecase.GetDeliveries(id, page)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not retrieve deliveries", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully got the deliveries", deliveries, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
+
+// @Summary		Replay Webhook Delivery
+// @Description	admin can send a finished delivery again, it goes out as a new delivery
+// @Tags			Admin
+// @Accept			json
+// @Produce		    json
+// @Param			id	path	string	true	"delivery id"
+// @Security		Bearer
+// @Success		200	{object}	response.Response{}
+// @Failure		500	{object}	response.Response{}
+// @Router			/admin/webhooks/deliveries/{id}/replay [post]
+func (w *WebhookHandler) ReplayDelivery(c *gin.Context) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "error in getting parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	deliveryID, err := w.usecase.ReplayDelivery(id)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not replay the delivery", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully queued the delivery again", gin.H{"delivery_id": deliveryID}, nil)
+	c.JSON(http.StatusOK, successRes)
+
+}
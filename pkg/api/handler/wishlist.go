@@ -83,3 +83,55 @@ func (w *WishlistHandler) GetWishList(c *gin.Context) {
 	successRes := response.ClientResponse(http.StatusOK, "Successfully got all records", products, nil)
 	c.JSON(http.StatusOK, successRes)
 }
+
+func (w *WishlistHandler) MoveToCart(c *gin.Context) {
+
+	inventoryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "check path parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := w.usecase.MoveToCart(userID, inventoryID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not move to cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully moved product to cart", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+func (w *WishlistHandler) MoveToWishlist(c *gin.Context) {
+
+	inventoryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "check path parameter", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	userID, ok := c.MustGet("id").(int)
+	if !ok {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not find id from context", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	if err := w.usecase.MoveToWishlist(userID, inventoryID); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "could not move to wishlist", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	successRes := response.ClientResponse(http.StatusOK, "Successfully moved product to wishlist", nil, nil)
+	c.JSON(http.StatusOK, successRes)
+}
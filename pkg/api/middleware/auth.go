@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -14,7 +15,7 @@ func AdminAuthMiddleware(c *gin.Context) {
 
 	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
 
-	_, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
+	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
 		return []byte("accesssecret"), nil
 	})
 	if err != nil {
@@ -24,5 +25,66 @@ func AdminAuthMiddleware(c *gin.Context) {
 		return
 	}
 
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if id, ok := claims["id"].(float64); ok {
+			c.Set("id", int(id))
+		}
+	}
+
+	c.Next()
+}
+
+// TwoFactorTokenParser reads the short lived token given to an admin after the password check
+type TwoFactorTokenParser interface {
+	ParseTokenAdminTwoFactor(token string) (int, error)
+}
+
+// AdminTwoFactorMiddleware lets through the short lived token given after the password check,
+// as well as a normal access token, it is only used on the routes that complete the second factor
+func AdminTwoFactorMiddleware(twoFactorParser TwoFactorTokenParser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminTwoFactor(c, twoFactorParser)
+	}
+}
+
+func adminTwoFactor(c *gin.Context, twoFactorParser TwoFactorTokenParser) {
+
+	tokenString := c.Request.Header.Get("Authorization")
+
+	tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+
+	if id, err := twoFactorParser.ParseTokenAdminTwoFactor(tokenString); err == nil {
+		c.Set("role", "admin_2fa")
+		c.Set("id", id)
+		c.Next()
+		return
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte("accesssecret"), nil
+	})
+	if err != nil || !token.Valid {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token"})
+		c.Abort()
+		return
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["role"] != "admin" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token"})
+		c.Abort()
+		return
+	}
+
+	id, ok := claims["id"].(float64)
+	if !ok || id == 0 {
+		c.JSON(http.StatusForbidden, gin.H{"error": "error in retrieving id"})
+		c.Abort()
+		return
+	}
+
+	c.Set("role", claims["role"])
+	c.Set("id", int(id))
+
 	c.Next()
 }
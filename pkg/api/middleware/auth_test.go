@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+type twoFactorParserFunc func(token string) (int, error)
+
+func (f twoFactorParserFunc) ParseTokenAdminTwoFactor(token string) (int, error) {
+	return f(token)
+}
+
+func Test_AdminTwoFactorMiddleware(t *testing.T) {
+
+	signed := func(role, secret string) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"id":   3,
+			"role": role,
+			"exp":  time.Now().Add(time.Minute).Unix(),
+		}).SignedString([]byte(secret))
+		return token
+	}
+
+	parser := twoFactorParserFunc(func(token string) (int, error) {
+		if token == "pending" {
+			return 3, nil
+		}
+		return 0, errors.New("two factor token is not valid")
+	})
+
+	testData := map[string]struct {
+		token          string
+		expectedStatus int
+		expectedRole   string
+	}{
+		"token from the password check": {
+			token:          "pending",
+			expectedStatus: http.StatusOK,
+			expectedRole:   "admin_2fa",
+		},
+		"admin access token": {
+			token:          signed("admin", "accesssecret"),
+			expectedStatus: http.StatusOK,
+			expectedRole:   "admin",
+		},
+		"pending token signed with the old hardcoded secret": {
+			token:          signed("admin_2fa", "twofactorsecret"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"user token": {
+			token:          signed("client", "accesssecret"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/", AdminTwoFactorMiddleware(parser), func(c *gin.Context) {
+				assert.Equal(t, 3, c.GetInt("id"))
+				assert.Equal(t, test.expectedRole, c.GetString("role"))
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+test.token)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+		})
+	}
+}
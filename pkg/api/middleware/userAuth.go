@@ -4,12 +4,24 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
 )
 
-func UserAuthMiddleware(c *gin.Context) {
+// SessionChecker tells from when the tokens of a user are valid, it changes when the password is reset
+type SessionChecker interface {
+	GetSessionsRevokedAt(id int) (time.Time, error)
+}
+
+func UserAuthMiddleware(sessionChecker SessionChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAuth(c, sessionChecker)
+	}
+}
+
+func userAuth(c *gin.Context, sessionChecker SessionChecker) {
 	tokenString := c.GetHeader("Authorization")
 	if tokenString == "" {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing authorization token"})
@@ -52,8 +64,33 @@ func UserAuthMiddleware(c *gin.Context) {
 		return
 	}
 
+	revokedAt, err := sessionChecker.GetSessionsRevokedAt(int(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not validate session"})
+		c.Abort()
+		return
+	}
+
+	issuedAt, _ := claims["iat"].(float64)
+	if issuedBefore(int64(issuedAt), revokedAt) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired, login again"})
+		c.Abort()
+		return
+	}
+
 	c.Set("role", role)
 	c.Set("id", int(id))
 
 	c.Next()
 }
+
+// issuedBefore tells if a token was issued before the sessions were revoked. The iat of a token is in whole
+// seconds, so a token from the second the sessions were revoked in is taken to be from before it
+func issuedBefore(issuedAt int64, revokedAt time.Time) bool {
+
+	if revokedAt.IsZero() {
+		return false
+	}
+
+	return issuedAt <= revokedAt.Unix()
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+type sessionCheckerFunc func(id int) (time.Time, error)
+
+func (f sessionCheckerFunc) GetSessionsRevokedAt(id int) (time.Time, error) {
+	return f(id)
+}
+
+func Test_UserAuthMiddleware(t *testing.T) {
+
+	revokedAt := time.Date(2026, 10, 19, 10, 30, 15, 600000000, time.UTC)
+
+	testData := map[string]struct {
+		issuedAt       time.Time
+		revokedAt      time.Time
+		expectedStatus int
+	}{
+		"token issued after the sessions were revoked": {
+			issuedAt:       revokedAt.Add(time.Second),
+			revokedAt:      revokedAt,
+			expectedStatus: http.StatusOK,
+		},
+		"token issued in the same second as the revocation": {
+			issuedAt:       revokedAt.Add(-500 * time.Millisecond),
+			revokedAt:      revokedAt,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"token issued before the sessions were revoked": {
+			issuedAt:       revokedAt.Add(-time.Hour),
+			revokedAt:      revokedAt,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"sessions never revoked": {
+			issuedAt:       revokedAt,
+			revokedAt:      time.Time{},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"id":   5,
+				"role": "client",
+				"iat":  test.issuedAt.Unix(),
+			}).SignedString([]byte("comebuyjersey"))
+			assert.NoError(t, err)
+
+			checker := sessionCheckerFunc(func(id int) (time.Time, error) {
+				assert.Equal(t, 5, id)
+				return test.revokedAt, nil
+			})
+
+			router := gin.New()
+			router.GET("/", UserAuthMiddleware(checker), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatus, w.Code)
+		})
+	}
+}
@@ -6,14 +6,22 @@ import (
 	"github.com/gin-gonic/gin"
 
 	handler "jerseyhub/pkg/api/handler"
+	"jerseyhub/pkg/api/middleware"
 	"jerseyhub/pkg/routes"
+	services "jerseyhub/pkg/usecase/interface"
 
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
+// dispatcher is a background worker that has to run alongside the server
+type dispatcher interface {
+	Start()
+}
+
 type ServerHTTP struct {
-	engine *gin.Engine
+	engine      *gin.Engine
+	dispatchers []dispatcher
 }
 
 func NewServerHTTP(userHandler *handler.UserHandler,
@@ -26,7 +34,24 @@ func NewServerHTTP(userHandler *handler.UserHandler,
 	couponHandler *handler.CouponHandler,
 	paymentHandler *handler.PaymentHandler,
 	offerhandler *handler.OfferHandler,
-	wishlistHandler *handler.WishlistHandler) *ServerHTTP {
+	wishlistHandler *handler.WishlistHandler,
+	emailHandler *handler.EmailHandler,
+	identityHandler *handler.IdentityHandler,
+	shipmentHandler *handler.ShipmentHandler,
+	shippingHandler *handler.ShippingHandler,
+	invoiceHandler *handler.InvoiceHandler,
+	returnHandler *handler.ReturnHandler,
+	reviewHandler *handler.ReviewHandler,
+	questionHandler *handler.QuestionHandler,
+	alertHandler *handler.AlertHandler,
+	notificationHandler *handler.NotificationHandler,
+	webhookHandler *handler.WebhookHandler,
+	jobHandler *handler.JobHandler,
+	sessionChecker middleware.SessionChecker,
+	twoFactorParser middleware.TwoFactorTokenParser,
+	eventUseCase services.EventUseCase,
+	webhookUseCase services.WebhookUseCase,
+	jobUseCase services.JobUseCase) *ServerHTTP {
 
 	engine := gin.New()
 
@@ -40,13 +65,21 @@ func NewServerHTTP(userHandler *handler.UserHandler,
 
 	engine.GET("/validate-token", adminHandler.ValidateRefreshTokenAndCreateNewAccess)
 
-	routes.UserRoutes(engine.Group("/users"), userHandler, otpHandler, inventoryHandler, orderHandler, cartHandler, paymentHandler, wishlistHandler, categoryHandler, couponHandler)
-	routes.AdminRoutes(engine.Group("/admin"), adminHandler, inventoryHandler, userHandler, categoryHandler, orderHandler, couponHandler, offerhandler)
+	routes.UserRoutes(engine.Group("/users"), userHandler, otpHandler, inventoryHandler, orderHandler, cartHandler, paymentHandler, wishlistHandler, categoryHandler, couponHandler, emailHandler, identityHandler, invoiceHandler, shippingHandler, returnHandler, reviewHandler, questionHandler, alertHandler, notificationHandler, sessionChecker)
+	routes.AdminRoutes(engine.Group("/admin"), adminHandler, inventoryHandler, userHandler, categoryHandler, orderHandler, couponHandler, offerhandler, shipmentHandler, shippingHandler, invoiceHandler, returnHandler, reviewHandler, questionHandler, webhookHandler, jobHandler, cartHandler, twoFactorParser)
 
-	return &ServerHTTP{engine: engine}
+	return &ServerHTTP{
+		engine:      engine,
+		dispatchers: []dispatcher{eventUseCase, webhookUseCase, jobUseCase},
+	}
 }
 
+// Start runs the dispatchers before serving, every subscriber and job is registered by now
 func (sh *ServerHTTP) Start() {
+	for _, d := range sh.dispatchers {
+		d.Start()
+	}
+
 	err := sh.engine.Run(":3000")
 	if err != nil {
 		log.Fatal("gin engine couldn't start")
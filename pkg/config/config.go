@@ -6,22 +6,50 @@ import (
 )
 
 type Config struct {
-	BASE_URL              string `mapstructure:"BASE_URL"`
-	DBHost                string `mapstructure:"DB_HOST"`
-	DBName                string `mapstructure:"DB_NAME"`
-	DBUser                string `mapstructure:"DB_USER"`
-	DBPort                string `mapstructure:"DB_PORT"`
-	DBPassword            string `mapstructure:"DB_PASSWORD"`
-	AUTHTOKEN             string `mapstructure:"DB_AUTHTOKEN"`
-	ACCOUNTSID            string `mapstructure:"DB_ACCOUNTSID"`
-	SERVICESID            string `mapstructure:"DB_SERVICESID"`
-	AWS_REGION            string `mapstructure:"AWS_REGION"`
-	AWS_ACCESS_KEY_ID     string `mapstructure:"AWS_ACCESS_KEY_ID"`
-	AWS_SECRET_ACCESS_KEY string `mapstructure:"AWS_SECRET_ACCESS_KEY"`
+	BASE_URL               string `mapstructure:"BASE_URL"`
+	DBHost                 string `mapstructure:"DB_HOST"`
+	DBName                 string `mapstructure:"DB_NAME"`
+	DBUser                 string `mapstructure:"DB_USER"`
+	DBPort                 string `mapstructure:"DB_PORT"`
+	DBPassword             string `mapstructure:"DB_PASSWORD"`
+	AUTHTOKEN              string `mapstructure:"DB_AUTHTOKEN"`
+	ACCOUNTSID             string `mapstructure:"DB_ACCOUNTSID"`
+	SERVICESID             string `mapstructure:"DB_SERVICESID"`
+	AWS_REGION             string `mapstructure:"AWS_REGION"`
+	AWS_ACCESS_KEY_ID      string `mapstructure:"AWS_ACCESS_KEY_ID"`
+	AWS_SECRET_ACCESS_KEY  string `mapstructure:"AWS_SECRET_ACCESS_KEY"`
+	MAIL_DRIVER            string `mapstructure:"MAIL_DRIVER"`
+	MAIL_FROM              string `mapstructure:"MAIL_FROM"`
+	MAIL_DROP_DIR          string `mapstructure:"MAIL_DROP_DIR"`
+	SMTP_HOST              string `mapstructure:"SMTP_HOST"`
+	SMTP_PORT              string `mapstructure:"SMTP_PORT"`
+	SMTP_USERNAME          string `mapstructure:"SMTP_USERNAME"`
+	SMTP_PASSWORD          string `mapstructure:"SMTP_PASSWORD"`
+	GOOGLE_ISSUER          string `mapstructure:"GOOGLE_ISSUER"`
+	GOOGLE_CLIENT_ID       string `mapstructure:"GOOGLE_CLIENT_ID"`
+	GOOGLE_CLIENT_SECRET   string `mapstructure:"GOOGLE_CLIENT_SECRET"`
+	GOOGLE_REDIRECT_URL    string `mapstructure:"GOOGLE_REDIRECT_URL"`
+	SELLER_NAME            string `mapstructure:"SELLER_NAME"`
+	SELLER_ADDRESS         string `mapstructure:"SELLER_ADDRESS"`
+	SELLER_STATE           string `mapstructure:"SELLER_STATE"`
+	SELLER_GSTIN           string `mapstructure:"SELLER_GSTIN"`
+	RETURN_WINDOW_DAYS     int    `mapstructure:"RETURN_WINDOW_DAYS"`
+	PAYMENT_WINDOW_MINUTES int    `mapstructure:"PAYMENT_WINDOW_MINUTES"`
+	CART_REMINDER_HOURS    string `mapstructure:"CART_REMINDER_HOURS"`
+	CART_RECOVERY_DISCOUNT int    `mapstructure:"CART_RECOVERY_DISCOUNT"`
+	CART_TOKEN_SECRET      string `mapstructure:"CART_TOKEN_SECRET"`
+	TWO_FACTOR_SECRET      string `mapstructure:"TWO_FACTOR_SECRET"`
+	MAX_ORDER_QUANTITY     int    `mapstructure:"MAX_ORDER_QUANTITY"`
 }
 
 var envs = []string{
 	"BASE_URL", "DB_HOST", "DB_NAME", "DB_USER", "DB_PORT", "DB_PASSWORD", "DB_AUTHTOKEN", "DB_ACCOUNTSID", "DB_SERVICESID", "AWS_REGION", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY",
+	"MAIL_DRIVER", "MAIL_FROM", "MAIL_DROP_DIR", "SMTP_HOST", "SMTP_PORT", "SMTP_USERNAME", "SMTP_PASSWORD",
+	"GOOGLE_ISSUER", "GOOGLE_CLIENT_ID", "GOOGLE_CLIENT_SECRET", "GOOGLE_REDIRECT_URL",
+	"SELLER_NAME", "SELLER_ADDRESS", "SELLER_STATE", "SELLER_GSTIN",
+	"RETURN_WINDOW_DAYS", "PAYMENT_WINDOW_MINUTES",
+	"CART_REMINDER_HOURS", "CART_RECOVERY_DISCOUNT", "CART_TOKEN_SECRET", "MAX_ORDER_QUANTITY",
+	"TWO_FACTOR_SECRET",
 }
 
 func LoadConfig() (Config, error) {
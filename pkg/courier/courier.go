@@ -0,0 +1,11 @@
+package courier
+
+import (
+	cfg "jerseyhub/pkg/config"
+	interfaces "jerseyhub/pkg/courier/interface"
+)
+
+// NewCourier is where a real delivery partner gets picked from the config, only the fake courier exists for now
+func NewCourier(config cfg.Config) interfaces.Courier {
+	return NewFakeCourier()
+}
@@ -0,0 +1,87 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	interfaces "jerseyhub/pkg/courier/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+// the fake courier moves every parcel through these stages, each a fixed time after booking
+var fakeStages = []struct {
+	status      string
+	after       time.Duration
+	description string
+}{
+	{"BOOKED", 0, "Shipment booked"},
+	{"PICKED_UP", 24 * time.Hour, "Picked up from the seller"},
+	{"IN_TRANSIT", 48 * time.Hour, "In transit to the destination hub"},
+	{"OUT_FOR_DELIVERY", 96 * time.Hour, "Out for delivery"},
+	{"DELIVERED", 120 * time.Hour, "Delivered"},
+}
+
+type fakeCourier struct {
+	mu       sync.Mutex
+	sequence int
+	booked   map[string]time.Time
+}
+
+func NewFakeCourier() interfaces.Courier {
+	return &fakeCourier{
+		booked: make(map[string]time.Time),
+	}
+}
+
+func (f *fakeCourier) Name() string {
+	return "fake"
+}
+
+func (f *fakeCourier) Book(shipment models.CourierShipmentRequest) (models.CourierBooking, error) {
+	if shipment.Pin == "" {
+		return models.CourierBooking{}, errors.New("pin code is required")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sequence++
+	now := time.Now()
+	trackingNumber := fmt.Sprintf("FAKE%d%04d", now.Unix(), f.sequence)
+	f.booked[trackingNumber] = now
+
+	return models.CourierBooking{
+		Courier:          f.Name(),
+		TrackingNumber:   trackingNumber,
+		ExpectedDelivery: now.Add(fakeStages[len(fakeStages)-1].after),
+	}, nil
+}
+
+func (f *fakeCourier) Track(trackingNumber string) ([]models.TrackingEvent, error) {
+	f.mu.Lock()
+	bookedAt, ok := f.booked[trackingNumber]
+	f.mu.Unlock()
+
+	if !ok {
+		return nil, errors.New("unknown tracking number")
+	}
+
+	var events []models.TrackingEvent
+	for _, stage := range fakeStages {
+		occurredAt := bookedAt.Add(stage.after)
+		if occurredAt.After(time.Now()) {
+			break
+		}
+
+		events = append(events, models.TrackingEvent{
+			Status:      stage.status,
+			Location:    "jerseyhub fake courier",
+			Description: stage.description,
+			OccurredAt:  occurredAt,
+		})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+// Courier is implemented once for every delivery partner we integrate with
+type Courier interface {
+	Name() string
+	Book(shipment models.CourierShipmentRequest) (models.CourierBooking, error)
+	Track(trackingNumber string) ([]models.TrackingEvent, error)
+}
@@ -57,6 +57,117 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 	if err := db.AutoMigrate(domain.Wishlist{}); err != nil {
 		return db, err
 	}
+	if err := db.AutoMigrate(domain.EmailVerification{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.PasswordReset{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.UserIdentity{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.OidcLoginState{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.AdminRecoveryCode{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.AdminPolicy{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.Shipment{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ShipmentItem{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ShipmentEvent{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ShippingZone{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ShippingZoneArea{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ServiceablePin{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.Invoice{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.InvoiceSequence{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ReturnRequest{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ReturnRequestItem{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ReturnRequestImage{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.Review{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ReviewImage{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ReviewVote{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ProductQuestion{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ProductAnswer{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.AnswerVote{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.ProductAlert{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.Notification{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.NotificationPreference{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.OutboxEvent{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.EventDelivery{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.WebhookEndpoint{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.WebhookDelivery{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.Job{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.CartReminder{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.GuestCart{}); err != nil {
+		return db, err
+	}
+	if err := db.AutoMigrate(domain.GuestCartItem{}); err != nil {
+		return db, err
+	}
+	if err := BackfillOrderSnapshots(db); err != nil {
+		return db, err
+	}
+	if err := BackfillOrderItemStatus(db); err != nil {
+		return db, err
+	}
+	if err := BackfillDeliveredAt(db); err != nil {
+		return db, err
+	}
 	CheckAndCreateAdmin(db)
 
 	return db, dbErr
@@ -81,3 +192,33 @@ func CheckAndCreateAdmin(db *gorm.DB) {
 		db.Create(&admin)
 	}
 }
+
+// BackfillOrderSnapshots copies the address and product details onto orders placed before
+// they were captured at checkout, rows which already have them are left alone
+func BackfillOrderSnapshots(db *gorm.DB) error {
+	if err := db.Exec(`UPDATE orders SET ship_name = addresses.name, ship_house_name = addresses.house_name,
+	ship_street = addresses.street, ship_city = addresses.city, ship_state = addresses.state,
+	ship_pin = addresses.pin, ship_phone = addresses.phone
+	FROM addresses WHERE addresses.id = orders.address_id AND orders.ship_name IS NULL`).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`UPDATE order_items SET product_name = inventories.product_name, image = inventories.image,
+	price = inventories.price
+	FROM inventories WHERE inventories.id = order_items.inventory_id AND order_items.product_name IS NULL`).Error
+}
+
+// BackfillOrderItemStatus gives items of orders placed before item statuses existed the status of their order
+func BackfillOrderItemStatus(db *gorm.DB) error {
+	return db.Exec(`UPDATE order_items SET item_status = orders.order_status
+	FROM orders WHERE orders.id = order_items.order_id AND order_items.item_status = 'PENDING' AND orders.order_status <> 'PENDING'`).Error
+}
+
+// BackfillDeliveredAt dates orders delivered before the delivery time was kept, from the tracking
+// event when there is one
+func BackfillDeliveredAt(db *gorm.DB) error {
+	return db.Exec(`UPDATE orders SET delivered_at = COALESCE((SELECT MAX(shipment_events.occurred_at) FROM shipment_events
+	JOIN shipments ON shipments.id = shipment_events.shipment_id
+	WHERE shipments.order_id = orders.id AND shipment_events.status = 'DELIVERED'), orders.updated_at)
+	WHERE orders.order_status IN ('DELIVERED','RETURNED') AND orders.delivered_at IS NULL`).Error
+}
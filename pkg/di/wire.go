@@ -7,14 +7,61 @@ import (
 	"github.com/google/wire"
 	http "jerseyhub/pkg/api"
 	handler "jerseyhub/pkg/api/handler"
+	middleware "jerseyhub/pkg/api/middleware"
 	config "jerseyhub/pkg/config"
+	courier "jerseyhub/pkg/courier"
 	db "jerseyhub/pkg/db"
+	helper "jerseyhub/pkg/helper"
+	helper_interface "jerseyhub/pkg/helper/interface"
+	invoice "jerseyhub/pkg/invoice"
+	mailer "jerseyhub/pkg/mailer"
+	oidc "jerseyhub/pkg/oidc"
 	repository "jerseyhub/pkg/repository"
 	usecase "jerseyhub/pkg/usecase"
+	services "jerseyhub/pkg/usecase/interface"
+	webhook "jerseyhub/pkg/webhook"
 )
 
 func InitializeAPI(cfg config.Config) (*http.ServerHTTP, error) {
-	wire.Build(db.ConnectDatabase, repository.NewUserRepository, usecase.NewUserUseCase, handler.NewUserHandler, http.NewServerHTTP)
+	wire.Build(db.ConnectDatabase,
+		helper.NewHelper,
+		mailer.NewMailer,
+		oidc.NewGoogleProvider,
+		courier.NewCourier,
+		invoice.NewRenderer,
+		webhook.NewSender,
+
+		repository.NewEventRepository, usecase.NewEventUseCase,
+		repository.NewJobRepository, usecase.NewJobUseCase, handler.NewJobHandler,
+		repository.NewWebhookRepository, usecase.NewWebhookUseCase, handler.NewWebhookHandler,
+		repository.NewEmailRepository, usecase.NewEmailUseCase, handler.NewEmailHandler,
+		repository.NewNotificationRepository, usecase.NewNotificationUseCase, handler.NewNotificationHandler,
+		repository.NewAlertRepository, usecase.NewAlertUseCase, handler.NewAlertHandler,
+		repository.NewOfferRepository, usecase.NewOfferUseCase, handler.NewOfferHandler,
+		repository.NewWishlistRepository, usecase.NewWishlistUseCase, handler.NewWishlistHandler,
+		repository.NewAdminRepository, usecase.NewAdminUseCase, handler.NewAdminHandler,
+		repository.NewInventoryRepository, usecase.NewInventoryUseCase, handler.NewInventoryHandler,
+		repository.NewReviewRepository, usecase.NewReviewUseCase, handler.NewReviewHandler,
+		repository.NewQuestionRepository, usecase.NewQuestionUseCase, handler.NewQuestionHandler,
+		repository.NewCategoryRepository, usecase.NewCategoryUseCase, handler.NewCategoryHandler,
+		repository.NewOtpRepository, usecase.NewOtpUseCase, handler.NewOtpHandler,
+		repository.NewUserRepository, usecase.NewUserUseCase, handler.NewUserHandler,
+		repository.NewIdentityRepository, usecase.NewIdentityUseCase, handler.NewIdentityHandler,
+		repository.NewCouponRepository, usecase.NewCouponUseCase, handler.NewCouponHandler,
+		repository.NewShippingRepository, usecase.NewShippingUseCase, handler.NewShippingHandler,
+		repository.NewShipmentRepository, usecase.NewShipmentUseCase, handler.NewShipmentHandler,
+		repository.NewInvoiceRepository, usecase.NewInvoiceUseCase, handler.NewInvoiceHandler,
+		repository.NewOrderRepository, usecase.NewOrderUseCase, handler.NewOrderHandler,
+		repository.NewReturnRepository, usecase.NewReturnUseCase, handler.NewReturnHandler,
+		repository.NewCartRepository, usecase.NewCartUseCase, handler.NewCartHandler,
+		repository.NewPaymentRepository, usecase.NewPaymentUseCase, handler.NewPaymentHandler,
+
+		// the user auth middleware checks revoked sessions through the user usecase
+		wire.Bind(new(middleware.SessionChecker), new(services.UserUseCase)),
+		// the admin two factor middleware reads its token through the helper
+		wire.Bind(new(middleware.TwoFactorTokenParser), new(helper_interface.Helper)),
+
+		http.NewServerHTTP)
 
 	return &http.ServerHTTP{}, nil
 }
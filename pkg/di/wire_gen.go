@@ -10,10 +10,15 @@ import (
 	"jerseyhub/pkg/api"
 	"jerseyhub/pkg/api/handler"
 	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/courier"
 	"jerseyhub/pkg/db"
 	"jerseyhub/pkg/helper"
+	"jerseyhub/pkg/invoice"
+	"jerseyhub/pkg/mailer"
+	"jerseyhub/pkg/oidc"
 	"jerseyhub/pkg/repository"
 	"jerseyhub/pkg/usecase"
+	"jerseyhub/pkg/webhook"
 )
 
 // Injectors from wire.go:
@@ -25,13 +30,37 @@ func InitializeAPI(cfg config.Config) (*http.ServerHTTP, error) {
 	}
 
 	helper:=helper.NewHelper(cfg)
+	mailer:=mailer.NewMailer(cfg)
+	googleProvider:=oidc.NewGoogleProvider(cfg)
+	courier:=courier.NewCourier(cfg)
+	invoiceRenderer:=invoice.NewRenderer()
+	webhookSender:=webhook.NewSender(cfg)
+
+	eventRepository := repository.NewEventRepository(gormDB)
+	eventUseCase := usecase.NewEventUseCase(eventRepository)
+
+	jobRepository := repository.NewJobRepository(gormDB)
+	jobUseCase := usecase.NewJobUseCase(jobRepository)
+	jobHandler := handler.NewJobHandler(jobUseCase)
+
+	webhookRepository := repository.NewWebhookRepository(gormDB)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepository,webhookSender,eventUseCase)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+
+	emailRepository := repository.NewEmailRepository(gormDB)
+	notificationRepository := repository.NewNotificationRepository(gormDB)
+	notificationUseCase := usecase.NewNotificationUseCase(notificationRepository,emailRepository,mailer,eventUseCase)
+	notificationHandler := handler.NewNotificationHandler(notificationUseCase)
+	alertRepository := repository.NewAlertRepository(gormDB)
+	alertUseCase := usecase.NewAlertUseCase(alertRepository,notificationUseCase,eventUseCase)
+	alertHandler := handler.NewAlertHandler(alertUseCase)
 
 	offerRepository := repository.NewOfferRepository(gormDB)
-	offerUseCase := usecase.NewOfferUseCase(offerRepository)
+	offerUseCase := usecase.NewOfferUseCase(offerRepository,alertUseCase,jobUseCase)
 	offerHandler := handler.NewOfferHandler(offerUseCase)
 
 	wishlistRepository := repository.NewWishlistRepository(gormDB)
-	wishlistUseCase := usecase.NewWishlistUseCase(wishlistRepository,offerRepository)
+	wishlistUseCase := usecase.NewWishlistUseCase(wishlistRepository,offerRepository,alertUseCase)
 	wishlistHandler := handler.NewWishlistHandler(wishlistUseCase)
 
 
@@ -40,7 +69,11 @@ func InitializeAPI(cfg config.Config) (*http.ServerHTTP, error) {
 	adminHandler := handler.NewAdminHandler(adminUseCase)
 
 	inventoryRepository := repository.NewInventoryRepository(gormDB)
-	inventoryUseCase := usecase.NewInventoryUseCase(inventoryRepository,offerRepository,helper,wishlistRepository)
+	reviewRepository := repository.NewReviewRepository(gormDB)
+	questionRepository := repository.NewQuestionRepository(gormDB)
+	questionUseCase := usecase.NewQuestionUseCase(questionRepository,reviewRepository,inventoryRepository)
+	questionHandler := handler.NewQuestionHandler(questionUseCase)
+	inventoryUseCase := usecase.NewInventoryUseCase(inventoryRepository,offerRepository,helper,wishlistRepository,questionUseCase,alertUseCase,jobUseCase)
 	inventoryHandler := handler.NewInventoryHandler(inventoryUseCase)
 
 	categoryRepository := repository.NewCategoryRepository(gormDB)
@@ -51,34 +84,60 @@ func InitializeAPI(cfg config.Config) (*http.ServerHTTP, error) {
 
 	otpRepository := repository.NewOtpRepository(gormDB)
 	otpUseCase := usecase.NewOtpUseCase(cfg, otpRepository,helper)
-	otpHandler := handler.NewOtpHandler(otpUseCase)
 
 
 	orderRepository := repository.NewOrderRepository(gormDB)
 
+	emailUseCase := usecase.NewEmailUseCase(emailRepository,mailer,helper,cfg)
+	emailHandler := handler.NewEmailHandler(emailUseCase)
+
 	userRepository := repository.NewUserRepository(gormDB)
-	userUseCase := usecase.NewUserUseCase(userRepository,cfg,otpRepository,inventoryRepository,orderRepository,helper)
-	userHandler := handler.NewUserHandler(userUseCase)
+	userUseCase := usecase.NewUserUseCase(userRepository,cfg,otpRepository,inventoryRepository,orderRepository,helper,emailUseCase)
+
+	identityRepository := repository.NewIdentityRepository(gormDB)
+	identityUseCase := usecase.NewIdentityUseCase(identityRepository,userRepository,orderRepository,googleProvider,helper)
+	identityHandler := handler.NewIdentityHandler(identityUseCase)
 
 	couponRepository := repository.NewCouponRepository(gormDB)
-	couponUseCase := usecase.NewCouponUseCase(couponRepository)
+	couponUseCase := usecase.NewCouponUseCase(couponRepository,jobUseCase)
 	couponHandler := handler.NewCouponHandler(couponUseCase)
 
-	orderUseCase := usecase.NewOrderUseCase(orderRepository,couponRepository,userUseCase)
+	shippingRepository := repository.NewShippingRepository(gormDB)
+	shippingUseCase := usecase.NewShippingUseCase(shippingRepository)
+	shippingHandler := handler.NewShippingHandler(shippingUseCase)
+
+	shipmentRepository := repository.NewShipmentRepository(gormDB)
+	shipmentUseCase := usecase.NewShipmentUseCase(shipmentRepository,orderRepository,courier,emailUseCase)
+	shipmentHandler := handler.NewShipmentHandler(shipmentUseCase)
+
+	invoiceRepository := repository.NewInvoiceRepository(gormDB)
+	invoiceUseCase := usecase.NewInvoiceUseCase(invoiceRepository,orderRepository,invoiceRenderer,cfg)
+	invoiceHandler := handler.NewInvoiceHandler(invoiceUseCase)
+
+	orderUseCase := usecase.NewOrderUseCase(orderRepository,couponRepository,userUseCase,emailUseCase,shipmentUseCase,shippingUseCase,invoiceUseCase,notificationUseCase,jobUseCase,cfg)
 	orderHandler := handler.NewOrderHandler(orderUseCase)
 
+	returnRepository := repository.NewReturnRepository(gormDB)
+	returnUseCase := usecase.NewReturnUseCase(returnRepository,orderRepository,offerRepository,orderUseCase,emailUseCase,notificationUseCase,helper,cfg)
+	returnHandler := handler.NewReturnHandler(returnUseCase)
+
+	reviewUseCase := usecase.NewReviewUseCase(reviewRepository,helper)
+	reviewHandler := handler.NewReviewHandler(reviewUseCase)
+
 
 	cartRepository := repository.NewCartRepository(gormDB)
-	cartUseCase := usecase.NewCartUseCase(cartRepository,inventoryRepository,userUseCase)
+	cartUseCase := usecase.NewCartUseCase(cartRepository,inventoryRepository,userUseCase,shippingUseCase,notificationUseCase,couponRepository,helper,jobUseCase,eventUseCase,cfg)
 	cartHandler := handler.NewCartHandler(cartUseCase)
+	userHandler := handler.NewUserHandler(userUseCase,cartUseCase)
+	otpHandler := handler.NewOtpHandler(otpUseCase,cartUseCase)
 
 
 	paymentRepository := repository.NewPaymentRepository(gormDB)
-	paymentUseCase := usecase.NewPaymentUseCase(paymentRepository)
+	paymentUseCase := usecase.NewPaymentUseCase(paymentRepository,cfg)
 	paymentHandler := handler.NewPaymentHandler(paymentUseCase)
 
-	
-	serverHTTP := http.NewServerHTTP(userHandler,adminHandler,categoryHandler,inventoryHandler,otpHandler,orderHandler,cartHandler,couponHandler,paymentHandler,offerHandler,wishlistHandler)
+
+	serverHTTP := http.NewServerHTTP(userHandler,adminHandler,categoryHandler,inventoryHandler,otpHandler,orderHandler,cartHandler,couponHandler,paymentHandler,offerHandler,wishlistHandler,emailHandler,identityHandler,shipmentHandler,shippingHandler,invoiceHandler,returnHandler,reviewHandler,questionHandler,alertHandler,notificationHandler,webhookHandler,jobHandler,userUseCase,helper,eventUseCase,webhookUseCase,jobUseCase)
 
 
 
@@ -7,10 +7,37 @@ type Admin struct {
 	Name     string `json:"name" gorm:"validate:required"`
 	Username string `json:"email" gorm:"validate:required"`
 	Password string `json:"password" gorm:"validate:required"`
+	// totp secret is kept until the admin confirms it with a code, only then is 2FA enabled
+	TotpSecret   string `json:"-"`
+	TotpEnabled  bool   `json:"totp_enabled" gorm:"default:false"`
+	TotpLastStep int64  `json:"-"`
+}
+
+type AdminRecoveryCode struct {
+	ID       uint   `json:"id" gorm:"primarykey"`
+	AdminID  uint   `json:"admin_id" gorm:"not null"`
+	Admin    Admin  `json:"-" gorm:"foreignkey:AdminID;constraint:OnDelete:CASCADE"`
+	CodeHash string `json:"-" gorm:"not null"`
+	Used     bool   `json:"used" gorm:"default:false"`
+}
+
+// AdminPolicy is a single row of settings that apply to every admin
+type AdminPolicy struct {
+	ID                uint `json:"id" gorm:"primarykey"`
+	TwoFactorRequired bool `json:"two_factor_required" gorm:"default:false"`
 }
 
 type TokenAdmin struct {
 	Admin        models.AdminDetailsResponse
 	AccessToken  string
 	RefreshToken string
+	// set instead of the tokens when the login still needs the second factor
+	TwoFactorRequired  bool   `json:",omitempty"`
+	EnrollmentRequired bool   `json:",omitempty"`
+	TwoFactorToken     string `json:",omitempty"`
+}
+
+type TwoFactorEnabled struct {
+	RecoveryCodes []string
+	Token         TokenAdmin
 }
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type Cart struct {
 	ID     uint  `json:"id" gorm:"primarykey"`
 	UserID uint  `json:"user_id" gorm:"not null"`
@@ -13,4 +15,46 @@ type LineItems struct {
 	InventoryID uint        `json:"inventory_id" gorm:"not null"`
 	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID;constraint:OnDelete:CASCADE"`
 	Quantity    int         `json:"quantity" gorm:"default:1"`
+	CreatedAt   time.Time   `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	// the cart was last touched at the latest of these, abandoned carts are found by it
+	UpdatedAt time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+	// price and offer when the line was added, the cart flags the line when they change. Lines from
+	// before these were kept have none and are never flagged
+	AddedPrice    *float64 `json:"added_price"`
+	AddedDiscount *int     `json:"added_discount"`
+}
+
+// CartReminder is an abandoned cart reminder, an order placed after it is put down to it
+type CartReminder struct {
+	ID     uint `json:"id" gorm:"primarykey"`
+	CartID uint `json:"cart_id" gorm:"not null;index"`
+	Cart   Cart `json:"-" gorm:"foreignkey:CartID;constraint:OnDelete:CASCADE"`
+	UserID uint `json:"user_id" gorm:"not null;index"`
+	// first, second and so on reminder since the cart was last touched
+	Step        int        `json:"step" gorm:"not null"`
+	CartValue   float64    `json:"cart_value"`
+	Sent        bool       `json:"sent"`
+	CouponID    *uint      `json:"coupon_id"`
+	Coupon      string     `json:"coupon"`
+	SentAt      time.Time  `json:"sent_at"`
+	OrderID     *uint      `json:"order_id"`
+	ConvertedAt *time.Time `json:"converted_at"`
+}
+
+// GuestCart is the cart of a visitor who has not logged in, they hold a signed token with its id
+type GuestCart struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	// carts not touched for a while are cleared out by it
+	UpdatedAt time.Time `json:"updated_at" gorm:"index"`
+}
+
+type GuestCartItem struct {
+	ID          uint        `json:"id" gorm:"primarykey"`
+	GuestCartID uint        `json:"guest_cart_id" gorm:"not null;uniqueIndex:idx_guest_cart_item"`
+	GuestCart   GuestCart   `json:"-" gorm:"foreignkey:GuestCartID;constraint:OnDelete:CASCADE"`
+	InventoryID uint        `json:"inventory_id" gorm:"not null;uniqueIndex:idx_guest_cart_item"`
+	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID;constraint:OnDelete:CASCADE"`
+	Quantity    int         `json:"quantity" gorm:"default:1"`
+	CreatedAt   time.Time   `json:"created_at"`
 }
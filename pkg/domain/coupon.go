@@ -1,10 +1,18 @@
 package domain
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Coupons struct {
 	gorm.Model
 	Coupon       string `json:"coupon" gorm:"unique;not null"`
 	DiscountRate int    `json:"discount_rate" gorm:"not null"`
 	Valid        bool   `json:"valid" gorm:"default:true"`
+	// the expiry job makes the coupon invalid once this passes
+	ExpiresAt *time.Time `json:"expires_at"`
+	// set on the recovery coupons sent with cart reminders, only that user can use it and only once
+	UserID *uint `json:"user_id"`
 }
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+type EmailVerification struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	UserID    uint      `json:"user_id" gorm:"not null"`
+	Users     Users     `json:"-" gorm:"foreignkey:UserID;constraint:OnDelete:CASCADE"`
+	TokenHash string    `json:"-" gorm:"unique;not null"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Used      bool      `json:"used" gorm:"default:false"`
+	// the address the link went to, it verifies only that one so a link to an old address is no use after a change
+	Email string `json:"email"`
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// OutboxEvent is written in the same transaction as the change it describes and delivered later
+type OutboxEvent struct {
+	ID            uint       `json:"id" gorm:"primarykey"`
+	Name          string     `json:"name" gorm:"not null"`
+	Payload       string     `json:"payload" gorm:"type:jsonb;not null"`
+	Status        string     `json:"status" gorm:"default:'PENDING';index;check:status IN ('PENDING','DONE','FAILED')"`
+	Attempts      int        `json:"attempts" gorm:"default:0"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" gorm:"index"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ProcessedAt   *time.Time `json:"processed_at"`
+}
+
+// EventDelivery records the subscribers an event already reached, so a retry only goes to the ones that failed
+type EventDelivery struct {
+	ID            uint        `json:"id" gorm:"primarykey"`
+	OutboxEventID uint        `json:"outbox_event_id" gorm:"not null;uniqueIndex:idx_event_delivery"`
+	OutboxEvent   OutboxEvent `json:"-" gorm:"foreignkey:OutboxEventID;constraint:OnDelete:CASCADE"`
+	Subscriber    string      `json:"subscriber" gorm:"not null;uniqueIndex:idx_event_delivery"`
+	DeliveredAt   time.Time   `json:"delivered_at"`
+}
+
+// WebhookEndpoint gets the events listed in Events, a comma separated list of event names
+type WebhookEndpoint struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	URL       string    `json:"url" gorm:"not null"`
+	Secret    string    `json:"-" gorm:"not null"`
+	Events    string    `json:"events" gorm:"not null"`
+	Active    bool      `json:"active" gorm:"default:true"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookDelivery is the delivery log, a replay is a new delivery pointing at the one it repeats
+type WebhookDelivery struct {
+	ID                uint            `json:"id" gorm:"primarykey"`
+	WebhookEndpointID uint            `json:"webhook_endpoint_id" gorm:"not null;index"`
+	WebhookEndpoint   WebhookEndpoint `json:"-" gorm:"foreignkey:WebhookEndpointID;constraint:OnDelete:CASCADE"`
+	Event             string          `json:"event" gorm:"not null"`
+	OutboxEventID     *uint           `json:"outbox_event_id" gorm:"index"`
+	ReplayOfID        *uint           `json:"replay_of_id"`
+	Payload           string          `json:"payload" gorm:"type:jsonb;not null"`
+	Status            string          `json:"status" gorm:"default:'PENDING';index;check:status IN ('PENDING','DELIVERED','FAILED')"`
+	Attempts          int             `json:"attempts" gorm:"default:0"`
+	ResponseCode      int             `json:"response_code"`
+	ResponseBody      string          `json:"response_body"`
+	LastError         string          `json:"last_error"`
+	NextAttemptAt     time.Time       `json:"next_attempt_at" gorm:"index"`
+	CreatedAt         time.Time       `json:"created_at"`
+	DeliveredAt       *time.Time      `json:"delivered_at"`
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// UserIdentity links a user to an account at an external login provider like google
+type UserIdentity struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	UserID    uint      `json:"user_id" gorm:"not null"`
+	Users     Users     `json:"-" gorm:"foreignkey:UserID;constraint:OnDelete:CASCADE"`
+	Provider  string    `json:"provider" gorm:"not null;uniqueIndex:idx_provider_subject"`
+	Subject   string    `json:"-" gorm:"not null;uniqueIndex:idx_provider_subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OidcLoginState keeps the PKCE verifier and nonce between the redirect to the provider and the callback
+type OidcLoginState struct {
+	ID           uint      `json:"id" gorm:"primarykey"`
+	State        string    `json:"-" gorm:"unique;not null"`
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"-"`
+	Nonce        string    `json:"-"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
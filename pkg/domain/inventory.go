@@ -12,7 +12,9 @@ type Inventories struct {
 }
 
 type Category struct {
-	ID       uint   `json:"id" gorm:"unique;not null"`
-	Category string `json:"category"`
-	Image    string `json:"category_image"`
+	ID       uint    `json:"id" gorm:"unique;not null"`
+	Category string  `json:"category"`
+	Image    string  `json:"category_image"`
+	HsnCode  string  `json:"hsn_code"`
+	GstRate  float64 `json:"gst_rate"`
 }
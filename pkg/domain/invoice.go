@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// Invoice is created the first time an order's invoice is downloaded, the number never changes after that
+type Invoice struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	OrderID       uint      `json:"order_id" gorm:"uniqueIndex;not null"`
+	Order         Order     `json:"-" gorm:"foreignkey:OrderID;constraint:OnDelete:CASCADE"`
+	InvoiceNumber string    `json:"invoice_number" gorm:"uniqueIndex;not null"`
+	FinancialYear string    `json:"financial_year" gorm:"not null"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// InvoiceSequence keeps the last invoice number used in each financial year so numbers have no gaps
+type InvoiceSequence struct {
+	FinancialYear string `json:"financial_year" gorm:"primarykey"`
+	LastNumber    int    `json:"last_number"`
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Job is a unit of background work, a job that used up its attempts is left DEAD until an admin retries it
+type Job struct {
+	ID          uint       `json:"id" gorm:"primarykey"`
+	Name        string     `json:"name" gorm:"not null;index"`
+	Payload     string     `json:"payload" gorm:"type:jsonb;not null"`
+	Status      string     `json:"status" gorm:"default:'QUEUED';index;check:status IN ('QUEUED','RUNNING','DONE','DEAD')"`
+	Attempts    int        `json:"attempts" gorm:"default:0"`
+	MaxAttempts int        `json:"max_attempts" gorm:"default:5"`
+	LastError   string     `json:"last_error"`
+	RunAt       time.Time  `json:"run_at" gorm:"index"`
+	LockedUntil *time.Time `json:"locked_until"`
+	// scheduled runs are keyed by job and time, so two servers do not queue the same run
+	UniqueKey  *string    `json:"unique_key" gorm:"unique"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// ProductAlert is a users subscription to a product, Price is the price the next drop is measured against
+type ProductAlert struct {
+	ID             uint        `json:"id" gorm:"primarykey"`
+	UserID         uint        `json:"user_id" gorm:"not null;uniqueIndex:idx_product_alert"`
+	Users          Users       `json:"-" gorm:"foreignkey:UserID"`
+	InventoryID    uint        `json:"inventory_id" gorm:"not null;uniqueIndex:idx_product_alert"`
+	Inventories    Inventories `json:"-" gorm:"foreignkey:InventoryID;constraint:OnDelete:CASCADE"`
+	Kind           string      `json:"kind" gorm:"not null;uniqueIndex:idx_product_alert;check:kind IN ('BACK_IN_STOCK','PRICE_DROP')"`
+	Price          float64     `json:"price"`
+	Active         bool        `json:"active" gorm:"default:true"`
+	LastNotifiedAt *time.Time  `json:"last_notified_at"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// Notification is the in-app copy of everything sent to a user, DedupeKey stops the same event going out twice
+type Notification struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+	Users     Users     `json:"-" gorm:"foreignkey:UserID"`
+	Category  string    `json:"category" gorm:"not null"`
+	Title     string    `json:"title" gorm:"not null"`
+	Message   string    `json:"message"`
+	Link      string    `json:"link"`
+	DedupeKey string    `json:"-" gorm:"index"`
+	Read      bool      `json:"read" gorm:"default:false"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NotificationPreference is only stored once the user changes a category, everything is on by default
+type NotificationPreference struct {
+	ID       uint   `json:"id" gorm:"primarykey"`
+	UserID   uint   `json:"user_id" gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Users    Users  `json:"-" gorm:"foreignkey:UserID"`
+	Category string `json:"category" gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Enabled  bool   `json:"enabled" gorm:"default:true"`
+	Email    bool   `json:"email" gorm:"default:true"`
+}
@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 type Offer struct {
 	ID           int      `json:"id" gorm:"unique;not null"`
 	CategoryID   int      `json:"category_id"`
 	Category     Category `json:"-" gorm:"foreignkey:CategoryID;constraint:OnDelete:CASCADE"`
 	DiscountRate int      `json:"discount_rate"`
 	Valid        bool     `gorm:"default:True"`
+	// the offer is removed by the expiry job once this passes, no expiry keeps it until an admin removes it
+	ExpiresAt *time.Time `json:"expires_at"`
 }
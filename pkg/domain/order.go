@@ -1,11 +1,17 @@
 package domain
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type PaymentMethod struct {
 	ID           uint   `gorm:"primarykey"`
 	Payment_Name string `json:"payment_name"`
 	IsDeleted    bool   `json:"is_deleted" gorm:"default:false"`
+	// cash on delivery methods get the COD surcharge of the shipping zone
+	CashOnDelivery bool `json:"cash_on_delivery" gorm:"default:false"`
 }
 
 type Order struct {
@@ -18,8 +24,23 @@ type Order struct {
 	PaymentMethod   PaymentMethod `json:"-" gorm:"foreignkey:PaymentMethodID"`
 	CouponUsed      string        `json:"coupon_used" gorm:"default:null"`
 	FinalPrice      float64       `json:"price"`
+	ShippingCharge  float64       `json:"shipping_charge" gorm:"default:0"`
+	CodCharge       float64       `json:"cod_charge" gorm:"default:0"`
+	RefundedAmount  float64       `json:"refunded_amount" gorm:"default:0"`
 	OrderStatus     string        `json:"order_status" gorm:"order_status:4;default:'PENDING';check:order_status IN ('PENDING', 'SHIPPED','DELIVERED','CANCELED','RETURNED')"`
 	PaymentStatus   string        `json:"payment_status" gorm:"payment_status:2;default:'NOT PAID';check:payment_status IN ('PAID', 'NOT PAID')"`
+	// shipping address as it was at checkout, editing the address later does not touch the order
+	ShipName      string `json:"ship_name"`
+	ShipHouseName string `json:"ship_house_name"`
+	ShipStreet    string `json:"ship_street"`
+	ShipCity      string `json:"ship_city"`
+	ShipState     string `json:"ship_state"`
+	ShipPin       string `json:"ship_pin"`
+	ShipPhone     string `json:"ship_phone"`
+	// the return window counts from here
+	DeliveredAt *time.Time `json:"delivered_at"`
+	// set on orders shipped in exchange for items of another order
+	ReplacementForID *uint `json:"replacement_for_id"`
 }
 
 type OrderItem struct {
@@ -28,8 +49,25 @@ type OrderItem struct {
 	Order       Order       `json:"-" gorm:"foreignkey:OrderID;constraint:OnDelete:CASCADE"`
 	InventoryID uint        `json:"inventory_id"`
 	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID"`
-	Quantity    int         `json:"quantity"`
-	TotalPrice  float64     `json:"total_price"`
+	// product as it was at checkout
+	ProductName  string  `json:"product_name"`
+	Image        string  `json:"image"`
+	Price        float64 `json:"price"`
+	Quantity     int     `json:"quantity"`
+	TotalPrice   float64 `json:"total_price"`
+	HsnCode      string  `json:"hsn_code"`
+	GstRate      float64 `json:"gst_rate"`
+	TaxableValue float64 `json:"taxable_value"`
+	Cgst         float64 `json:"cgst"`
+	Sgst         float64 `json:"sgst"`
+	Igst         float64 `json:"igst"`
+	// items can be canceled or returned a few at a time, the order status follows its items
+	CanceledQuantity int     `json:"canceled_quantity" gorm:"default:0"`
+	ReturnedQuantity int     `json:"returned_quantity" gorm:"default:0"`
+	RefundedAmount   float64 `json:"refunded_amount" gorm:"default:0"`
+	ItemStatus       string  `json:"item_status" gorm:"default:'PENDING';check:item_status IN ('PENDING', 'SHIPPED','DELIVERED','CANCELED','RETURNED')"`
+	// units taken off the stock at checkout, for cash on delivery and online orders alike, canceling puts them back
+	ReservedQuantity int `json:"reserved_quantity" gorm:"default:0"`
 }
 
 type AdminOrdersResponse struct {
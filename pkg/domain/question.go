@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type ProductQuestion struct {
+	ID          uint        `json:"id" gorm:"primarykey"`
+	InventoryID uint        `json:"inventory_id" gorm:"not null"`
+	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID;constraint:OnDelete:CASCADE"`
+	UserID      uint        `json:"user_id" gorm:"not null"`
+	Users       Users       `json:"-" gorm:"foreignkey:UserID"`
+	Question    string      `json:"question" gorm:"not null"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// ProductAnswer comes from an admin or from a user who bought the product, UserID is empty for admins
+type ProductAnswer struct {
+	ID                uint            `json:"id" gorm:"primarykey"`
+	ProductQuestionID uint            `json:"product_question_id" gorm:"not null"`
+	ProductQuestion   ProductQuestion `json:"-" gorm:"foreignkey:ProductQuestionID;constraint:OnDelete:CASCADE"`
+	UserID            *uint           `json:"user_id"`
+	Users             Users           `json:"-" gorm:"foreignkey:UserID"`
+	ByAdmin           bool            `json:"by_admin" gorm:"default:false"`
+	Answer            string          `json:"answer" gorm:"not null"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+type AnswerVote struct {
+	ID              uint          `json:"id" gorm:"primarykey"`
+	ProductAnswerID uint          `json:"product_answer_id" gorm:"not null;uniqueIndex:idx_answer_vote"`
+	ProductAnswer   ProductAnswer `json:"-" gorm:"foreignkey:ProductAnswerID;constraint:OnDelete:CASCADE"`
+	UserID          uint          `json:"user_id" gorm:"not null;uniqueIndex:idx_answer_vote"`
+	Users           Users         `json:"-" gorm:"foreignkey:UserID"`
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// ReturnRequest goes requested -> approved -> pickup scheduled -> received and is refunded once the
+// items pass inspection, it can be rejected on the way. An exchange goes the same way but ships a
+// replacement order for another size when approved instead of refunding
+type ReturnRequest struct {
+	ID           uint       `json:"id" gorm:"primarykey"`
+	OrderID      uint       `json:"order_id" gorm:"not null"`
+	Order        Order      `json:"-" gorm:"foreignkey:OrderID;constraint:OnDelete:CASCADE"`
+	UserID       uint       `json:"user_id" gorm:"not null"`
+	Users        Users      `json:"-" gorm:"foreignkey:UserID"`
+	ReasonCode   string     `json:"reason_code" gorm:"not null;check:reason_code IN ('WRONG_SIZE','DAMAGED','DEFECTIVE','WRONG_ITEM','NOT_AS_DESCRIBED','CHANGED_MIND','OTHER')"`
+	Comments     string     `json:"comments" gorm:"default:''"`
+	Status       string     `json:"status" gorm:"default:'REQUESTED';check:status IN ('REQUESTED','APPROVED','REJECTED','PICKUP_SCHEDULED','RECEIVED','REFUNDED','EXCHANGED','INSPECTION_FAILED')"`
+	AdminNote    string     `json:"admin_note" gorm:"default:''"`
+	PickupDate   *time.Time `json:"pickup_date"`
+	RefundAmount float64    `json:"refund_amount" gorm:"default:0"`
+	Kind         string     `json:"kind" gorm:"default:'RETURN';check:kind IN ('RETURN','EXCHANGE')"`
+	// size wanted instead, its stock is held from the request until it is rejected
+	ExchangeInventoryID *uint       `json:"exchange_inventory_id"`
+	ExchangeInventory   Inventories `json:"-" gorm:"foreignkey:ExchangeInventoryID"`
+	// positive is charged on the replacement order, negative is refunded once the item is back
+	PriceDifference    float64   `json:"price_difference" gorm:"default:0"`
+	ReplacementOrderID *uint     `json:"replacement_order_id"`
+	ReplacementOrder   Order     `json:"-" gorm:"foreignkey:ReplacementOrderID"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type ReturnRequestItem struct {
+	ID              uint          `json:"id" gorm:"primarykey"`
+	ReturnRequestID uint          `json:"return_request_id" gorm:"not null"`
+	ReturnRequest   ReturnRequest `json:"-" gorm:"foreignkey:ReturnRequestID;constraint:OnDelete:CASCADE"`
+	OrderItemID     uint          `json:"order_item_id" gorm:"not null"`
+	OrderItem       OrderItem     `json:"-" gorm:"foreignkey:OrderItemID"`
+	Quantity        int           `json:"quantity"`
+}
+
+type ReturnRequestImage struct {
+	ID              uint          `json:"id" gorm:"primarykey"`
+	ReturnRequestID uint          `json:"return_request_id" gorm:"not null"`
+	ReturnRequest   ReturnRequest `json:"-" gorm:"foreignkey:ReturnRequestID;constraint:OnDelete:CASCADE"`
+	Url             string        `json:"url"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Review of a product by a user who received it, it shows up on the product once an admin approves it
+type Review struct {
+	ID          uint        `json:"id" gorm:"primarykey"`
+	InventoryID uint        `json:"inventory_id" gorm:"not null;uniqueIndex:idx_review_user_product"`
+	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID;constraint:OnDelete:CASCADE"`
+	UserID      uint        `json:"user_id" gorm:"not null;uniqueIndex:idx_review_user_product"`
+	Users       Users       `json:"-" gorm:"foreignkey:UserID"`
+	Rating      int         `json:"rating" gorm:"not null;check:rating BETWEEN 1 AND 5"`
+	Title       string      `json:"title" gorm:"default:''"`
+	Comment     string      `json:"comment" gorm:"default:''"`
+	Status      string      `json:"status" gorm:"default:'PENDING';check:status IN ('PENDING','APPROVED','HIDDEN')"`
+	AdminReply  string      `json:"admin_reply" gorm:"default:''"`
+	RepliedAt   *time.Time  `json:"replied_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type ReviewImage struct {
+	ID       uint   `json:"id" gorm:"primarykey"`
+	ReviewID uint   `json:"review_id" gorm:"not null"`
+	Review   Review `json:"-" gorm:"foreignkey:ReviewID;constraint:OnDelete:CASCADE"`
+	Url      string `json:"url"`
+}
+
+// ReviewVote is a user finding a review helpful, once per review
+type ReviewVote struct {
+	ID       uint   `json:"id" gorm:"primarykey"`
+	ReviewID uint   `json:"review_id" gorm:"not null;uniqueIndex:idx_review_vote"`
+	Review   Review `json:"-" gorm:"foreignkey:ReviewID;constraint:OnDelete:CASCADE"`
+	UserID   uint   `json:"user_id" gorm:"not null;uniqueIndex:idx_review_vote"`
+	Users    Users  `json:"-" gorm:"foreignkey:UserID"`
+}
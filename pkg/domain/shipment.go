@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+type Shipment struct {
+	ID               uint      `json:"id" gorm:"primarykey"`
+	OrderID          uint      `json:"order_id" gorm:"not null"`
+	Order            Order     `json:"-" gorm:"foreignkey:OrderID;constraint:OnDelete:CASCADE"`
+	Courier          string    `json:"courier" gorm:"not null"`
+	TrackingNumber   string    `json:"tracking_number" gorm:"not null"`
+	Status           string    `json:"status" gorm:"default:'BOOKED';check:status IN ('BOOKED','PICKED_UP','IN_TRANSIT','OUT_FOR_DELIVERY','DELIVERY_FAILED','DELIVERED')"`
+	ExpectedDelivery time.Time `json:"expected_delivery"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ShipmentItem is the part of an order item sent in a shipment, an order item can be split over shipments
+type ShipmentItem struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	ShipmentID  uint      `json:"shipment_id" gorm:"not null"`
+	Shipment    Shipment  `json:"-" gorm:"foreignkey:ShipmentID;constraint:OnDelete:CASCADE"`
+	OrderItemID uint      `json:"order_item_id" gorm:"not null"`
+	OrderItem   OrderItem `json:"-" gorm:"foreignkey:OrderItemID"`
+	Quantity    int       `json:"quantity"`
+}
+
+type ShipmentEvent struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	ShipmentID  uint      `json:"shipment_id" gorm:"not null"`
+	Shipment    Shipment  `json:"-" gorm:"foreignkey:ShipmentID;constraint:OnDelete:CASCADE"`
+	Status      string    `json:"status"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+// ShippingZone holds the rates for the areas listed against it in ShippingZoneArea
+type ShippingZone struct {
+	ID                uint    `json:"id" gorm:"primarykey"`
+	Name              string  `json:"name" gorm:"not null"`
+	FlatRate          float64 `json:"flat_rate"`
+	PerItemRate       float64 `json:"per_item_rate"`
+	FreeShippingAbove float64 `json:"free_shipping_above"`
+	CodSurcharge      float64 `json:"cod_surcharge"`
+	// the default zone is used for addresses no other zone covers
+	IsDefault bool `json:"is_default" gorm:"default:false"`
+	IsDeleted bool `json:"is_deleted" gorm:"default:false"`
+}
+
+// ShippingZoneArea is either a state or a PIN code prefix, a matching prefix wins over the state
+type ShippingZoneArea struct {
+	ID        uint         `json:"id" gorm:"primarykey"`
+	ZoneID    uint         `json:"zone_id" gorm:"not null"`
+	Zone      ShippingZone `json:"-" gorm:"foreignkey:ZoneID;constraint:OnDelete:CASCADE"`
+	State     string       `json:"state"`
+	PinPrefix string       `json:"pin_prefix"`
+}
+
+// ServiceablePin is one PIN code we deliver to, once the table has rows every other PIN is refused
+type ServiceablePin struct {
+	Pin            string `json:"pin" gorm:"primarykey;size:6"`
+	CodAllowed     bool   `json:"cod_allowed" gorm:"default:false"`
+	PrepaidAllowed bool   `json:"prepaid_allowed" gorm:"default:true"`
+	DeliveryDays   int    `json:"delivery_days"`
+}
@@ -1,14 +1,19 @@
 package domain
 
+import "time"
+
 type Users struct {
-	ID           uint   `json:"id" gorm:"unique;not null"`
-	Name         string `json:"name"`
-	Email        string `json:"email" validate:"email"`
-	Password     string `json:"password" validate:"min=8,max=20"`
-	Phone        string `json:"phone"`
-	Blocked      bool   `json:"blocked" gorm:"default:false"`
-	IsAdmin      bool   `json:"is_admin" gorm:"default:false"`
-	ReferralCode string `json:"referral_code"`
+	ID            uint   `json:"id" gorm:"unique;not null"`
+	Name          string `json:"name"`
+	Email         string `json:"email" validate:"email"`
+	Password      string `json:"password" validate:"min=8,max=20"`
+	Phone         string `json:"phone"`
+	Blocked       bool   `json:"blocked" gorm:"default:false"`
+	IsAdmin       bool   `json:"is_admin" gorm:"default:false"`
+	ReferralCode  string `json:"referral_code"`
+	EmailVerified bool   `json:"email_verified" gorm:"default:false"`
+	// tokens issued before this moment are rejected by the auth middleware
+	SessionsRevokedAt *time.Time `json:"-"`
 }
 
 type Address struct {
@@ -23,4 +28,15 @@ type Address struct {
 	Phone     string `json:"phone" gorm:"phone"`
 	Pin       string `json:"pin" validate:"required"`
 	Default   bool   `json:"default" gorm:"default:false"`
+	// addresses used by orders are only hidden on delete so the order history still has them
+	IsDeleted bool `json:"-" gorm:"default:false"`
+}
+
+type PasswordReset struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	UserID    uint      `json:"user_id" gorm:"not null"`
+	Users     Users     `json:"-" gorm:"foreignkey:UserID;constraint:OnDelete:CASCADE"`
+	TokenHash string    `json:"-" gorm:"unique;not null"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Used      bool      `json:"used" gorm:"default:false"`
 }
@@ -7,4 +7,6 @@ type Wishlist struct {
 	InventoryID uint        `json:"inventory_id" gorm:"not null"`
 	Inventories Inventories `json:"-" gorm:"foreignkey:InventoryID"`
 	IsDeleted   bool        `json:"is_deleted" gorm:"default:false"`
+	// price after the offer when it was saved, the wishlist shows how it moved since
+	AddedPrice *float64 `json:"added_price"`
 }
@@ -22,7 +22,9 @@ import (
 	twilioApi "github.com/twilio/twilio-go/rest/verify/v2"
 
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base32"
+	"encoding/hex"
 )
 
 type helper struct {
@@ -178,6 +180,51 @@ func (h *helper) GenerateTokenClients(user models.UserDetailsResponse) (string,
 	return tokenString, nil
 }
 
+type GuestCartClaims struct {
+	CartID int `json:"cart_id"`
+	jwt.StandardClaims
+}
+
+// GenerateTokenGuestCart signs the id of a guest cart, the visitor keeps it in place of an account
+func (h *helper) GenerateTokenGuestCart(cartID int, expiresAt time.Time) (string, error) {
+
+	if h.cfg.CART_TOKEN_SECRET == "" {
+		return "", errors.New("guest carts are not set up")
+	}
+
+	claims := &GuestCartClaims{
+		CartID: cartID,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: expiresAt.Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(h.cfg.CART_TOKEN_SECRET))
+}
+
+// ParseTokenGuestCart gives the cart id of a guest cart token that is signed by us and not expired
+func (h *helper) ParseTokenGuestCart(tokenString string) (int, error) {
+
+	if h.cfg.CART_TOKEN_SECRET == "" {
+		return 0, errors.New("guest carts are not set up")
+	}
+
+	claims := &GuestCartClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(h.cfg.CART_TOKEN_SECRET), nil
+	})
+	if err != nil || !token.Valid || claims.CartID <= 0 {
+		return 0, errors.New("cart token is not valid")
+	}
+
+	return claims.CartID, nil
+}
+
 func (h *helper) GenerateRefferalCode() (string, error) {
 	// Calculate the required number of random bytes
 	byteLength := (5 * 5) / 8
@@ -199,6 +246,23 @@ func (h *helper) GenerateRefferalCode() (string, error) {
 	return encoded, nil
 }
 
+// GenerateSecureToken creates a random url safe token, used for links sent through mail
+func (h *helper) GenerateSecureToken() (string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(randomBytes), nil
+}
+
+// HashToken is used so that only the hash of a mailed token is stored in the database
+func (h *helper) HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
 func (h *helper) PasswordHashing(password string) (string, error) {
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 10)
@@ -3,16 +3,27 @@ package interfaces
 import (
 	"jerseyhub/pkg/utils/models"
 	"mime/multipart"
+	"time"
 )
 
 type Helper interface {
 	GenerateTokenAdmin(admin models.AdminDetailsResponse) (string, string, error)
+	GenerateTokenAdminTwoFactor(admin models.AdminDetailsResponse) (string, error)
+	ParseTokenAdminTwoFactor(token string) (int, error)
 	AddImageToS3(file *multipart.FileHeader) (string, error)
 	TwilioSetup(username string, password string)
 	TwilioSendOTP(phone string, serviceID string) (string, error)
 	TwilioVerifyOTP(serviceID string, code string, phone string) error
 	GenerateTokenClients(user models.UserDetailsResponse) (string, error)
+	GenerateTokenGuestCart(cartID int, expiresAt time.Time) (string, error)
+	ParseTokenGuestCart(token string) (int, error)
 	GenerateRefferalCode() (string, error)
+	GenerateSecureToken() (string, error)
+	HashToken(token string) string
+	GenerateTOTPSecret() (string, error)
+	TOTPProvisioningURI(secret string, account string) string
+	ValidateTOTP(secret string, code string) (int64, bool)
+	GenerateRecoveryCodes(count int) ([]string, error)
 	PasswordHashing(string) (string, error)
 	CompareHashAndPassword(a string, b string) error
 	Copy(a *models.UserDetailsResponse, b *models.UserSignInResponse) (models.UserDetailsResponse, error)
@@ -0,0 +1,145 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"jerseyhub/pkg/utils/models"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// totp settings are the defaults every authenticator app understands, RFC 6238 with SHA1
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	totpIssuer = "jerseyhub"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (h *helper) GenerateTOTPSecret() (string, error) {
+	randomBytes := make([]byte, 20)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(randomBytes), nil
+}
+
+// TOTPProvisioningURI is the otpauth uri that authenticator apps read from the QR code
+func (h *helper) TOTPProvisioningURI(secret string, account string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", totpIssuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	label := url.PathEscape(totpIssuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// ValidateTOTP returns the time step the code belongs to, one step of clock drift is allowed on both sides
+func (h *helper) ValidateTOTP(secret string, code string) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(key) == 0 || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := time.Now().Unix() / totpPeriod
+	for step := current - 1; step <= current+1; step++ {
+		if hmac.Equal([]byte(totpCode(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+// GenerateRecoveryCodes creates one time codes in the form xxxxx-xxxxx for admins who lose their authenticator
+func (h *helper) GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		randomBytes := make([]byte, 5)
+		_, err := rand.Read(randomBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		code := hex.EncodeToString(randomBytes)
+		codes = append(codes, code[:5]+"-"+code[5:])
+	}
+
+	return codes, nil
+}
+
+// GenerateTokenAdminTwoFactor is given after the password check, it is signed with its own secret so the admin routes never accept it
+func (h *helper) GenerateTokenAdminTwoFactor(admin models.AdminDetailsResponse) (string, error) {
+
+	if h.cfg.TWO_FACTOR_SECRET == "" {
+		return "", errors.New("two factor authentication is not set up")
+	}
+
+	claims := &AuthCustomClaims{
+		Id:    admin.ID,
+		Email: admin.Email,
+		Role:  "admin_2fa",
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(time.Minute * 5).Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(h.cfg.TWO_FACTOR_SECRET))
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// ParseTokenAdminTwoFactor gives the admin id of a two factor token that is signed by us and not expired
+func (h *helper) ParseTokenAdminTwoFactor(tokenString string) (int, error) {
+
+	if h.cfg.TWO_FACTOR_SECRET == "" {
+		return 0, errors.New("two factor authentication is not set up")
+	}
+
+	claims := &AuthCustomClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(h.cfg.TWO_FACTOR_SECRET), nil
+	})
+	if err != nil || !token.Valid || claims.Role != "admin_2fa" || claims.Id <= 0 {
+		return 0, errors.New("two factor token is not valid")
+	}
+
+	return claims.Id, nil
+}
@@ -0,0 +1,181 @@
+package helper
+
+import (
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_totpCode(t *testing.T) {
+
+	// the SHA1 test vectors of RFC 6238, cut down to 6 digits
+	key := []byte("12345678901234567890")
+
+	testData := map[string]struct {
+		unix     int64
+		expected string
+	}{
+		"time 59":          {unix: 59, expected: "287082"},
+		"time 1111111109":  {unix: 1111111109, expected: "081804"},
+		"time 1111111111":  {unix: 1111111111, expected: "050471"},
+		"time 1234567890":  {unix: 1234567890, expected: "005924"},
+		"time 2000000000":  {unix: 2000000000, expected: "279037"},
+		"time 20000000000": {unix: 20000000000, expected: "353130"},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, totpCode(key, test.unix/totpPeriod))
+		})
+	}
+}
+
+func Test_ValidateTOTP(t *testing.T) {
+
+	h := NewHelper(config.Config{})
+
+	secret, err := h.GenerateTOTPSecret()
+	assert.NoError(t, err)
+
+	key, err := totpEncoding.DecodeString(secret)
+	assert.NoError(t, err)
+
+	current := time.Now().Unix() / totpPeriod
+
+	testData := map[string]struct {
+		secret       string
+		code         string
+		expectedStep int64
+		expectedOk   bool
+	}{
+		"code of the current step": {
+			secret:       secret,
+			code:         totpCode(key, current),
+			expectedStep: current,
+			expectedOk:   true,
+		},
+		"code of the step before": {
+			secret:       secret,
+			code:         totpCode(key, current-1),
+			expectedStep: current - 1,
+			expectedOk:   true,
+		},
+		"code from two steps back": {
+			secret:     secret,
+			code:       totpCode(key, current-2),
+			expectedOk: false,
+		},
+		"lower case secret": {
+			secret:       strings.ToLower(secret),
+			code:         totpCode(key, current),
+			expectedStep: current,
+			expectedOk:   true,
+		},
+		"code of the wrong length": {
+			secret:     secret,
+			code:       totpCode(key, current)[:5],
+			expectedOk: false,
+		},
+		"secret that is not base32": {
+			secret:     "not base32!",
+			code:       "123456",
+			expectedOk: false,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			step, ok := h.ValidateTOTP(test.secret, test.code)
+			assert.Equal(t, test.expectedOk, ok)
+			if ok {
+				// the step may have moved on while the test ran
+				assert.InDelta(t, test.expectedStep, step, 1)
+			}
+		})
+	}
+}
+
+func Test_TOTPProvisioningURI(t *testing.T) {
+
+	h := NewHelper(config.Config{})
+
+	uri := h.TOTPProvisioningURI("JBSWY3DPEHPK3PXP", "admin@jerseyhub.com")
+	assert.Equal(t, "otpauth://totp/jerseyhub:admin@jerseyhub.com?algorithm=SHA1&digits=6&issuer=jerseyhub&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
+
+func Test_GenerateRecoveryCodes(t *testing.T) {
+
+	h := NewHelper(config.Config{})
+
+	codes, err := h.GenerateRecoveryCodes(10)
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		assert.Regexp(t, "^[0-9a-f]{5}-[0-9a-f]{5}$", code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+}
+
+func Test_ParseTokenAdminTwoFactor(t *testing.T) {
+
+	admin := models.AdminDetailsResponse{ID: 3, Name: "Admin", Email: "admin@jerseyhub.com"}
+	h := NewHelper(config.Config{TWO_FACTOR_SECRET: "configured secret"})
+
+	pending, err := h.GenerateTokenAdminTwoFactor(admin)
+	assert.NoError(t, err)
+
+	access, _, err := h.GenerateTokenAdmin(admin)
+	assert.NoError(t, err)
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AuthCustomClaims{
+		Id:             3,
+		Role:           "admin_2fa",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Minute).Unix()},
+	}).SignedString([]byte("twofactorsecret"))
+	assert.NoError(t, err)
+
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &AuthCustomClaims{
+		Id:             3,
+		Role:           "admin_2fa",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+	}).SignedString([]byte("configured secret"))
+	assert.NoError(t, err)
+
+	testData := map[string]struct {
+		token         string
+		expectedID    int
+		expectedError bool
+	}{
+		"token from the password check": {token: pending, expectedID: 3},
+		"signed with another secret":    {token: forged, expectedError: true},
+		"expired token":                 {token: expired, expectedError: true},
+		"admin access token":            {token: access, expectedError: true},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			id, err := h.ParseTokenAdminTwoFactor(test.token)
+			assert.Equal(t, test.expectedID, id)
+			assert.Equal(t, test.expectedError, err != nil)
+		})
+	}
+}
+
+func Test_TwoFactorSecretNotSet(t *testing.T) {
+
+	h := NewHelper(config.Config{})
+
+	_, err := h.GenerateTokenAdminTwoFactor(models.AdminDetailsResponse{ID: 3})
+	assert.EqualError(t, err, "two factor authentication is not set up")
+
+	_, err = h.ParseTokenAdminTwoFactor("token")
+	assert.EqualError(t, err, "two factor authentication is not set up")
+}
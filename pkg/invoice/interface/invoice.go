@@ -0,0 +1,9 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+// Renderer turns an invoice into a document the customer can download
+type Renderer interface {
+	HTML(invoice models.Invoice) ([]byte, error)
+	PDF(invoice models.Invoice) ([]byte, error)
+}
@@ -0,0 +1,33 @@
+package invoice
+
+import (
+	"bytes"
+	"html/template"
+	"path/filepath"
+
+	interfaces "jerseyhub/pkg/invoice/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+// the html invoice lives with the other templates
+var templateDir = "templates"
+
+type renderer struct{}
+
+func NewRenderer() interfaces.Renderer {
+	return &renderer{}
+}
+
+func (r *renderer) HTML(invoice models.Invoice) ([]byte, error) {
+	tmpl, err := template.ParseFiles(filepath.Join(templateDir, "invoice.html"))
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, invoice); err != nil {
+		return nil, err
+	}
+
+	return body.Bytes(), nil
+}
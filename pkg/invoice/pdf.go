@@ -0,0 +1,111 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+func (r *renderer) PDF(invoice models.Invoice) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(12, 12, 12)
+	pdf.AddPage()
+	// core fonts only know latin-1, names typed in other scripts would come out garbled without this
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 8, "Tax Invoice", "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, tr(invoice.SellerName), "", 1, "L", false, 0, "")
+	pdf.MultiCell(0, 5, tr(invoice.SellerAddress), "", "L", false)
+	pdf.CellFormat(0, 5, "GSTIN: "+invoice.SellerGstin, "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+
+	pdf.CellFormat(95, 5, "Invoice No: "+invoice.InvoiceNumber, "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, "Order No: "+fmt.Sprint(invoice.Order.OrderID), "", 1, "L", false, 0, "")
+	pdf.CellFormat(95, 5, "Invoice Date: "+invoice.InvoiceDate.Format("02 Jan 2006"), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, "Order Date: "+invoice.Customer.OrderedAt.Format("02 Jan 2006"), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(0, 5, "Bill To / Ship To", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, tr(invoice.Customer.Name), "", 1, "L", false, 0, "")
+	pdf.MultiCell(0, 5, tr(invoice.Order.Address), "", "L", false)
+	pdf.CellFormat(0, 5, "Phone: "+invoice.Order.Phone, "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, "Place of Supply: "+tr(invoice.Customer.State), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+
+	header := []string{"Item", "HSN", "Qty", "Taxable", "GST %", "CGST", "SGST", "Total"}
+	if invoice.Interstate {
+		header = []string{"Item", "HSN", "Qty", "Taxable", "GST %", "IGST", "Total"}
+	}
+	widths := map[string]float64{"Item": 60, "HSN": 18, "Qty": 10, "Taxable": 22, "GST %": 14, "CGST": 18, "SGST": 18, "IGST": 36, "Total": 26}
+
+	pdf.SetFont("Helvetica", "B", 9)
+	for _, h := range header {
+		pdf.CellFormat(widths[h], 7, h, "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, item := range invoice.Items {
+		values := map[string]string{
+			"Item":    tr(item.ProductName),
+			"HSN":     item.HsnCode,
+			"Qty":     fmt.Sprint(item.Quantity),
+			"Taxable": fmt.Sprintf("%.2f", item.TaxableValue),
+			"GST %":   fmt.Sprintf("%.2f", item.GstRate),
+			"CGST":    fmt.Sprintf("%.2f", item.Cgst),
+			"SGST":    fmt.Sprintf("%.2f", item.Sgst),
+			"IGST":    fmt.Sprintf("%.2f", item.Igst),
+			"Total":   fmt.Sprintf("%.2f", item.Total),
+		}
+		for _, h := range header {
+			align := "R"
+			if h == "Item" {
+				align = "L"
+			}
+			pdf.CellFormat(widths[h], 7, values[h], "1", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(3)
+
+	summary := [][2]string{{"Taxable Value", fmt.Sprintf("%.2f", invoice.TotalTaxable)}}
+	if invoice.Interstate {
+		summary = append(summary, [2]string{"IGST", fmt.Sprintf("%.2f", invoice.TotalIgst)})
+	} else {
+		summary = append(summary, [2]string{"CGST", fmt.Sprintf("%.2f", invoice.TotalCgst)}, [2]string{"SGST", fmt.Sprintf("%.2f", invoice.TotalSgst)})
+	}
+	summary = append(summary, [2]string{"Shipping", fmt.Sprintf("%.2f", invoice.Order.ShippingCharge)})
+	if invoice.Order.CodCharge > 0 {
+		summary = append(summary, [2]string{"COD Charge", fmt.Sprintf("%.2f", invoice.Order.CodCharge)})
+	}
+	if invoice.Discount > 0 {
+		summary = append(summary, [2]string{"Discount", fmt.Sprintf("-%.2f", invoice.Discount)})
+	}
+
+	for _, row := range summary {
+		pdf.CellFormat(160, 6, row[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(0, 6, row[1], "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(160, 7, "Grand Total", "", 0, "R", false, 0, "")
+	pdf.CellFormat(0, 7, fmt.Sprintf("%.2f", invoice.Order.TotalAmount), "", 1, "R", false, 0, "")
+
+	pdf.Ln(6)
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.CellFormat(0, 5, "Prices are inclusive of GST. This is a computer generated invoice.", "", 1, "L", false, 0, "")
+
+	var out bytes.Buffer
+	if err := pdf.Output(&out); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	cfg "jerseyhub/pkg/config"
+)
+
+// fileMailer writes every mail into a directory instead of sending it, used for development and tests
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(config cfg.Config) *fileMailer {
+	dir := config.MAIL_DROP_DIR
+	if dir == "" {
+		dir = "maildrop"
+	}
+
+	return &fileMailer{
+		dir:  dir,
+		from: config.MAIL_FROM,
+	}
+}
+
+func (m *fileMailer) Send(to string, subject string, template string, data interface{}) error {
+
+	body, err := render(template, data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d_%s_%s", time.Now().UnixNano(), strings.ReplaceAll(to, "@", "_at_"), template)
+	content := fmt.Sprintf("From: %s\nTo: %s\nSubject: %s\n\n%s", m.from, to, subject, body)
+
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(content), 0644)
+}
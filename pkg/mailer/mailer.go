@@ -0,0 +1,36 @@
+package mailer
+
+import (
+	"bytes"
+	"html/template"
+	"path/filepath"
+
+	cfg "jerseyhub/pkg/config"
+	interfaces "jerseyhub/pkg/mailer/interface"
+)
+
+// email templates live alongside razorpay.html
+var templateDir = "templates"
+
+// NewMailer picks the implementation from MAIL_DRIVER, anything other than smtp drops the mails into files
+func NewMailer(config cfg.Config) interfaces.Mailer {
+	if config.MAIL_DRIVER == "smtp" {
+		return NewSMTPMailer(config)
+	}
+
+	return NewFileMailer(config)
+}
+
+func render(name string, data interface{}) (string, error) {
+	tmpl, err := template.ParseFiles(filepath.Join(templateDir, name))
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return "", err
+	}
+
+	return body.String(), nil
+}
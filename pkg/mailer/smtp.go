@@ -0,0 +1,40 @@
+package mailer
+
+import (
+	"fmt"
+	"net/smtp"
+
+	cfg "jerseyhub/pkg/config"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPMailer(config cfg.Config) *smtpMailer {
+	return &smtpMailer{
+		host:     config.SMTP_HOST,
+		port:     config.SMTP_PORT,
+		username: config.SMTP_USERNAME,
+		password: config.SMTP_PASSWORD,
+		from:     config.MAIL_FROM,
+	}
+}
+
+func (m *smtpMailer) Send(to string, subject string, template string, data interface{}) error {
+
+	body, err := render(template, data)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/html; charset=\"UTF-8\"\r\n\r\n%s", m.from, to, subject, body)
+
+	auth := smtp.PlainAuth("", m.username, m.password, m.host)
+
+	return smtp.SendMail(m.host+":"+m.port, auth, m.from, []string{to}, []byte(message))
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/courier/interface/courier.go
+
+// Package mockcourier is a generated GoMock package.
+package mockcourier
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCourier is a mock of Courier interface.
+type MockCourier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourierMockRecorder
+}
+
+// MockCourierMockRecorder is the mock recorder for MockCourier.
+type MockCourierMockRecorder struct {
+	mock *MockCourier
+}
+
+// NewMockCourier creates a new mock instance.
+func NewMockCourier(ctrl *gomock.Controller) *MockCourier {
+	mock := &MockCourier{ctrl: ctrl}
+	mock.recorder = &MockCourierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourier) EXPECT() *MockCourierMockRecorder {
+	return m.recorder
+}
+
+// Book mocks base method.
+func (m *MockCourier) Book(shipment models.CourierShipmentRequest) (models.CourierBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Book", shipment)
+	ret0, _ := ret[0].(models.CourierBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Book indicates an expected call of Book.
+func (mr *MockCourierMockRecorder) Book(shipment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockCourier)(nil).Book), shipment)
+}
+
+// Name mocks base method.
+func (m *MockCourier) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockCourierMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockCourier)(nil).Name))
+}
+
+// Track mocks base method.
+func (m *MockCourier) Track(trackingNumber string) ([]models.TrackingEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", trackingNumber)
+	ret0, _ := ret[0].([]models.TrackingEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockCourierMockRecorder) Track(trackingNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockCourier)(nil).Track), trackingNumber)
+}
@@ -8,6 +8,7 @@ import (
 	models "jerseyhub/pkg/utils/models"
 	multipart "mime/multipart"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -79,6 +80,21 @@ func (mr *MockHelperMockRecorder) Copy(a, b interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockHelper)(nil).Copy), a, b)
 }
 
+// GenerateRecoveryCodes mocks base method.
+func (m *MockHelper) GenerateRecoveryCodes(count int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRecoveryCodes", count)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRecoveryCodes indicates an expected call of GenerateRecoveryCodes.
+func (mr *MockHelperMockRecorder) GenerateRecoveryCodes(count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRecoveryCodes", reflect.TypeOf((*MockHelper)(nil).GenerateRecoveryCodes), count)
+}
+
 // GenerateRefferalCode mocks base method.
 func (m *MockHelper) GenerateRefferalCode() (string, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +110,36 @@ func (mr *MockHelperMockRecorder) GenerateRefferalCode() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefferalCode", reflect.TypeOf((*MockHelper)(nil).GenerateRefferalCode))
 }
 
+// GenerateSecureToken mocks base method.
+func (m *MockHelper) GenerateSecureToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecureToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecureToken indicates an expected call of GenerateSecureToken.
+func (mr *MockHelperMockRecorder) GenerateSecureToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecureToken", reflect.TypeOf((*MockHelper)(nil).GenerateSecureToken))
+}
+
+// GenerateTOTPSecret mocks base method.
+func (m *MockHelper) GenerateTOTPSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTOTPSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateTOTPSecret indicates an expected call of GenerateTOTPSecret.
+func (mr *MockHelperMockRecorder) GenerateTOTPSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTOTPSecret", reflect.TypeOf((*MockHelper)(nil).GenerateTOTPSecret))
+}
+
 // GenerateTokenAdmin mocks base method.
 func (m *MockHelper) GenerateTokenAdmin(admin models.AdminDetailsResponse) (string, string, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +156,21 @@ func (mr *MockHelperMockRecorder) GenerateTokenAdmin(admin interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTokenAdmin", reflect.TypeOf((*MockHelper)(nil).GenerateTokenAdmin), admin)
 }
 
+// GenerateTokenAdminTwoFactor mocks base method.
+func (m *MockHelper) GenerateTokenAdminTwoFactor(admin models.AdminDetailsResponse) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTokenAdminTwoFactor", admin)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateTokenAdminTwoFactor indicates an expected call of GenerateTokenAdminTwoFactor.
+func (mr *MockHelperMockRecorder) GenerateTokenAdminTwoFactor(admin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTokenAdminTwoFactor", reflect.TypeOf((*MockHelper)(nil).GenerateTokenAdminTwoFactor), admin)
+}
+
 // GenerateTokenClients mocks base method.
 func (m *MockHelper) GenerateTokenClients(user models.UserDetailsResponse) (string, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +186,65 @@ func (mr *MockHelperMockRecorder) GenerateTokenClients(user interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTokenClients", reflect.TypeOf((*MockHelper)(nil).GenerateTokenClients), user)
 }
 
+// GenerateTokenGuestCart mocks base method.
+func (m *MockHelper) GenerateTokenGuestCart(cartID int, expiresAt time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTokenGuestCart", cartID, expiresAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateTokenGuestCart indicates an expected call of GenerateTokenGuestCart.
+func (mr *MockHelperMockRecorder) GenerateTokenGuestCart(cartID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTokenGuestCart", reflect.TypeOf((*MockHelper)(nil).GenerateTokenGuestCart), cartID, expiresAt)
+}
+
+// HashToken mocks base method.
+func (m *MockHelper) HashToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashToken", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashToken indicates an expected call of HashToken.
+func (mr *MockHelperMockRecorder) HashToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashToken", reflect.TypeOf((*MockHelper)(nil).HashToken), token)
+}
+
+// ParseTokenAdminTwoFactor mocks base method.
+func (m *MockHelper) ParseTokenAdminTwoFactor(token string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTokenAdminTwoFactor", token)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTokenAdminTwoFactor indicates an expected call of ParseTokenAdminTwoFactor.
+func (mr *MockHelperMockRecorder) ParseTokenAdminTwoFactor(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTokenAdminTwoFactor", reflect.TypeOf((*MockHelper)(nil).ParseTokenAdminTwoFactor), token)
+}
+
+// ParseTokenGuestCart mocks base method.
+func (m *MockHelper) ParseTokenGuestCart(token string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTokenGuestCart", token)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTokenGuestCart indicates an expected call of ParseTokenGuestCart.
+func (mr *MockHelperMockRecorder) ParseTokenGuestCart(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTokenGuestCart", reflect.TypeOf((*MockHelper)(nil).ParseTokenGuestCart), token)
+}
+
 // PasswordHashing mocks base method.
 func (m *MockHelper) PasswordHashing(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +260,20 @@ func (mr *MockHelperMockRecorder) PasswordHashing(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordHashing", reflect.TypeOf((*MockHelper)(nil).PasswordHashing), arg0)
 }
 
+// TOTPProvisioningURI mocks base method.
+func (m *MockHelper) TOTPProvisioningURI(secret, account string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TOTPProvisioningURI", secret, account)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TOTPProvisioningURI indicates an expected call of TOTPProvisioningURI.
+func (mr *MockHelperMockRecorder) TOTPProvisioningURI(secret, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TOTPProvisioningURI", reflect.TypeOf((*MockHelper)(nil).TOTPProvisioningURI), secret, account)
+}
+
 // TwilioSendOTP mocks base method.
 func (m *MockHelper) TwilioSendOTP(phone, serviceID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -180,3 +314,18 @@ func (mr *MockHelperMockRecorder) TwilioVerifyOTP(serviceID, code, phone interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TwilioVerifyOTP", reflect.TypeOf((*MockHelper)(nil).TwilioVerifyOTP), serviceID, code, phone)
 }
+
+// ValidateTOTP mocks base method.
+func (m *MockHelper) ValidateTOTP(secret, code string) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTOTP", secret, code)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ValidateTOTP indicates an expected call of ValidateTOTP.
+func (mr *MockHelperMockRecorder) ValidateTOTP(secret, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTOTP", reflect.TypeOf((*MockHelper)(nil).ValidateTOTP), secret, code)
+}
@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/admin.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAdminRepository is a mock of AdminRepository interface.
+type MockAdminRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminRepositoryMockRecorder
+}
+
+// MockAdminRepositoryMockRecorder is the mock recorder for MockAdminRepository.
+type MockAdminRepositoryMockRecorder struct {
+	mock *MockAdminRepository
+}
+
+// NewMockAdminRepository creates a new mock instance.
+func NewMockAdminRepository(ctrl *gomock.Controller) *MockAdminRepository {
+	mock := &MockAdminRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminRepository) EXPECT() *MockAdminRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckIfPaymentMethodAlreadyExists mocks base method.
+func (m *MockAdminRepository) CheckIfPaymentMethodAlreadyExists(payment string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfPaymentMethodAlreadyExists", payment)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfPaymentMethodAlreadyExists indicates an expected call of CheckIfPaymentMethodAlreadyExists.
+func (mr *MockAdminRepositoryMockRecorder) CheckIfPaymentMethodAlreadyExists(payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfPaymentMethodAlreadyExists", reflect.TypeOf((*MockAdminRepository)(nil).CheckIfPaymentMethodAlreadyExists), payment)
+}
+
+// DeletePaymentMethod mocks base method.
+func (m *MockAdminRepository) DeletePaymentMethod(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaymentMethod", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePaymentMethod indicates an expected call of DeletePaymentMethod.
+func (mr *MockAdminRepositoryMockRecorder) DeletePaymentMethod(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaymentMethod", reflect.TypeOf((*MockAdminRepository)(nil).DeletePaymentMethod), id)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockAdminRepository) DisableTwoFactor(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockAdminRepositoryMockRecorder) DisableTwoFactor(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockAdminRepository)(nil).DisableTwoFactor), id)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockAdminRepository) EnableTwoFactor(id int, step int64, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", id, step, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockAdminRepositoryMockRecorder) EnableTwoFactor(id, step, codeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockAdminRepository)(nil).EnableTwoFactor), id, step, codeHashes)
+}
+
+// GetAdminByID mocks base method.
+func (m *MockAdminRepository) GetAdminByID(id int) (domain.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminByID", id)
+	ret0, _ := ret[0].(domain.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminByID indicates an expected call of GetAdminByID.
+func (mr *MockAdminRepositoryMockRecorder) GetAdminByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByID", reflect.TypeOf((*MockAdminRepository)(nil).GetAdminByID), id)
+}
+
+// GetAdminPolicy mocks base method.
+func (m *MockAdminRepository) GetAdminPolicy() (domain.AdminPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminPolicy")
+	ret0, _ := ret[0].(domain.AdminPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminPolicy indicates an expected call of GetAdminPolicy.
+func (mr *MockAdminRepositoryMockRecorder) GetAdminPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminPolicy", reflect.TypeOf((*MockAdminRepository)(nil).GetAdminPolicy))
+}
+
+// GetUserByID mocks base method.
+func (m *MockAdminRepository) GetUserByID(id string) (domain.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", id)
+	ret0, _ := ret[0].(domain.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockAdminRepositoryMockRecorder) GetUserByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAdminRepository)(nil).GetUserByID), id)
+}
+
+// GetUsers mocks base method.
+func (m *MockAdminRepository) GetUsers(page int) ([]models.UserDetailsAtAdmin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", page)
+	ret0, _ := ret[0].([]models.UserDetailsAtAdmin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockAdminRepositoryMockRecorder) GetUsers(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockAdminRepository)(nil).GetUsers), page)
+}
+
+// ListPaymentMethods mocks base method.
+func (m *MockAdminRepository) ListPaymentMethods() ([]domain.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentMethods")
+	ret0, _ := ret[0].([]domain.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentMethods indicates an expected call of ListPaymentMethods.
+func (mr *MockAdminRepositoryMockRecorder) ListPaymentMethods() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentMethods", reflect.TypeOf((*MockAdminRepository)(nil).ListPaymentMethods))
+}
+
+// LoginHandler mocks base method.
+func (m *MockAdminRepository) LoginHandler(adminDetails models.AdminLogin) (domain.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginHandler", adminDetails)
+	ret0, _ := ret[0].(domain.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginHandler indicates an expected call of LoginHandler.
+func (mr *MockAdminRepositoryMockRecorder) LoginHandler(adminDetails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginHandler", reflect.TypeOf((*MockAdminRepository)(nil).LoginHandler), adminDetails)
+}
+
+// NewPaymentMethod mocks base method.
+func (m *MockAdminRepository) NewPaymentMethod(method models.NewPaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPaymentMethod", method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewPaymentMethod indicates an expected call of NewPaymentMethod.
+func (mr *MockAdminRepositoryMockRecorder) NewPaymentMethod(method interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPaymentMethod", reflect.TypeOf((*MockAdminRepository)(nil).NewPaymentMethod), method)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockAdminRepository) ReplaceRecoveryCodes(id int, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", id, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockAdminRepositoryMockRecorder) ReplaceRecoveryCodes(id, codeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockAdminRepository)(nil).ReplaceRecoveryCodes), id, codeHashes)
+}
+
+// SetTotpSecret mocks base method.
+func (m *MockAdminRepository) SetTotpSecret(id int, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTotpSecret", id, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTotpSecret indicates an expected call of SetTotpSecret.
+func (mr *MockAdminRepositoryMockRecorder) SetTotpSecret(id, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTotpSecret", reflect.TypeOf((*MockAdminRepository)(nil).SetTotpSecret), id, secret)
+}
+
+// UpdateBlockUserByID mocks base method.
+func (m *MockAdminRepository) UpdateBlockUserByID(user domain.Users) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlockUserByID", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBlockUserByID indicates an expected call of UpdateBlockUserByID.
+func (mr *MockAdminRepositoryMockRecorder) UpdateBlockUserByID(user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockUserByID", reflect.TypeOf((*MockAdminRepository)(nil).UpdateBlockUserByID), user)
+}
+
+// UpdateTotpLastStep mocks base method.
+func (m *MockAdminRepository) UpdateTotpLastStep(id int, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTotpLastStep", id, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTotpLastStep indicates an expected call of UpdateTotpLastStep.
+func (mr *MockAdminRepositoryMockRecorder) UpdateTotpLastStep(id, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTotpLastStep", reflect.TypeOf((*MockAdminRepository)(nil).UpdateTotpLastStep), id, step)
+}
+
+// UpdateTwoFactorPolicy mocks base method.
+func (m *MockAdminRepository) UpdateTwoFactorPolicy(required bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTwoFactorPolicy", required)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTwoFactorPolicy indicates an expected call of UpdateTwoFactorPolicy.
+func (mr *MockAdminRepositoryMockRecorder) UpdateTwoFactorPolicy(required interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTwoFactorPolicy", reflect.TypeOf((*MockAdminRepository)(nil).UpdateTwoFactorPolicy), required)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockAdminRepository) UseRecoveryCode(id int, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", id, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockAdminRepositoryMockRecorder) UseRecoveryCode(id, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockAdminRepository)(nil).UseRecoveryCode), id, codeHash)
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/alert.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAlertRepository is a mock of AlertRepository interface.
+type MockAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertRepositoryMockRecorder
+}
+
+// MockAlertRepositoryMockRecorder is the mock recorder for MockAlertRepository.
+type MockAlertRepositoryMockRecorder struct {
+	mock *MockAlertRepository
+}
+
+// NewMockAlertRepository creates a new mock instance.
+func NewMockAlertRepository(ctrl *gomock.Controller) *MockAlertRepository {
+	mock := &MockAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertRepository) EXPECT() *MockAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAlert mocks base method.
+func (m *MockAlertRepository) AddAlert(userID, inventoryID int, kind string, price float64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlert", userID, inventoryID, kind, price)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAlert indicates an expected call of AddAlert.
+func (mr *MockAlertRepositoryMockRecorder) AddAlert(userID, inventoryID, kind, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlert", reflect.TypeOf((*MockAlertRepository)(nil).AddAlert), userID, inventoryID, kind, price)
+}
+
+// CloseAlert mocks base method.
+func (m *MockAlertRepository) CloseAlert(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAlert", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseAlert indicates an expected call of CloseAlert.
+func (mr *MockAlertRepositoryMockRecorder) CloseAlert(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAlert", reflect.TypeOf((*MockAlertRepository)(nil).CloseAlert), id)
+}
+
+// GetAlertProduct mocks base method.
+func (m *MockAlertRepository) GetAlertProduct(inventoryID int) (models.AlertProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertProduct", inventoryID)
+	ret0, _ := ret[0].(models.AlertProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertProduct indicates an expected call of GetAlertProduct.
+func (mr *MockAlertRepositoryMockRecorder) GetAlertProduct(inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertProduct", reflect.TypeOf((*MockAlertRepository)(nil).GetAlertProduct), inventoryID)
+}
+
+// GetAlerts mocks base method.
+func (m *MockAlertRepository) GetAlerts(userID int) ([]models.ProductAlertDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", userID)
+	ret0, _ := ret[0].([]models.ProductAlertDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockAlertRepositoryMockRecorder) GetAlerts(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockAlertRepository)(nil).GetAlerts), userID)
+}
+
+// GetPriceDropAlerts mocks base method.
+func (m *MockAlertRepository) GetPriceDropAlerts(inventoryID, categoryID int) ([]models.TriggeredAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceDropAlerts", inventoryID, categoryID)
+	ret0, _ := ret[0].([]models.TriggeredAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceDropAlerts indicates an expected call of GetPriceDropAlerts.
+func (mr *MockAlertRepositoryMockRecorder) GetPriceDropAlerts(inventoryID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceDropAlerts", reflect.TypeOf((*MockAlertRepository)(nil).GetPriceDropAlerts), inventoryID, categoryID)
+}
+
+// GetStockAlerts mocks base method.
+func (m *MockAlertRepository) GetStockAlerts(inventoryID int) ([]models.TriggeredAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockAlerts", inventoryID)
+	ret0, _ := ret[0].([]models.TriggeredAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockAlerts indicates an expected call of GetStockAlerts.
+func (mr *MockAlertRepositoryMockRecorder) GetStockAlerts(inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockAlerts", reflect.TypeOf((*MockAlertRepository)(nil).GetStockAlerts), inventoryID)
+}
+
+// MarkAlertNotified mocks base method.
+func (m *MockAlertRepository) MarkAlertNotified(id int, price float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAlertNotified", id, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAlertNotified indicates an expected call of MarkAlertNotified.
+func (mr *MockAlertRepositoryMockRecorder) MarkAlertNotified(id, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlertNotified", reflect.TypeOf((*MockAlertRepository)(nil).MarkAlertNotified), id, price)
+}
+
+// RemoveAlert mocks base method.
+func (m *MockAlertRepository) RemoveAlert(userID, id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAlert", userID, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAlert indicates an expected call of RemoveAlert.
+func (mr *MockAlertRepositoryMockRecorder) RemoveAlert(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAlert", reflect.TypeOf((*MockAlertRepository)(nil).RemoveAlert), userID, id)
+}
+
+// RemoveProductAlerts mocks base method.
+func (m *MockAlertRepository) RemoveProductAlerts(userID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProductAlerts", userID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProductAlerts indicates an expected call of RemoveProductAlerts.
+func (mr *MockAlertRepositoryMockRecorder) RemoveProductAlerts(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProductAlerts", reflect.TypeOf((*MockAlertRepository)(nil).RemoveProductAlerts), userID, inventoryID)
+}
@@ -0,0 +1,360 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/cart.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCartRepository is a mock of CartRepository interface.
+type MockCartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryMockRecorder
+}
+
+// MockCartRepositoryMockRecorder is the mock recorder for MockCartRepository.
+type MockCartRepositoryMockRecorder struct {
+	mock *MockCartRepository
+}
+
+// NewMockCartRepository creates a new mock instance.
+func NewMockCartRepository(ctrl *gomock.Controller) *MockCartRepository {
+	mock := &MockCartRepository{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCartReminder mocks base method.
+func (m *MockCartRepository) AddCartReminder(reminder models.CartReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCartReminder", reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCartReminder indicates an expected call of AddCartReminder.
+func (mr *MockCartRepositoryMockRecorder) AddCartReminder(reminder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCartReminder", reflect.TypeOf((*MockCartRepository)(nil).AddCartReminder), reminder)
+}
+
+// AddLineItems mocks base method.
+func (m *MockCartRepository) AddLineItems(cart_id, inventory_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLineItems", cart_id, inventory_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLineItems indicates an expected call of AddLineItems.
+func (mr *MockCartRepositoryMockRecorder) AddLineItems(cart_id, inventory_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLineItems", reflect.TypeOf((*MockCartRepository)(nil).AddLineItems), cart_id, inventory_id)
+}
+
+// AttributeOrder mocks base method.
+func (m *MockCartRepository) AttributeOrder(orderID int, window time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttributeOrder", orderID, window)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttributeOrder indicates an expected call of AttributeOrder.
+func (mr *MockCartRepositoryMockRecorder) AttributeOrder(orderID, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttributeOrder", reflect.TypeOf((*MockCartRepository)(nil).AttributeOrder), orderID, window)
+}
+
+// CheckIfItemIsAlreadyAdded mocks base method.
+func (m *MockCartRepository) CheckIfItemIsAlreadyAdded(cart_id, inventory_id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfItemIsAlreadyAdded", cart_id, inventory_id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfItemIsAlreadyAdded indicates an expected call of CheckIfItemIsAlreadyAdded.
+func (mr *MockCartRepositoryMockRecorder) CheckIfItemIsAlreadyAdded(cart_id, inventory_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfItemIsAlreadyAdded", reflect.TypeOf((*MockCartRepository)(nil).CheckIfItemIsAlreadyAdded), cart_id, inventory_id)
+}
+
+// CreateGuestCart mocks base method.
+func (m *MockCartRepository) CreateGuestCart(inventoryID, quantity int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestCart", inventoryID, quantity)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestCart indicates an expected call of CreateGuestCart.
+func (mr *MockCartRepositoryMockRecorder) CreateGuestCart(inventoryID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestCart", reflect.TypeOf((*MockCartRepository)(nil).CreateGuestCart), inventoryID, quantity)
+}
+
+// CreateNewCart mocks base method.
+func (m *MockCartRepository) CreateNewCart(user_id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewCart", user_id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewCart indicates an expected call of CreateNewCart.
+func (mr *MockCartRepositoryMockRecorder) CreateNewCart(user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewCart", reflect.TypeOf((*MockCartRepository)(nil).CreateNewCart), user_id)
+}
+
+// DeleteStaleGuestCarts mocks base method.
+func (m *MockCartRepository) DeleteStaleGuestCarts(before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleGuestCarts", before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleGuestCarts indicates an expected call of DeleteStaleGuestCarts.
+func (mr *MockCartRepositoryMockRecorder) DeleteStaleGuestCarts(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleGuestCarts", reflect.TypeOf((*MockCartRepository)(nil).DeleteStaleGuestCarts), before)
+}
+
+// GetAbandonedCarts mocks base method.
+func (m *MockCartRepository) GetAbandonedCarts(idleSince time.Time, page int) ([]models.AbandonedCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCarts", idleSince, page)
+	ret0, _ := ret[0].([]models.AbandonedCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCarts indicates an expected call of GetAbandonedCarts.
+func (mr *MockCartRepositoryMockRecorder) GetAbandonedCarts(idleSince, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCarts", reflect.TypeOf((*MockCartRepository)(nil).GetAbandonedCarts), idleSince, page)
+}
+
+// GetAddresses mocks base method.
+func (m *MockCartRepository) GetAddresses(id int) ([]models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddresses", id)
+	ret0, _ := ret[0].([]models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddresses indicates an expected call of GetAddresses.
+func (mr *MockCartRepositoryMockRecorder) GetAddresses(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddresses", reflect.TypeOf((*MockCartRepository)(nil).GetAddresses), id)
+}
+
+// GetCart mocks base method.
+func (m *MockCartRepository) GetCart(id int) ([]models.GetCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", id)
+	ret0, _ := ret[0].([]models.GetCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockCartRepositoryMockRecorder) GetCart(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartRepository)(nil).GetCart), id)
+}
+
+// GetCartId mocks base method.
+func (m *MockCartRepository) GetCartId(user_id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartId", user_id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartId indicates an expected call of GetCartId.
+func (mr *MockCartRepositoryMockRecorder) GetCartId(user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartId", reflect.TypeOf((*MockCartRepository)(nil).GetCartId), user_id)
+}
+
+// GetCartItemStatus mocks base method.
+func (m *MockCartRepository) GetCartItemStatus(cartID int) ([]models.CartItemStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartItemStatus", cartID)
+	ret0, _ := ret[0].([]models.CartItemStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartItemStatus indicates an expected call of GetCartItemStatus.
+func (mr *MockCartRepositoryMockRecorder) GetCartItemStatus(cartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartItemStatus", reflect.TypeOf((*MockCartRepository)(nil).GetCartItemStatus), cartID)
+}
+
+// GetCartQuantity mocks base method.
+func (m *MockCartRepository) GetCartQuantity(cartID, inventoryID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartQuantity", cartID, inventoryID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartQuantity indicates an expected call of GetCartQuantity.
+func (mr *MockCartRepositoryMockRecorder) GetCartQuantity(cartID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartQuantity", reflect.TypeOf((*MockCartRepository)(nil).GetCartQuantity), cartID, inventoryID)
+}
+
+// GetCartRecoverySummary mocks base method.
+func (m *MockCartRepository) GetCartRecoverySummary(idleSince, since time.Time) (models.CartRecoverySummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartRecoverySummary", idleSince, since)
+	ret0, _ := ret[0].(models.CartRecoverySummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartRecoverySummary indicates an expected call of GetCartRecoverySummary.
+func (mr *MockCartRepositoryMockRecorder) GetCartRecoverySummary(idleSince, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartRecoverySummary", reflect.TypeOf((*MockCartRepository)(nil).GetCartRecoverySummary), idleSince, since)
+}
+
+// GetGuestCart mocks base method.
+func (m *MockCartRepository) GetGuestCart(guestCartID int) ([]models.GetCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestCart", guestCartID)
+	ret0, _ := ret[0].([]models.GetCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestCart indicates an expected call of GetGuestCart.
+func (mr *MockCartRepositoryMockRecorder) GetGuestCart(guestCartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestCart", reflect.TypeOf((*MockCartRepository)(nil).GetGuestCart), guestCartID)
+}
+
+// GetGuestCartQuantity mocks base method.
+func (m *MockCartRepository) GetGuestCartQuantity(guestCartID, inventoryID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestCartQuantity", guestCartID, inventoryID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestCartQuantity indicates an expected call of GetGuestCartQuantity.
+func (mr *MockCartRepositoryMockRecorder) GetGuestCartQuantity(guestCartID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestCartQuantity", reflect.TypeOf((*MockCartRepository)(nil).GetGuestCartQuantity), guestCartID, inventoryID)
+}
+
+// GetPaymentOptions mocks base method.
+func (m *MockCartRepository) GetPaymentOptions() ([]models.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOptions")
+	ret0, _ := ret[0].([]models.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOptions indicates an expected call of GetPaymentOptions.
+func (mr *MockCartRepositoryMockRecorder) GetPaymentOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOptions", reflect.TypeOf((*MockCartRepository)(nil).GetPaymentOptions))
+}
+
+// MergeGuestCart mocks base method.
+func (m *MockCartRepository) MergeGuestCart(guestCartID, cartID, maxQuantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuestCart", guestCartID, cartID, maxQuantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuestCart indicates an expected call of MergeGuestCart.
+func (mr *MockCartRepositoryMockRecorder) MergeGuestCart(guestCartID, cartID, maxQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuestCart", reflect.TypeOf((*MockCartRepository)(nil).MergeGuestCart), guestCartID, cartID, maxQuantity)
+}
+
+// RemoveGuestCartItem mocks base method.
+func (m *MockCartRepository) RemoveGuestCartItem(guestCartID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestCartItem", guestCartID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGuestCartItem indicates an expected call of RemoveGuestCartItem.
+func (mr *MockCartRepositoryMockRecorder) RemoveGuestCartItem(guestCartID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestCartItem", reflect.TypeOf((*MockCartRepository)(nil).RemoveGuestCartItem), guestCartID, inventoryID)
+}
+
+// SetCartQuantity mocks base method.
+func (m *MockCartRepository) SetCartQuantity(cartID, inventoryID, quantity int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCartQuantity", cartID, inventoryID, quantity)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCartQuantity indicates an expected call of SetCartQuantity.
+func (mr *MockCartRepositoryMockRecorder) SetCartQuantity(cartID, inventoryID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCartQuantity", reflect.TypeOf((*MockCartRepository)(nil).SetCartQuantity), cartID, inventoryID, quantity)
+}
+
+// SetGuestCartItem mocks base method.
+func (m *MockCartRepository) SetGuestCartItem(guestCartID, inventoryID, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuestCartItem", guestCartID, inventoryID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuestCartItem indicates an expected call of SetGuestCartItem.
+func (mr *MockCartRepositoryMockRecorder) SetGuestCartItem(guestCartID, inventoryID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestCartItem", reflect.TypeOf((*MockCartRepository)(nil).SetGuestCartItem), guestCartID, inventoryID, quantity)
+}
+
+// TouchGuestCart mocks base method.
+func (m *MockCartRepository) TouchGuestCart(id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchGuestCart", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchGuestCart indicates an expected call of TouchGuestCart.
+func (mr *MockCartRepositoryMockRecorder) TouchGuestCart(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchGuestCart", reflect.TypeOf((*MockCartRepository)(nil).TouchGuestCart), id)
+}
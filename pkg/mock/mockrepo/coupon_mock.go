@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/coupon.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCouponRepository is a mock of CouponRepository interface.
+type MockCouponRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCouponRepositoryMockRecorder
+}
+
+// MockCouponRepositoryMockRecorder is the mock recorder for MockCouponRepository.
+type MockCouponRepositoryMockRecorder struct {
+	mock *MockCouponRepository
+}
+
+// NewMockCouponRepository creates a new mock instance.
+func NewMockCouponRepository(ctrl *gomock.Controller) *MockCouponRepository {
+	mock := &MockCouponRepository{ctrl: ctrl}
+	mock.recorder = &MockCouponRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCouponRepository) EXPECT() *MockCouponRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCoupon mocks base method.
+func (m *MockCouponRepository) AddCoupon(arg0 models.Coupons) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCoupon", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCoupon indicates an expected call of AddCoupon.
+func (mr *MockCouponRepositoryMockRecorder) AddCoupon(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCoupon", reflect.TypeOf((*MockCouponRepository)(nil).AddCoupon), arg0)
+}
+
+// AddUserCoupon mocks base method.
+func (m *MockCouponRepository) AddUserCoupon(code string, discountRate, userID int, expiresAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserCoupon", code, discountRate, userID, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUserCoupon indicates an expected call of AddUserCoupon.
+func (mr *MockCouponRepositoryMockRecorder) AddUserCoupon(code, discountRate, userID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserCoupon", reflect.TypeOf((*MockCouponRepository)(nil).AddUserCoupon), code, discountRate, userID, expiresAt)
+}
+
+// ExpireCoupons mocks base method.
+func (m *MockCouponRepository) ExpireCoupons(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireCoupons", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireCoupons indicates an expected call of ExpireCoupons.
+func (mr *MockCouponRepositoryMockRecorder) ExpireCoupons(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireCoupons", reflect.TypeOf((*MockCouponRepository)(nil).ExpireCoupons), now)
+}
+
+// FindCouponDetails mocks base method.
+func (m *MockCouponRepository) FindCouponDetails(couponID int) (domain.Coupons, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCouponDetails", couponID)
+	ret0, _ := ret[0].(domain.Coupons)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCouponDetails indicates an expected call of FindCouponDetails.
+func (mr *MockCouponRepositoryMockRecorder) FindCouponDetails(couponID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCouponDetails", reflect.TypeOf((*MockCouponRepository)(nil).FindCouponDetails), couponID)
+}
+
+// GetAllCoupons mocks base method.
+func (m *MockCouponRepository) GetAllCoupons() ([]domain.Coupons, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCoupons")
+	ret0, _ := ret[0].([]domain.Coupons)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllCoupons indicates an expected call of GetAllCoupons.
+func (mr *MockCouponRepositoryMockRecorder) GetAllCoupons() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCoupons", reflect.TypeOf((*MockCouponRepository)(nil).GetAllCoupons))
+}
+
+// MakeCouponInvalid mocks base method.
+func (m *MockCouponRepository) MakeCouponInvalid(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeCouponInvalid", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeCouponInvalid indicates an expected call of MakeCouponInvalid.
+func (mr *MockCouponRepositoryMockRecorder) MakeCouponInvalid(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCouponInvalid", reflect.TypeOf((*MockCouponRepository)(nil).MakeCouponInvalid), id)
+}
+
+// ReActivateCoupon mocks base method.
+func (m *MockCouponRepository) ReActivateCoupon(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReActivateCoupon", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReActivateCoupon indicates an expected call of ReActivateCoupon.
+func (mr *MockCouponRepositoryMockRecorder) ReActivateCoupon(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReActivateCoupon", reflect.TypeOf((*MockCouponRepository)(nil).ReActivateCoupon), id)
+}
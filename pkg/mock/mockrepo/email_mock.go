@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/email.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailRepository is a mock of EmailRepository interface.
+type MockEmailRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailRepositoryMockRecorder
+}
+
+// MockEmailRepositoryMockRecorder is the mock recorder for MockEmailRepository.
+type MockEmailRepositoryMockRecorder struct {
+	mock *MockEmailRepository
+}
+
+// NewMockEmailRepository creates a new mock instance.
+func NewMockEmailRepository(ctrl *gomock.Controller) *MockEmailRepository {
+	mock := &MockEmailRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailRepository) EXPECT() *MockEmailRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckIfEmailVerified mocks base method.
+func (m *MockEmailRepository) CheckIfEmailVerified(userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfEmailVerified", userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfEmailVerified indicates an expected call of CheckIfEmailVerified.
+func (mr *MockEmailRepositoryMockRecorder) CheckIfEmailVerified(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfEmailVerified", reflect.TypeOf((*MockEmailRepository)(nil).CheckIfEmailVerified), userID)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockEmailRepository) CreateEmailVerification(userID int, email, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", userID, email, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockEmailRepositoryMockRecorder) CreateEmailVerification(userID, email, tokenHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockEmailRepository)(nil).CreateEmailVerification), userID, email, tokenHash, expiresAt)
+}
+
+// FindEmailVerification mocks base method.
+func (m *MockEmailRepository) FindEmailVerification(tokenHash string) (domain.EmailVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEmailVerification", tokenHash)
+	ret0, _ := ret[0].(domain.EmailVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEmailVerification indicates an expected call of FindEmailVerification.
+func (mr *MockEmailRepositoryMockRecorder) FindEmailVerification(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEmailVerification", reflect.TypeOf((*MockEmailRepository)(nil).FindEmailVerification), tokenHash)
+}
+
+// GetEmailRecipient mocks base method.
+func (m *MockEmailRepository) GetEmailRecipient(userID int) (models.EmailRecipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailRecipient", userID)
+	ret0, _ := ret[0].(models.EmailRecipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailRecipient indicates an expected call of GetEmailRecipient.
+func (mr *MockEmailRepositoryMockRecorder) GetEmailRecipient(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailRecipient", reflect.TypeOf((*MockEmailRepository)(nil).GetEmailRecipient), userID)
+}
+
+// GetOrderEmailDetails mocks base method.
+func (m *MockEmailRepository) GetOrderEmailDetails(orderID int) (models.OrderEmailDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderEmailDetails", orderID)
+	ret0, _ := ret[0].(models.OrderEmailDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderEmailDetails indicates an expected call of GetOrderEmailDetails.
+func (mr *MockEmailRepositoryMockRecorder) GetOrderEmailDetails(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderEmailDetails", reflect.TypeOf((*MockEmailRepository)(nil).GetOrderEmailDetails), orderID)
+}
+
+// MarkEmailAsVerified mocks base method.
+func (m *MockEmailRepository) MarkEmailAsVerified(verificationID, userID int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailAsVerified", verificationID, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailAsVerified indicates an expected call of MarkEmailAsVerified.
+func (mr *MockEmailRepositoryMockRecorder) MarkEmailAsVerified(verificationID, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailAsVerified", reflect.TypeOf((*MockEmailRepository)(nil).MarkEmailAsVerified), verificationID, userID, email)
+}
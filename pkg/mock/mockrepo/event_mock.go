@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/event.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventRepository is a mock of EventRepository interface.
+type MockEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryMockRecorder
+}
+
+// MockEventRepositoryMockRecorder is the mock recorder for MockEventRepository.
+type MockEventRepositoryMockRecorder struct {
+	mock *MockEventRepository
+}
+
+// NewMockEventRepository creates a new mock instance.
+func NewMockEventRepository(ctrl *gomock.Controller) *MockEventRepository {
+	mock := &MockEventRepository{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddDelivery mocks base method.
+func (m *MockEventRepository) AddDelivery(eventID int, subscriber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelivery", eventID, subscriber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDelivery indicates an expected call of AddDelivery.
+func (mr *MockEventRepositoryMockRecorder) AddDelivery(eventID, subscriber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelivery", reflect.TypeOf((*MockEventRepository)(nil).AddDelivery), eventID, subscriber)
+}
+
+// ClaimDueEvents mocks base method.
+func (m *MockEventRepository) ClaimDueEvents(limit int, lease time.Duration) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueEvents", limit, lease)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueEvents indicates an expected call of ClaimDueEvents.
+func (mr *MockEventRepositoryMockRecorder) ClaimDueEvents(limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueEvents", reflect.TypeOf((*MockEventRepository)(nil).ClaimDueEvents), limit, lease)
+}
+
+// FailEvent mocks base method.
+func (m *MockEventRepository) FailEvent(id int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailEvent", id, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailEvent indicates an expected call of FailEvent.
+func (mr *MockEventRepositoryMockRecorder) FailEvent(id, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailEvent", reflect.TypeOf((*MockEventRepository)(nil).FailEvent), id, lastError)
+}
+
+// GetDeliveredSubscribers mocks base method.
+func (m *MockEventRepository) GetDeliveredSubscribers(eventID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveredSubscribers", eventID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveredSubscribers indicates an expected call of GetDeliveredSubscribers.
+func (mr *MockEventRepositoryMockRecorder) GetDeliveredSubscribers(eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveredSubscribers", reflect.TypeOf((*MockEventRepository)(nil).GetDeliveredSubscribers), eventID)
+}
+
+// MarkEventDone mocks base method.
+func (m *MockEventRepository) MarkEventDone(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventDone", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventDone indicates an expected call of MarkEventDone.
+func (mr *MockEventRepositoryMockRecorder) MarkEventDone(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventDone", reflect.TypeOf((*MockEventRepository)(nil).MarkEventDone), id)
+}
+
+// RetryEvent mocks base method.
+func (m *MockEventRepository) RetryEvent(id int, next time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryEvent", id, next, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryEvent indicates an expected call of RetryEvent.
+func (mr *MockEventRepositoryMockRecorder) RetryEvent(id, next, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryEvent", reflect.TypeOf((*MockEventRepository)(nil).RetryEvent), id, next, lastError)
+}
@@ -123,18 +123,18 @@ func (mr *MockInventoryRepositoryMockRecorder) EditInventoryDetails(id, model in
 }
 
 // ListProducts mocks base method.
-func (m *MockInventoryRepository) ListProducts(page int) ([]models.Inventories, error) {
+func (m *MockInventoryRepository) ListProducts(page int, sort string) ([]models.Inventories, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProducts", page)
+	ret := m.ctrl.Call(m, "ListProducts", page, sort)
 	ret0, _ := ret[0].([]models.Inventories)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProducts indicates an expected call of ListProducts.
-func (mr *MockInventoryRepositoryMockRecorder) ListProducts(page interface{}) *gomock.Call {
+func (mr *MockInventoryRepositoryMockRecorder) ListProducts(page, sort interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockInventoryRepository)(nil).ListProducts), page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockInventoryRepository)(nil).ListProducts), page, sort)
 }
 
 // ListProductsByCategory mocks base method.
@@ -152,6 +152,20 @@ func (mr *MockInventoryRepositoryMockRecorder) ListProductsByCategory(id interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductsByCategory", reflect.TypeOf((*MockInventoryRepository)(nil).ListProductsByCategory), id)
 }
 
+// RebuildSearchIndex mocks base method.
+func (m *MockInventoryRepository) RebuildSearchIndex() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildSearchIndex")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildSearchIndex indicates an expected call of RebuildSearchIndex.
+func (mr *MockInventoryRepositoryMockRecorder) RebuildSearchIndex() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildSearchIndex", reflect.TypeOf((*MockInventoryRepository)(nil).RebuildSearchIndex))
+}
+
 // SearchProducts mocks base method.
 func (m *MockInventoryRepository) SearchProducts(key string) ([]models.Inventories, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/invoice.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceRepository is a mock of InvoiceRepository interface.
+type MockInvoiceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepositoryMockRecorder
+}
+
+// MockInvoiceRepositoryMockRecorder is the mock recorder for MockInvoiceRepository.
+type MockInvoiceRepositoryMockRecorder struct {
+	mock *MockInvoiceRepository
+}
+
+// NewMockInvoiceRepository creates a new mock instance.
+func NewMockInvoiceRepository(ctrl *gomock.Controller) *MockInvoiceRepository {
+	mock := &MockInvoiceRepository{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepository) EXPECT() *MockInvoiceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckCategoryExists mocks base method.
+func (m *MockInvoiceRepository) CheckCategoryExists(categoryID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCategoryExists", categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCategoryExists indicates an expected call of CheckCategoryExists.
+func (mr *MockInvoiceRepositoryMockRecorder) CheckCategoryExists(categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCategoryExists", reflect.TypeOf((*MockInvoiceRepository)(nil).CheckCategoryExists), categoryID)
+}
+
+// CreateInvoice mocks base method.
+func (m *MockInvoiceRepository) CreateInvoice(orderID int, financialYear string) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", orderID, financialYear)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockInvoiceRepositoryMockRecorder) CreateInvoice(orderID, financialYear interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockInvoiceRepository)(nil).CreateInvoice), orderID, financialYear)
+}
+
+// GetAddressState mocks base method.
+func (m *MockInvoiceRepository) GetAddressState(addressID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressState", addressID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressState indicates an expected call of GetAddressState.
+func (mr *MockInvoiceRepositoryMockRecorder) GetAddressState(addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressState", reflect.TypeOf((*MockInvoiceRepository)(nil).GetAddressState), addressID)
+}
+
+// GetCategoryTax mocks base method.
+func (m *MockInvoiceRepository) GetCategoryTax(categoryID int) (models.CategoryTax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTax", categoryID)
+	ret0, _ := ret[0].(models.CategoryTax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTax indicates an expected call of GetCategoryTax.
+func (mr *MockInvoiceRepositoryMockRecorder) GetCategoryTax(categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTax", reflect.TypeOf((*MockInvoiceRepository)(nil).GetCategoryTax), categoryID)
+}
+
+// GetCategoryTaxes mocks base method.
+func (m *MockInvoiceRepository) GetCategoryTaxes() ([]models.CategoryTax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTaxes")
+	ret0, _ := ret[0].([]models.CategoryTax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTaxes indicates an expected call of GetCategoryTaxes.
+func (mr *MockInvoiceRepositoryMockRecorder) GetCategoryTaxes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTaxes", reflect.TypeOf((*MockInvoiceRepository)(nil).GetCategoryTaxes))
+}
+
+// GetInvoiceByOrderID mocks base method.
+func (m *MockInvoiceRepository) GetInvoiceByOrderID(orderID int) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceByOrderID", orderID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceByOrderID indicates an expected call of GetInvoiceByOrderID.
+func (mr *MockInvoiceRepositoryMockRecorder) GetInvoiceByOrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByOrderID", reflect.TypeOf((*MockInvoiceRepository)(nil).GetInvoiceByOrderID), orderID)
+}
+
+// GetInvoiceCustomer mocks base method.
+func (m *MockInvoiceRepository) GetInvoiceCustomer(orderID int) (models.InvoiceCustomer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceCustomer", orderID)
+	ret0, _ := ret[0].(models.InvoiceCustomer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceCustomer indicates an expected call of GetInvoiceCustomer.
+func (mr *MockInvoiceRepositoryMockRecorder) GetInvoiceCustomer(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceCustomer", reflect.TypeOf((*MockInvoiceRepository)(nil).GetInvoiceCustomer), orderID)
+}
+
+// SetCategoryTax mocks base method.
+func (m *MockInvoiceRepository) SetCategoryTax(tax models.CategoryTax) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryTax", tax)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryTax indicates an expected call of SetCategoryTax.
+func (mr *MockInvoiceRepositoryMockRecorder) SetCategoryTax(tax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryTax", reflect.TypeOf((*MockInvoiceRepository)(nil).SetCategoryTax), tax)
+}
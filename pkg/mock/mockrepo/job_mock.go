@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/job.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddJob mocks base method.
+func (m *MockJobRepository) AddJob(name, payload string, runAt time.Time, maxAttempts int, uniqueKey *string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddJob", name, payload, runAt, maxAttempts, uniqueKey)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddJob indicates an expected call of AddJob.
+func (mr *MockJobRepositoryMockRecorder) AddJob(name, payload, runAt, maxAttempts, uniqueKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJob", reflect.TypeOf((*MockJobRepository)(nil).AddJob), name, payload, runAt, maxAttempts, uniqueKey)
+}
+
+// ClaimDueJobs mocks base method.
+func (m *MockJobRepository) ClaimDueJobs(limit int, lease time.Duration) ([]models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueJobs", limit, lease)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueJobs indicates an expected call of ClaimDueJobs.
+func (mr *MockJobRepositoryMockRecorder) ClaimDueJobs(limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueJobs", reflect.TypeOf((*MockJobRepository)(nil).ClaimDueJobs), limit, lease)
+}
+
+// CountJobs mocks base method.
+func (m *MockJobRepository) CountJobs() ([]models.JobStatusCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobs")
+	ret0, _ := ret[0].([]models.JobStatusCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobs indicates an expected call of CountJobs.
+func (mr *MockJobRepositoryMockRecorder) CountJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobs", reflect.TypeOf((*MockJobRepository)(nil).CountJobs))
+}
+
+// GetJobs mocks base method.
+func (m *MockJobRepository) GetJobs(status string, page int) ([]models.JobDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", status, page)
+	ret0, _ := ret[0].([]models.JobDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockJobRepositoryMockRecorder) GetJobs(status, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobRepository)(nil).GetJobs), status, page)
+}
+
+// KillJob mocks base method.
+func (m *MockJobRepository) KillJob(id int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KillJob", id, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KillJob indicates an expected call of KillJob.
+func (mr *MockJobRepositoryMockRecorder) KillJob(id, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillJob", reflect.TypeOf((*MockJobRepository)(nil).KillJob), id, lastError)
+}
+
+// MarkJobDone mocks base method.
+func (m *MockJobRepository) MarkJobDone(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkJobDone", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkJobDone indicates an expected call of MarkJobDone.
+func (mr *MockJobRepositoryMockRecorder) MarkJobDone(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkJobDone", reflect.TypeOf((*MockJobRepository)(nil).MarkJobDone), id)
+}
+
+// RetryJob mocks base method.
+func (m *MockJobRepository) RetryJob(id int, next time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", id, next, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockJobRepositoryMockRecorder) RetryJob(id, next, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobRepository)(nil).RetryJob), id, next, lastError)
+}
+
+// ReviveJob mocks base method.
+func (m *MockJobRepository) ReviveJob(id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviveJob", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviveJob indicates an expected call of ReviveJob.
+func (mr *MockJobRepositoryMockRecorder) ReviveJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviveJob", reflect.TypeOf((*MockJobRepository)(nil).ReviveJob), id)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/offer.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOfferRepository is a mock of OfferRepository interface.
+type MockOfferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfferRepositoryMockRecorder
+}
+
+// MockOfferRepositoryMockRecorder is the mock recorder for MockOfferRepository.
+type MockOfferRepositoryMockRecorder struct {
+	mock *MockOfferRepository
+}
+
+// NewMockOfferRepository creates a new mock instance.
+func NewMockOfferRepository(ctrl *gomock.Controller) *MockOfferRepository {
+	mock := &MockOfferRepository{ctrl: ctrl}
+	mock.recorder = &MockOfferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfferRepository) EXPECT() *MockOfferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddNewOffer mocks base method.
+func (m *MockOfferRepository) AddNewOffer(model models.OfferMaking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNewOffer", model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNewOffer indicates an expected call of AddNewOffer.
+func (mr *MockOfferRepositoryMockRecorder) AddNewOffer(model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNewOffer", reflect.TypeOf((*MockOfferRepository)(nil).AddNewOffer), model)
+}
+
+// ExpireOffers mocks base method.
+func (m *MockOfferRepository) ExpireOffers(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockOfferRepositoryMockRecorder) ExpireOffers(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockOfferRepository)(nil).ExpireOffers), now)
+}
+
+// FindDiscountPercentage mocks base method.
+func (m *MockOfferRepository) FindDiscountPercentage(arg0 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscountPercentage", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDiscountPercentage indicates an expected call of FindDiscountPercentage.
+func (mr *MockOfferRepositoryMockRecorder) FindDiscountPercentage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscountPercentage", reflect.TypeOf((*MockOfferRepository)(nil).FindDiscountPercentage), arg0)
+}
+
+// GetOffers mocks base method.
+func (m *MockOfferRepository) GetOffers() ([]domain.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffers")
+	ret0, _ := ret[0].([]domain.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOffers indicates an expected call of GetOffers.
+func (mr *MockOfferRepositoryMockRecorder) GetOffers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffers", reflect.TypeOf((*MockOfferRepository)(nil).GetOffers))
+}
+
+// MakeOfferExpire mocks base method.
+func (m *MockOfferRepository) MakeOfferExpire(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeOfferExpire", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeOfferExpire indicates an expected call of MakeOfferExpire.
+func (mr *MockOfferRepositoryMockRecorder) MakeOfferExpire(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeOfferExpire", reflect.TypeOf((*MockOfferRepository)(nil).MakeOfferExpire), id)
+}
@@ -8,6 +8,7 @@ import (
 	domain "jerseyhub/pkg/domain"
 	models "jerseyhub/pkg/utils/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,20 +36,6 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
-// AddOrderProducts mocks base method.
-func (m *MockOrderRepository) AddOrderProducts(order_id int, cart []models.GetCart) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrderProducts", order_id, cart)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AddOrderProducts indicates an expected call of AddOrderProducts.
-func (mr *MockOrderRepositoryMockRecorder) AddOrderProducts(order_id, cart interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderProducts", reflect.TypeOf((*MockOrderRepository)(nil).AddOrderProducts), order_id, cart)
-}
-
 // AdminOrders mocks base method.
 func (m *MockOrderRepository) AdminOrders(status string) ([]domain.OrderDetails, error) {
 	m.ctrl.T.Helper()
@@ -64,20 +51,6 @@ func (mr *MockOrderRepositoryMockRecorder) AdminOrders(status interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminOrders", reflect.TypeOf((*MockOrderRepository)(nil).AdminOrders), status)
 }
 
-// CancelOrder mocks base method.
-func (m *MockOrderRepository) CancelOrder(id int) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelOrder", id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CancelOrder indicates an expected call of CancelOrder.
-func (mr *MockOrderRepositoryMockRecorder) CancelOrder(id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockOrderRepository)(nil).CancelOrder), id)
-}
-
 // CheckOrder mocks base method.
 func (m *MockOrderRepository) CheckOrder(orderID string, userID int) error {
 	m.ctrl.T.Helper()
@@ -255,6 +228,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetOrderDetail(orderID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDetail", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderDetail), orderID)
 }
 
+// GetOrderItemStates mocks base method.
+func (m *MockOrderRepository) GetOrderItemStates(orderID int) ([]models.OrderItemState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemStates", orderID)
+	ret0, _ := ret[0].([]models.OrderItemState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemStates indicates an expected call of GetOrderItemStates.
+func (mr *MockOrderRepositoryMockRecorder) GetOrderItemStates(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemStates", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderItemStates), orderID)
+}
+
 // GetOrders mocks base method.
 func (m *MockOrderRepository) GetOrders(id int) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -300,6 +288,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetProductImagesInAOrder(id interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductImagesInAOrder", reflect.TypeOf((*MockOrderRepository)(nil).GetProductImagesInAOrder), id)
 }
 
+// GetUnpaidOrders mocks base method.
+func (m *MockOrderRepository) GetUnpaidOrders(placedBefore time.Time) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnpaidOrders", placedBefore)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnpaidOrders indicates an expected call of GetUnpaidOrders.
+func (mr *MockOrderRepositoryMockRecorder) GetUnpaidOrders(placedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnpaidOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetUnpaidOrders), placedBefore)
+}
+
 // MakePaymentStatusAsPaid mocks base method.
 func (m *MockOrderRepository) MakePaymentStatusAsPaid(id int) error {
 	m.ctrl.T.Helper()
@@ -315,30 +318,30 @@ func (mr *MockOrderRepositoryMockRecorder) MakePaymentStatusAsPaid(id interface{
 }
 
 // OrderItems mocks base method.
-func (m *MockOrderRepository) OrderItems(userid, addressid, paymentid int, total float64, coupon string) (int, error) {
+func (m *MockOrderRepository) OrderItems(userid, addressid, paymentid int, total float64, coupon string, shipping models.ShippingCharge, cart []models.GetCart, taxes map[int]models.ItemTax) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OrderItems", userid, addressid, paymentid, total, coupon)
+	ret := m.ctrl.Call(m, "OrderItems", userid, addressid, paymentid, total, coupon, shipping, cart, taxes)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // OrderItems indicates an expected call of OrderItems.
-func (mr *MockOrderRepositoryMockRecorder) OrderItems(userid, addressid, paymentid, total, coupon interface{}) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) OrderItems(userid, addressid, paymentid, total, coupon, shipping, cart, taxes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderItems", reflect.TypeOf((*MockOrderRepository)(nil).OrderItems), userid, addressid, paymentid, total, coupon)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderItems", reflect.TypeOf((*MockOrderRepository)(nil).OrderItems), userid, addressid, paymentid, total, coupon, shipping, cart, taxes)
 }
 
-// ReturnOrder mocks base method.
-func (m *MockOrderRepository) ReturnOrder(id int) error {
+// UpdateOrderItems mocks base method.
+func (m *MockOrderRepository) UpdateOrderItems(orderID, userID int, changes []models.OrderItemChange, returned bool, extraRefund float64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrder", id)
+	ret := m.ctrl.Call(m, "UpdateOrderItems", orderID, userID, changes, returned, extraRefund)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ReturnOrder indicates an expected call of ReturnOrder.
-func (mr *MockOrderRepositoryMockRecorder) ReturnOrder(id interface{}) *gomock.Call {
+// UpdateOrderItems indicates an expected call of UpdateOrderItems.
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderItems(orderID, userID, changes, returned, extraRefund interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockOrderRepository)(nil).ReturnOrder), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderItems", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderItems), orderID, userID, changes, returned, extraRefund)
 }
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/payment.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPrice mocks base method.
+func (m *MockPaymentRepository) FindPrice(order_id int) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrice", order_id)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrice indicates an expected call of FindPrice.
+func (mr *MockPaymentRepositoryMockRecorder) FindPrice(order_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrice", reflect.TypeOf((*MockPaymentRepository)(nil).FindPrice), order_id)
+}
+
+// FindUsername mocks base method.
+func (m *MockPaymentRepository) FindUsername(user_id int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsername", user_id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsername indicates an expected call of FindUsername.
+func (mr *MockPaymentRepositoryMockRecorder) FindUsername(user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsername", reflect.TypeOf((*MockPaymentRepository)(nil).FindUsername), user_id)
+}
+
+// GetOrderPayment mocks base method.
+func (m *MockPaymentRepository) GetOrderPayment(orderID int) (models.OrderPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPayment", orderID)
+	ret0, _ := ret[0].(models.OrderPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPayment indicates an expected call of GetOrderPayment.
+func (mr *MockPaymentRepositoryMockRecorder) GetOrderPayment(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPayment", reflect.TypeOf((*MockPaymentRepository)(nil).GetOrderPayment), orderID)
+}
+
+// UpdatePaymentDetails mocks base method.
+func (m *MockPaymentRepository) UpdatePaymentDetails(orderID, paymentID, razorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentDetails", orderID, paymentID, razorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentDetails indicates an expected call of UpdatePaymentDetails.
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentDetails(orderID, paymentID, razorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentDetails", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentDetails), orderID, paymentID, razorID)
+}
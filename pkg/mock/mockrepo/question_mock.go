@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/question.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQuestionRepository is a mock of QuestionRepository interface.
+type MockQuestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuestionRepositoryMockRecorder
+}
+
+// MockQuestionRepositoryMockRecorder is the mock recorder for MockQuestionRepository.
+type MockQuestionRepositoryMockRecorder struct {
+	mock *MockQuestionRepository
+}
+
+// NewMockQuestionRepository creates a new mock instance.
+func NewMockQuestionRepository(ctrl *gomock.Controller) *MockQuestionRepository {
+	mock := &MockQuestionRepository{ctrl: ctrl}
+	mock.recorder = &MockQuestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuestionRepository) EXPECT() *MockQuestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAnswer mocks base method.
+func (m *MockQuestionRepository) AddAnswer(questionID, userID int, answer string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnswer", questionID, userID, answer)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAnswer indicates an expected call of AddAnswer.
+func (mr *MockQuestionRepositoryMockRecorder) AddAnswer(questionID, userID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswer", reflect.TypeOf((*MockQuestionRepository)(nil).AddAnswer), questionID, userID, answer)
+}
+
+// AddAnswerVote mocks base method.
+func (m *MockQuestionRepository) AddAnswerVote(id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnswerVote", id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAnswerVote indicates an expected call of AddAnswerVote.
+func (mr *MockQuestionRepositoryMockRecorder) AddAnswerVote(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnswerVote", reflect.TypeOf((*MockQuestionRepository)(nil).AddAnswerVote), id, userID)
+}
+
+// AddQuestion mocks base method.
+func (m *MockQuestionRepository) AddQuestion(userID int, question models.AddQuestion) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddQuestion", userID, question)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddQuestion indicates an expected call of AddQuestion.
+func (mr *MockQuestionRepositoryMockRecorder) AddQuestion(userID, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).AddQuestion), userID, question)
+}
+
+// DeleteAnswer mocks base method.
+func (m *MockQuestionRepository) DeleteAnswer(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnswer", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnswer indicates an expected call of DeleteAnswer.
+func (mr *MockQuestionRepositoryMockRecorder) DeleteAnswer(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnswer", reflect.TypeOf((*MockQuestionRepository)(nil).DeleteAnswer), id)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockQuestionRepository) DeleteQuestion(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockQuestionRepositoryMockRecorder) DeleteQuestion(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).DeleteQuestion), id)
+}
+
+// GetAnswer mocks base method.
+func (m *MockQuestionRepository) GetAnswer(id int) (models.AnswerDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswer", id)
+	ret0, _ := ret[0].(models.AnswerDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswer indicates an expected call of GetAnswer.
+func (mr *MockQuestionRepositoryMockRecorder) GetAnswer(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswer", reflect.TypeOf((*MockQuestionRepository)(nil).GetAnswer), id)
+}
+
+// GetAnswers mocks base method.
+func (m *MockQuestionRepository) GetAnswers(questionID int) ([]models.AnswerDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswers", questionID)
+	ret0, _ := ret[0].([]models.AnswerDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswers indicates an expected call of GetAnswers.
+func (mr *MockQuestionRepositoryMockRecorder) GetAnswers(questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswers", reflect.TypeOf((*MockQuestionRepository)(nil).GetAnswers), questionID)
+}
+
+// GetProductQuestions mocks base method.
+func (m *MockQuestionRepository) GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductQuestions", inventoryID, page)
+	ret0, _ := ret[0].([]models.QuestionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductQuestions indicates an expected call of GetProductQuestions.
+func (mr *MockQuestionRepositoryMockRecorder) GetProductQuestions(inventoryID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).GetProductQuestions), inventoryID, page)
+}
+
+// GetQuestion mocks base method.
+func (m *MockQuestionRepository) GetQuestion(id int) (models.QuestionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestion", id)
+	ret0, _ := ret[0].(models.QuestionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestion indicates an expected call of GetQuestion.
+func (mr *MockQuestionRepositoryMockRecorder) GetQuestion(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).GetQuestion), id)
+}
+
+// GetUnansweredQuestions mocks base method.
+func (m *MockQuestionRepository) GetUnansweredQuestions(page int) ([]models.QuestionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnansweredQuestions", page)
+	ret0, _ := ret[0].([]models.QuestionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnansweredQuestions indicates an expected call of GetUnansweredQuestions.
+func (mr *MockQuestionRepositoryMockRecorder) GetUnansweredQuestions(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnansweredQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).GetUnansweredQuestions), page)
+}
+
+// RemoveAnswerVote mocks base method.
+func (m *MockQuestionRepository) RemoveAnswerVote(id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAnswerVote", id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAnswerVote indicates an expected call of RemoveAnswerVote.
+func (mr *MockQuestionRepositoryMockRecorder) RemoveAnswerVote(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnswerVote", reflect.TypeOf((*MockQuestionRepository)(nil).RemoveAnswerVote), id, userID)
+}
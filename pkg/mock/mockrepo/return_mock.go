@@ -0,0 +1,288 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/return.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReturnRepository is a mock of ReturnRepository interface.
+type MockReturnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnRepositoryMockRecorder
+}
+
+// MockReturnRepositoryMockRecorder is the mock recorder for MockReturnRepository.
+type MockReturnRepositoryMockRecorder struct {
+	mock *MockReturnRepository
+}
+
+// NewMockReturnRepository creates a new mock instance.
+func NewMockReturnRepository(ctrl *gomock.Controller) *MockReturnRepository {
+	mock := &MockReturnRepository{ctrl: ctrl}
+	mock.recorder = &MockReturnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnRepository) EXPECT() *MockReturnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReturnImage mocks base method.
+func (m *MockReturnRepository) AddReturnImage(id int, url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReturnImage", id, url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReturnImage indicates an expected call of AddReturnImage.
+func (mr *MockReturnRepositoryMockRecorder) AddReturnImage(id, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReturnImage", reflect.TypeOf((*MockReturnRepository)(nil).AddReturnImage), id, url)
+}
+
+// CompleteExchange mocks base method.
+func (m *MockReturnRepository) CompleteExchange(id int, changes []models.OrderItemChange, paid float64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteExchange", id, changes, paid)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteExchange indicates an expected call of CompleteExchange.
+func (mr *MockReturnRepositoryMockRecorder) CompleteExchange(id, changes, paid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteExchange", reflect.TypeOf((*MockReturnRepository)(nil).CompleteExchange), id, changes, paid)
+}
+
+// CountReturnImages mocks base method.
+func (m *MockReturnRepository) CountReturnImages(id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReturnImages", id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReturnImages indicates an expected call of CountReturnImages.
+func (mr *MockReturnRepositoryMockRecorder) CountReturnImages(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReturnImages", reflect.TypeOf((*MockReturnRepository)(nil).CountReturnImages), id)
+}
+
+// CreateExchangeRequest mocks base method.
+func (m *MockReturnRepository) CreateExchangeRequest(orderID, userID int, request models.ExchangeRequest, difference float64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExchangeRequest", orderID, userID, request, difference)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExchangeRequest indicates an expected call of CreateExchangeRequest.
+func (mr *MockReturnRepositoryMockRecorder) CreateExchangeRequest(orderID, userID, request, difference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExchangeRequest", reflect.TypeOf((*MockReturnRepository)(nil).CreateExchangeRequest), orderID, userID, request, difference)
+}
+
+// CreateReturnRequest mocks base method.
+func (m *MockReturnRepository) CreateReturnRequest(orderID, userID int, request models.ReturnRequest, items []models.OrderItemRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturnRequest", orderID, userID, request, items)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturnRequest indicates an expected call of CreateReturnRequest.
+func (mr *MockReturnRepositoryMockRecorder) CreateReturnRequest(orderID, userID, request, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturnRequest", reflect.TypeOf((*MockReturnRepository)(nil).CreateReturnRequest), orderID, userID, request, items)
+}
+
+// GetDeliveryDate mocks base method.
+func (m *MockReturnRepository) GetDeliveryDate(orderID int) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryDate", orderID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryDate indicates an expected call of GetDeliveryDate.
+func (mr *MockReturnRepositoryMockRecorder) GetDeliveryDate(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDate", reflect.TypeOf((*MockReturnRepository)(nil).GetDeliveryDate), orderID)
+}
+
+// GetExchangeProduct mocks base method.
+func (m *MockReturnRepository) GetExchangeProduct(inventoryID int) (models.ExchangeProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeProduct", inventoryID)
+	ret0, _ := ret[0].(models.ExchangeProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeProduct indicates an expected call of GetExchangeProduct.
+func (mr *MockReturnRepositoryMockRecorder) GetExchangeProduct(inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeProduct", reflect.TypeOf((*MockReturnRepository)(nil).GetExchangeProduct), inventoryID)
+}
+
+// GetOpenReturnQuantities mocks base method.
+func (m *MockReturnRepository) GetOpenReturnQuantities(orderID int) ([]models.ReturnItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReturnQuantities", orderID)
+	ret0, _ := ret[0].([]models.ReturnItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReturnQuantities indicates an expected call of GetOpenReturnQuantities.
+func (mr *MockReturnRepositoryMockRecorder) GetOpenReturnQuantities(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReturnQuantities", reflect.TypeOf((*MockReturnRepository)(nil).GetOpenReturnQuantities), orderID)
+}
+
+// GetOrderItemProduct mocks base method.
+func (m *MockReturnRepository) GetOrderItemProduct(orderItemID int) (models.ExchangeProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemProduct", orderItemID)
+	ret0, _ := ret[0].(models.ExchangeProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemProduct indicates an expected call of GetOrderItemProduct.
+func (mr *MockReturnRepositoryMockRecorder) GetOrderItemProduct(orderItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemProduct", reflect.TypeOf((*MockReturnRepository)(nil).GetOrderItemProduct), orderItemID)
+}
+
+// GetReturnImages mocks base method.
+func (m *MockReturnRepository) GetReturnImages(id int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnImages", id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnImages indicates an expected call of GetReturnImages.
+func (mr *MockReturnRepositoryMockRecorder) GetReturnImages(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnImages", reflect.TypeOf((*MockReturnRepository)(nil).GetReturnImages), id)
+}
+
+// GetReturnItems mocks base method.
+func (m *MockReturnRepository) GetReturnItems(id int) ([]models.ReturnItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnItems", id)
+	ret0, _ := ret[0].([]models.ReturnItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnItems indicates an expected call of GetReturnItems.
+func (mr *MockReturnRepositoryMockRecorder) GetReturnItems(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnItems", reflect.TypeOf((*MockReturnRepository)(nil).GetReturnItems), id)
+}
+
+// GetReturnRequest mocks base method.
+func (m *MockReturnRepository) GetReturnRequest(id int) (models.ReturnRequestDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnRequest", id)
+	ret0, _ := ret[0].(models.ReturnRequestDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnRequest indicates an expected call of GetReturnRequest.
+func (mr *MockReturnRepositoryMockRecorder) GetReturnRequest(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnRequest", reflect.TypeOf((*MockReturnRepository)(nil).GetReturnRequest), id)
+}
+
+// GetReturnRequests mocks base method.
+func (m *MockReturnRepository) GetReturnRequests(userID int, status string, page int) ([]models.ReturnRequestDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnRequests", userID, status, page)
+	ret0, _ := ret[0].([]models.ReturnRequestDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnRequests indicates an expected call of GetReturnRequests.
+func (mr *MockReturnRepositoryMockRecorder) GetReturnRequests(userID, status, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnRequests", reflect.TypeOf((*MockReturnRepository)(nil).GetReturnRequests), userID, status, page)
+}
+
+// ReleaseExchangeStock mocks base method.
+func (m *MockReturnRepository) ReleaseExchangeStock(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExchangeStock", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseExchangeStock indicates an expected call of ReleaseExchangeStock.
+func (mr *MockReturnRepositoryMockRecorder) ReleaseExchangeStock(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExchangeStock", reflect.TypeOf((*MockReturnRepository)(nil).ReleaseExchangeStock), id)
+}
+
+// SchedulePickup mocks base method.
+func (m *MockReturnRepository) SchedulePickup(id int, date time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePickup", id, date)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePickup indicates an expected call of SchedulePickup.
+func (mr *MockReturnRepositoryMockRecorder) SchedulePickup(id, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePickup", reflect.TypeOf((*MockReturnRepository)(nil).SchedulePickup), id, date)
+}
+
+// SetReturnRefund mocks base method.
+func (m *MockReturnRepository) SetReturnRefund(id int, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReturnRefund", id, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReturnRefund indicates an expected call of SetReturnRefund.
+func (mr *MockReturnRepositoryMockRecorder) SetReturnRefund(id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReturnRefund", reflect.TypeOf((*MockReturnRepository)(nil).SetReturnRefund), id, amount)
+}
+
+// UpdateReturnStatus mocks base method.
+func (m *MockReturnRepository) UpdateReturnStatus(id int, from, to, note string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReturnStatus", id, from, to, note)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReturnStatus indicates an expected call of UpdateReturnStatus.
+func (mr *MockReturnRepositoryMockRecorder) UpdateReturnStatus(id, from, to, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReturnStatus", reflect.TypeOf((*MockReturnRepository)(nil).UpdateReturnStatus), id, from, to, note)
+}
@@ -0,0 +1,268 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/review.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockReviewRepository) AddReview(userID int, review models.AddReview) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", userID, review)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockReviewRepositoryMockRecorder) AddReview(userID, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReviewRepository)(nil).AddReview), userID, review)
+}
+
+// AddReviewImage mocks base method.
+func (m *MockReviewRepository) AddReviewImage(id int, url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewImage", id, url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReviewImage indicates an expected call of AddReviewImage.
+func (mr *MockReviewRepositoryMockRecorder) AddReviewImage(id, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewImage", reflect.TypeOf((*MockReviewRepository)(nil).AddReviewImage), id, url)
+}
+
+// AddReviewVote mocks base method.
+func (m *MockReviewRepository) AddReviewVote(id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewVote", id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReviewVote indicates an expected call of AddReviewVote.
+func (mr *MockReviewRepositoryMockRecorder) AddReviewVote(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewVote", reflect.TypeOf((*MockReviewRepository)(nil).AddReviewVote), id, userID)
+}
+
+// CheckDeliveredPurchase mocks base method.
+func (m *MockReviewRepository) CheckDeliveredPurchase(userID, inventoryID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDeliveredPurchase", userID, inventoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDeliveredPurchase indicates an expected call of CheckDeliveredPurchase.
+func (mr *MockReviewRepositoryMockRecorder) CheckDeliveredPurchase(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDeliveredPurchase", reflect.TypeOf((*MockReviewRepository)(nil).CheckDeliveredPurchase), userID, inventoryID)
+}
+
+// CheckReviewExists mocks base method.
+func (m *MockReviewRepository) CheckReviewExists(userID, inventoryID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReviewExists", userID, inventoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckReviewExists indicates an expected call of CheckReviewExists.
+func (mr *MockReviewRepositoryMockRecorder) CheckReviewExists(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReviewExists", reflect.TypeOf((*MockReviewRepository)(nil).CheckReviewExists), userID, inventoryID)
+}
+
+// CountReviewImages mocks base method.
+func (m *MockReviewRepository) CountReviewImages(id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviewImages", id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviewImages indicates an expected call of CountReviewImages.
+func (mr *MockReviewRepositoryMockRecorder) CountReviewImages(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewImages", reflect.TypeOf((*MockReviewRepository)(nil).CountReviewImages), id)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewRepository) DeleteReview(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewRepositoryMockRecorder) DeleteReview(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewRepository)(nil).DeleteReview), id)
+}
+
+// GetProductRating mocks base method.
+func (m *MockReviewRepository) GetProductRating(inventoryID int) (models.ProductReviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductRating", inventoryID)
+	ret0, _ := ret[0].(models.ProductReviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductRating indicates an expected call of GetProductRating.
+func (mr *MockReviewRepositoryMockRecorder) GetProductRating(inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductRating", reflect.TypeOf((*MockReviewRepository)(nil).GetProductRating), inventoryID)
+}
+
+// GetProductReviews mocks base method.
+func (m *MockReviewRepository) GetProductReviews(inventoryID, page int, sort string) ([]models.ReviewDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductReviews", inventoryID, page, sort)
+	ret0, _ := ret[0].([]models.ReviewDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductReviews indicates an expected call of GetProductReviews.
+func (mr *MockReviewRepositoryMockRecorder) GetProductReviews(inventoryID, page, sort interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductReviews", reflect.TypeOf((*MockReviewRepository)(nil).GetProductReviews), inventoryID, page, sort)
+}
+
+// GetReview mocks base method.
+func (m *MockReviewRepository) GetReview(id int) (models.ReviewDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", id)
+	ret0, _ := ret[0].(models.ReviewDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockReviewRepositoryMockRecorder) GetReview(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReviewRepository)(nil).GetReview), id)
+}
+
+// GetReviewImages mocks base method.
+func (m *MockReviewRepository) GetReviewImages(id int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewImages", id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewImages indicates an expected call of GetReviewImages.
+func (mr *MockReviewRepositoryMockRecorder) GetReviewImages(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewImages", reflect.TypeOf((*MockReviewRepository)(nil).GetReviewImages), id)
+}
+
+// GetReviews mocks base method.
+func (m *MockReviewRepository) GetReviews(userID int, status string, page int) ([]models.ReviewDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", userID, status, page)
+	ret0, _ := ret[0].([]models.ReviewDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockReviewRepositoryMockRecorder) GetReviews(userID, status, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewRepository)(nil).GetReviews), userID, status, page)
+}
+
+// RemoveReviewVote mocks base method.
+func (m *MockReviewRepository) RemoveReviewVote(id, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReviewVote", id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReviewVote indicates an expected call of RemoveReviewVote.
+func (mr *MockReviewRepositoryMockRecorder) RemoveReviewVote(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReviewVote", reflect.TypeOf((*MockReviewRepository)(nil).RemoveReviewVote), id, userID)
+}
+
+// ReplyToReview mocks base method.
+func (m *MockReviewRepository) ReplyToReview(id int, reply string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyToReview", id, reply)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplyToReview indicates an expected call of ReplyToReview.
+func (mr *MockReviewRepositoryMockRecorder) ReplyToReview(id, reply interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyToReview", reflect.TypeOf((*MockReviewRepository)(nil).ReplyToReview), id, reply)
+}
+
+// SetReviewStatus mocks base method.
+func (m *MockReviewRepository) SetReviewStatus(id int, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewStatus", id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReviewStatus indicates an expected call of SetReviewStatus.
+func (mr *MockReviewRepositoryMockRecorder) SetReviewStatus(id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewStatus", reflect.TypeOf((*MockReviewRepository)(nil).SetReviewStatus), id, status)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewRepository) UpdateReview(id int, review models.EditReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", id, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewRepositoryMockRecorder) UpdateReview(id, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewRepository)(nil).UpdateReview), id, review)
+}
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/shipment.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShipmentRepository is a mock of ShipmentRepository interface.
+type MockShipmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentRepositoryMockRecorder
+}
+
+// MockShipmentRepositoryMockRecorder is the mock recorder for MockShipmentRepository.
+type MockShipmentRepositoryMockRecorder struct {
+	mock *MockShipmentRepository
+}
+
+// NewMockShipmentRepository creates a new mock instance.
+func NewMockShipmentRepository(ctrl *gomock.Controller) *MockShipmentRepository {
+	mock := &MockShipmentRepository{ctrl: ctrl}
+	mock.recorder = &MockShipmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentRepository) EXPECT() *MockShipmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTrackingEvent mocks base method.
+func (m *MockShipmentRepository) AddTrackingEvent(shipmentID int, event models.TrackingEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrackingEvent", shipmentID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTrackingEvent indicates an expected call of AddTrackingEvent.
+func (mr *MockShipmentRepositoryMockRecorder) AddTrackingEvent(shipmentID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrackingEvent", reflect.TypeOf((*MockShipmentRepository)(nil).AddTrackingEvent), shipmentID, event)
+}
+
+// CheckTrackingEventExists mocks base method.
+func (m *MockShipmentRepository) CheckTrackingEventExists(shipmentID int, status string, occurredAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTrackingEventExists", shipmentID, status, occurredAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckTrackingEventExists indicates an expected call of CheckTrackingEventExists.
+func (mr *MockShipmentRepositoryMockRecorder) CheckTrackingEventExists(shipmentID, status, occurredAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTrackingEventExists", reflect.TypeOf((*MockShipmentRepository)(nil).CheckTrackingEventExists), shipmentID, status, occurredAt)
+}
+
+// CountUndeliveredShipments mocks base method.
+func (m *MockShipmentRepository) CountUndeliveredShipments(orderID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUndeliveredShipments", orderID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUndeliveredShipments indicates an expected call of CountUndeliveredShipments.
+func (mr *MockShipmentRepositoryMockRecorder) CountUndeliveredShipments(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUndeliveredShipments", reflect.TypeOf((*MockShipmentRepository)(nil).CountUndeliveredShipments), orderID)
+}
+
+// CreateShipment mocks base method.
+func (m *MockShipmentRepository) CreateShipment(orderID int, booking models.CourierBooking, items []models.ShipmentItemRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", orderID, booking, items)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockShipmentRepositoryMockRecorder) CreateShipment(orderID, booking, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockShipmentRepository)(nil).CreateShipment), orderID, booking, items)
+}
+
+// GetOrderItemQuantities mocks base method.
+func (m *MockShipmentRepository) GetOrderItemQuantities(orderID int) ([]models.OrderItemQuantity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemQuantities", orderID)
+	ret0, _ := ret[0].([]models.OrderItemQuantity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemQuantities indicates an expected call of GetOrderItemQuantities.
+func (mr *MockShipmentRepositoryMockRecorder) GetOrderItemQuantities(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemQuantities", reflect.TypeOf((*MockShipmentRepository)(nil).GetOrderItemQuantities), orderID)
+}
+
+// GetShipment mocks base method.
+func (m *MockShipmentRepository) GetShipment(id int) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipment", id)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipment indicates an expected call of GetShipment.
+func (mr *MockShipmentRepositoryMockRecorder) GetShipment(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipment", reflect.TypeOf((*MockShipmentRepository)(nil).GetShipment), id)
+}
+
+// GetShipmentItems mocks base method.
+func (m *MockShipmentRepository) GetShipmentItems(shipmentID int) ([]models.ShipmentItemDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipmentItems", shipmentID)
+	ret0, _ := ret[0].([]models.ShipmentItemDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipmentItems indicates an expected call of GetShipmentItems.
+func (mr *MockShipmentRepositoryMockRecorder) GetShipmentItems(shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipmentItems", reflect.TypeOf((*MockShipmentRepository)(nil).GetShipmentItems), shipmentID)
+}
+
+// GetShipmentsByOrder mocks base method.
+func (m *MockShipmentRepository) GetShipmentsByOrder(orderID int) ([]models.ShipmentDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipmentsByOrder", orderID)
+	ret0, _ := ret[0].([]models.ShipmentDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipmentsByOrder indicates an expected call of GetShipmentsByOrder.
+func (mr *MockShipmentRepositoryMockRecorder) GetShipmentsByOrder(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipmentsByOrder", reflect.TypeOf((*MockShipmentRepository)(nil).GetShipmentsByOrder), orderID)
+}
+
+// GetShippingAddress mocks base method.
+func (m *MockShipmentRepository) GetShippingAddress(orderID int) (models.CourierShipmentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShippingAddress", orderID)
+	ret0, _ := ret[0].(models.CourierShipmentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShippingAddress indicates an expected call of GetShippingAddress.
+func (mr *MockShipmentRepositoryMockRecorder) GetShippingAddress(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShippingAddress", reflect.TypeOf((*MockShipmentRepository)(nil).GetShippingAddress), orderID)
+}
+
+// GetTrackingEvents mocks base method.
+func (m *MockShipmentRepository) GetTrackingEvents(shipmentID int) ([]models.TrackingEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackingEvents", shipmentID)
+	ret0, _ := ret[0].([]models.TrackingEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackingEvents indicates an expected call of GetTrackingEvents.
+func (mr *MockShipmentRepositoryMockRecorder) GetTrackingEvents(shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackingEvents", reflect.TypeOf((*MockShipmentRepository)(nil).GetTrackingEvents), shipmentID)
+}
@@ -0,0 +1,242 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/shipping.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShippingRepository is a mock of ShippingRepository interface.
+type MockShippingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRepositoryMockRecorder
+}
+
+// MockShippingRepositoryMockRecorder is the mock recorder for MockShippingRepository.
+type MockShippingRepositoryMockRecorder struct {
+	mock *MockShippingRepository
+}
+
+// NewMockShippingRepository creates a new mock instance.
+func NewMockShippingRepository(ctrl *gomock.Controller) *MockShippingRepository {
+	mock := &MockShippingRepository{ctrl: ctrl}
+	mock.recorder = &MockShippingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRepository) EXPECT() *MockShippingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddShippingZone mocks base method.
+func (m *MockShippingRepository) AddShippingZone(zone models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddShippingZone", zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddShippingZone indicates an expected call of AddShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) AddShippingZone(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).AddShippingZone), zone)
+}
+
+// CheckIfCashOnDelivery mocks base method.
+func (m *MockShippingRepository) CheckIfCashOnDelivery(paymentID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfCashOnDelivery", paymentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfCashOnDelivery indicates an expected call of CheckIfCashOnDelivery.
+func (mr *MockShippingRepositoryMockRecorder) CheckIfCashOnDelivery(paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCashOnDelivery", reflect.TypeOf((*MockShippingRepository)(nil).CheckIfCashOnDelivery), paymentID)
+}
+
+// CheckShippingZoneExists mocks base method.
+func (m *MockShippingRepository) CheckShippingZoneExists(id int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckShippingZoneExists", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckShippingZoneExists indicates an expected call of CheckShippingZoneExists.
+func (mr *MockShippingRepositoryMockRecorder) CheckShippingZoneExists(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckShippingZoneExists", reflect.TypeOf((*MockShippingRepository)(nil).CheckShippingZoneExists), id)
+}
+
+// CountServiceablePins mocks base method.
+func (m *MockShippingRepository) CountServiceablePins() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountServiceablePins")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountServiceablePins indicates an expected call of CountServiceablePins.
+func (mr *MockShippingRepositoryMockRecorder) CountServiceablePins() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountServiceablePins", reflect.TypeOf((*MockShippingRepository)(nil).CountServiceablePins))
+}
+
+// DeleteServiceablePin mocks base method.
+func (m *MockShippingRepository) DeleteServiceablePin(pin string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceablePin", pin)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceablePin indicates an expected call of DeleteServiceablePin.
+func (mr *MockShippingRepositoryMockRecorder) DeleteServiceablePin(pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceablePin", reflect.TypeOf((*MockShippingRepository)(nil).DeleteServiceablePin), pin)
+}
+
+// DeleteShippingZone mocks base method.
+func (m *MockShippingRepository) DeleteShippingZone(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShippingZone", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShippingZone indicates an expected call of DeleteShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) DeleteShippingZone(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).DeleteShippingZone), id)
+}
+
+// FindServiceablePin mocks base method.
+func (m *MockShippingRepository) FindServiceablePin(pin string) (domain.ServiceablePin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServiceablePin", pin)
+	ret0, _ := ret[0].(domain.ServiceablePin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServiceablePin indicates an expected call of FindServiceablePin.
+func (mr *MockShippingRepositoryMockRecorder) FindServiceablePin(pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServiceablePin", reflect.TypeOf((*MockShippingRepository)(nil).FindServiceablePin), pin)
+}
+
+// FindShippingZone mocks base method.
+func (m *MockShippingRepository) FindShippingZone(state, pin string) (domain.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShippingZone", state, pin)
+	ret0, _ := ret[0].(domain.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShippingZone indicates an expected call of FindShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) FindShippingZone(state, pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).FindShippingZone), state, pin)
+}
+
+// GetAddress mocks base method.
+func (m *MockShippingRepository) GetAddress(addressID int) (models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress", addressID)
+	ret0, _ := ret[0].(models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddress indicates an expected call of GetAddress.
+func (mr *MockShippingRepositoryMockRecorder) GetAddress(addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockShippingRepository)(nil).GetAddress), addressID)
+}
+
+// GetServiceablePins mocks base method.
+func (m *MockShippingRepository) GetServiceablePins(page int) ([]models.ServiceablePin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceablePins", page)
+	ret0, _ := ret[0].([]models.ServiceablePin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceablePins indicates an expected call of GetServiceablePins.
+func (mr *MockShippingRepositoryMockRecorder) GetServiceablePins(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceablePins", reflect.TypeOf((*MockShippingRepository)(nil).GetServiceablePins), page)
+}
+
+// GetShippingZones mocks base method.
+func (m *MockShippingRepository) GetShippingZones() ([]models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShippingZones")
+	ret0, _ := ret[0].([]models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShippingZones indicates an expected call of GetShippingZones.
+func (mr *MockShippingRepositoryMockRecorder) GetShippingZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShippingZones", reflect.TypeOf((*MockShippingRepository)(nil).GetShippingZones))
+}
+
+// GetZoneAreas mocks base method.
+func (m *MockShippingRepository) GetZoneAreas(zoneID int) ([]domain.ShippingZoneArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZoneAreas", zoneID)
+	ret0, _ := ret[0].([]domain.ShippingZoneArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZoneAreas indicates an expected call of GetZoneAreas.
+func (mr *MockShippingRepositoryMockRecorder) GetZoneAreas(zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneAreas", reflect.TypeOf((*MockShippingRepository)(nil).GetZoneAreas), zoneID)
+}
+
+// ImportServiceablePins mocks base method.
+func (m *MockShippingRepository) ImportServiceablePins(pins []models.ServiceablePin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportServiceablePins", pins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportServiceablePins indicates an expected call of ImportServiceablePins.
+func (mr *MockShippingRepositoryMockRecorder) ImportServiceablePins(pins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportServiceablePins", reflect.TypeOf((*MockShippingRepository)(nil).ImportServiceablePins), pins)
+}
+
+// UpdateShippingZone mocks base method.
+func (m *MockShippingRepository) UpdateShippingZone(zone models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShippingZone", zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShippingZone indicates an expected call of UpdateShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) UpdateShippingZone(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).UpdateShippingZone), zone)
+}
@@ -8,6 +8,7 @@ import (
 	domain "jerseyhub/pkg/domain"
 	models "jerseyhub/pkg/utils/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -63,6 +64,21 @@ func (mr *MockUserRepositoryMockRecorder) ChangePassword(id, password interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserRepository)(nil).ChangePassword), id, password)
 }
 
+// CheckIfAddressInOrders mocks base method.
+func (m *MockUserRepository) CheckIfAddressInOrders(addressID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfAddressInOrders", addressID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfAddressInOrders indicates an expected call of CheckIfAddressInOrders.
+func (mr *MockUserRepositoryMockRecorder) CheckIfAddressInOrders(addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfAddressInOrders", reflect.TypeOf((*MockUserRepository)(nil).CheckIfAddressInOrders), addressID)
+}
+
 // CheckIfFirstAddress mocks base method.
 func (m *MockUserRepository) CheckIfFirstAddress(id int) bool {
 	m.ctrl.T.Helper()
@@ -91,6 +107,20 @@ func (mr *MockUserRepositoryMockRecorder) CheckUserAvailability(email interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserAvailability", reflect.TypeOf((*MockUserRepository)(nil).CheckUserAvailability), email)
 }
 
+// CreatePasswordReset mocks base method.
+func (m *MockUserRepository) CreatePasswordReset(userID int, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", userID, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockUserRepositoryMockRecorder) CreatePasswordReset(userID, tokenHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockUserRepository)(nil).CreatePasswordReset), userID, tokenHash, expiresAt)
+}
+
 // CreditReferencePointsToWallet mocks base method.
 func (m *MockUserRepository) CreditReferencePointsToWallet(user_id int) error {
 	m.ctrl.T.Helper()
@@ -105,6 +135,20 @@ func (mr *MockUserRepositoryMockRecorder) CreditReferencePointsToWallet(user_id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditReferencePointsToWallet", reflect.TypeOf((*MockUserRepository)(nil).CreditReferencePointsToWallet), user_id)
 }
 
+// DeleteAddress mocks base method.
+func (m *MockUserRepository) DeleteAddress(userID, addressID int, soft bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", userID, addressID, soft)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockUserRepositoryMockRecorder) DeleteAddress(userID, addressID, soft interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockUserRepository)(nil).DeleteAddress), userID, addressID, soft)
+}
+
 // EditEmail mocks base method.
 func (m *MockUserRepository) EditEmail(id int, email string) error {
 	m.ctrl.T.Helper()
@@ -192,6 +236,21 @@ func (mr *MockUserRepositoryMockRecorder) FindIdFromPhone(phone interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdFromPhone", reflect.TypeOf((*MockUserRepository)(nil).FindIdFromPhone), phone)
 }
 
+// FindPasswordReset mocks base method.
+func (m *MockUserRepository) FindPasswordReset(tokenHash string) (domain.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPasswordReset", tokenHash)
+	ret0, _ := ret[0].(domain.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPasswordReset indicates an expected call of FindPasswordReset.
+func (mr *MockUserRepositoryMockRecorder) FindPasswordReset(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPasswordReset", reflect.TypeOf((*MockUserRepository)(nil).FindPasswordReset), tokenHash)
+}
+
 // FindPrice mocks base method.
 func (m *MockUserRepository) FindPrice(inventory_id int) (float64, error) {
 	m.ctrl.T.Helper()
@@ -297,6 +356,21 @@ func (mr *MockUserRepositoryMockRecorder) FindofferPercentage(category_id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindofferPercentage", reflect.TypeOf((*MockUserRepository)(nil).FindofferPercentage), category_id)
 }
 
+// GetAddress mocks base method.
+func (m *MockUserRepository) GetAddress(userID, addressID int) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress", userID, addressID)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddress indicates an expected call of GetAddress.
+func (mr *MockUserRepositoryMockRecorder) GetAddress(userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockUserRepository)(nil).GetAddress), userID, addressID)
+}
+
 // GetAddresses mocks base method.
 func (m *MockUserRepository) GetAddresses(id int) ([]domain.Address, error) {
 	m.ctrl.T.Helper()
@@ -387,6 +461,21 @@ func (mr *MockUserRepositoryMockRecorder) GetReferralCodeFromID(id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralCodeFromID", reflect.TypeOf((*MockUserRepository)(nil).GetReferralCodeFromID), id)
 }
 
+// GetSessionsRevokedAt mocks base method.
+func (m *MockUserRepository) GetSessionsRevokedAt(id int) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsRevokedAt", id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsRevokedAt indicates an expected call of GetSessionsRevokedAt.
+func (mr *MockUserRepositoryMockRecorder) GetSessionsRevokedAt(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsRevokedAt", reflect.TypeOf((*MockUserRepository)(nil).GetSessionsRevokedAt), id)
+}
+
 // GetUserDetails mocks base method.
 func (m *MockUserRepository) GetUserDetails(id int) (models.UserDetailsResponse, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +505,48 @@ func (mr *MockUserRepositoryMockRecorder) RemoveFromCart(cart, inventory interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromCart", reflect.TypeOf((*MockUserRepository)(nil).RemoveFromCart), cart, inventory)
 }
 
+// ResetPassword mocks base method.
+func (m *MockUserRepository) ResetPassword(resetID, userID int, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", resetID, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserRepositoryMockRecorder) ResetPassword(resetID, userID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserRepository)(nil).ResetPassword), resetID, userID, password)
+}
+
+// SetDefaultAddress mocks base method.
+func (m *MockUserRepository) SetDefaultAddress(userID, addressID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAddress", userID, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultAddress indicates an expected call of SetDefaultAddress.
+func (mr *MockUserRepositoryMockRecorder) SetDefaultAddress(userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAddress", reflect.TypeOf((*MockUserRepository)(nil).SetDefaultAddress), userID, addressID)
+}
+
+// UpdateAddress mocks base method.
+func (m *MockUserRepository) UpdateAddress(userID, addressID int, address models.AddAddress, isDefault bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddress", userID, addressID, address, isDefault)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAddress indicates an expected call of UpdateAddress.
+func (mr *MockUserRepositoryMockRecorder) UpdateAddress(userID, addressID, address, isDefault interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddress", reflect.TypeOf((*MockUserRepository)(nil).UpdateAddress), userID, addressID, address, isDefault)
+}
+
 // UpdateQuantityAdd mocks base method.
 func (m *MockUserRepository) UpdateQuantityAdd(id, inv_id int) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interface/wishlist.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWishlistRepository is a mock of WishlistRepository interface.
+type MockWishlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepositoryMockRecorder
+}
+
+// MockWishlistRepositoryMockRecorder is the mock recorder for MockWishlistRepository.
+type MockWishlistRepositoryMockRecorder struct {
+	mock *MockWishlistRepository
+}
+
+// NewMockWishlistRepository creates a new mock instance.
+func NewMockWishlistRepository(ctrl *gomock.Controller) *MockWishlistRepository {
+	mock := &MockWishlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepository) EXPECT() *MockWishlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddToWishlist mocks base method.
+func (m *MockWishlistRepository) AddToWishlist(user_id, inventory_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWishlist", user_id, inventory_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWishlist indicates an expected call of AddToWishlist.
+func (mr *MockWishlistRepositoryMockRecorder) AddToWishlist(user_id, inventory_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWishlist", reflect.TypeOf((*MockWishlistRepository)(nil).AddToWishlist), user_id, inventory_id)
+}
+
+// CheckIfTheItemIsPresentAtCart mocks base method.
+func (m *MockWishlistRepository) CheckIfTheItemIsPresentAtCart(userID, productID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfTheItemIsPresentAtCart", userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfTheItemIsPresentAtCart indicates an expected call of CheckIfTheItemIsPresentAtCart.
+func (mr *MockWishlistRepositoryMockRecorder) CheckIfTheItemIsPresentAtCart(userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfTheItemIsPresentAtCart", reflect.TypeOf((*MockWishlistRepository)(nil).CheckIfTheItemIsPresentAtCart), userID, productID)
+}
+
+// CheckIfTheItemIsPresentAtWishlist mocks base method.
+func (m *MockWishlistRepository) CheckIfTheItemIsPresentAtWishlist(userID, productID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfTheItemIsPresentAtWishlist", userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfTheItemIsPresentAtWishlist indicates an expected call of CheckIfTheItemIsPresentAtWishlist.
+func (mr *MockWishlistRepositoryMockRecorder) CheckIfTheItemIsPresentAtWishlist(userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfTheItemIsPresentAtWishlist", reflect.TypeOf((*MockWishlistRepository)(nil).CheckIfTheItemIsPresentAtWishlist), userID, productID)
+}
+
+// GetWishList mocks base method.
+func (m *MockWishlistRepository) GetWishList(id int) ([]models.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishList", id)
+	ret0, _ := ret[0].([]models.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishList indicates an expected call of GetWishList.
+func (mr *MockWishlistRepositoryMockRecorder) GetWishList(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishList", reflect.TypeOf((*MockWishlistRepository)(nil).GetWishList), id)
+}
+
+// MoveToCart mocks base method.
+func (m *MockWishlistRepository) MoveToCart(userID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToCart", userID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToCart indicates an expected call of MoveToCart.
+func (mr *MockWishlistRepositoryMockRecorder) MoveToCart(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToCart", reflect.TypeOf((*MockWishlistRepository)(nil).MoveToCart), userID, inventoryID)
+}
+
+// MoveToWishlist mocks base method.
+func (m *MockWishlistRepository) MoveToWishlist(userID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToWishlist", userID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToWishlist indicates an expected call of MoveToWishlist.
+func (mr *MockWishlistRepositoryMockRecorder) MoveToWishlist(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToWishlist", reflect.TypeOf((*MockWishlistRepository)(nil).MoveToWishlist), userID, inventoryID)
+}
+
+// RemoveFromWishlist mocks base method.
+func (m *MockWishlistRepository) RemoveFromWishlist(inventory_id, UserID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWishlist", inventory_id, UserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWishlist indicates an expected call of RemoveFromWishlist.
+func (mr *MockWishlistRepositoryMockRecorder) RemoveFromWishlist(inventory_id, UserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWishlist", reflect.TypeOf((*MockWishlistRepository)(nil).RemoveFromWishlist), inventory_id, UserID)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/alert.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAlertUseCase is a mock of AlertUseCase interface.
+type MockAlertUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertUseCaseMockRecorder
+}
+
+// MockAlertUseCaseMockRecorder is the mock recorder for MockAlertUseCase.
+type MockAlertUseCaseMockRecorder struct {
+	mock *MockAlertUseCase
+}
+
+// NewMockAlertUseCase creates a new mock instance.
+func NewMockAlertUseCase(ctrl *gomock.Controller) *MockAlertUseCase {
+	mock := &MockAlertUseCase{ctrl: ctrl}
+	mock.recorder = &MockAlertUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertUseCase) EXPECT() *MockAlertUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetAlerts mocks base method.
+func (m *MockAlertUseCase) GetAlerts(userID int) ([]models.ProductAlertDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", userID)
+	ret0, _ := ret[0].([]models.ProductAlertDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockAlertUseCaseMockRecorder) GetAlerts(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockAlertUseCase)(nil).GetAlerts), userID)
+}
+
+// PricesChanged mocks base method.
+func (m *MockAlertUseCase) PricesChanged(inventoryID, categoryID int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PricesChanged", inventoryID, categoryID)
+}
+
+// PricesChanged indicates an expected call of PricesChanged.
+func (mr *MockAlertUseCaseMockRecorder) PricesChanged(inventoryID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PricesChanged", reflect.TypeOf((*MockAlertUseCase)(nil).PricesChanged), inventoryID, categoryID)
+}
+
+// ProductRestocked mocks base method.
+func (m *MockAlertUseCase) ProductRestocked(inventoryID int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProductRestocked", inventoryID)
+}
+
+// ProductRestocked indicates an expected call of ProductRestocked.
+func (mr *MockAlertUseCaseMockRecorder) ProductRestocked(inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductRestocked", reflect.TypeOf((*MockAlertUseCase)(nil).ProductRestocked), inventoryID)
+}
+
+// Subscribe mocks base method.
+func (m *MockAlertUseCase) Subscribe(userID int, alert models.AddProductAlert) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID, alert)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockAlertUseCaseMockRecorder) Subscribe(userID, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockAlertUseCase)(nil).Subscribe), userID, alert)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockAlertUseCase) Unsubscribe(userID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockAlertUseCaseMockRecorder) Unsubscribe(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockAlertUseCase)(nil).Unsubscribe), userID, id)
+}
+
+// UnwatchWishlistItem mocks base method.
+func (m *MockAlertUseCase) UnwatchWishlistItem(userID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwatchWishlistItem", userID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnwatchWishlistItem indicates an expected call of UnwatchWishlistItem.
+func (mr *MockAlertUseCaseMockRecorder) UnwatchWishlistItem(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwatchWishlistItem", reflect.TypeOf((*MockAlertUseCase)(nil).UnwatchWishlistItem), userID, inventoryID)
+}
+
+// WatchWishlistItem mocks base method.
+func (m *MockAlertUseCase) WatchWishlistItem(userID, inventoryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchWishlistItem", userID, inventoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchWishlistItem indicates an expected call of WatchWishlistItem.
+func (mr *MockAlertUseCaseMockRecorder) WatchWishlistItem(userID, inventoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWishlistItem", reflect.TypeOf((*MockAlertUseCase)(nil).WatchWishlistItem), userID, inventoryID)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/email.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailUseCase is a mock of EmailUseCase interface.
+type MockEmailUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailUseCaseMockRecorder
+}
+
+// MockEmailUseCaseMockRecorder is the mock recorder for MockEmailUseCase.
+type MockEmailUseCaseMockRecorder struct {
+	mock *MockEmailUseCase
+}
+
+// NewMockEmailUseCase creates a new mock instance.
+func NewMockEmailUseCase(ctrl *gomock.Controller) *MockEmailUseCase {
+	mock := &MockEmailUseCase{ctrl: ctrl}
+	mock.recorder = &MockEmailUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailUseCase) EXPECT() *MockEmailUseCaseMockRecorder {
+	return m.recorder
+}
+
+// SendOrderStatusEmail mocks base method.
+func (m *MockEmailUseCase) SendOrderStatusEmail(orderID int, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOrderStatusEmail", orderID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOrderStatusEmail indicates an expected call of SendOrderStatusEmail.
+func (mr *MockEmailUseCaseMockRecorder) SendOrderStatusEmail(orderID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderStatusEmail", reflect.TypeOf((*MockEmailUseCase)(nil).SendOrderStatusEmail), orderID, status)
+}
+
+// SendPasswordChangedEmail mocks base method.
+func (m *MockEmailUseCase) SendPasswordChangedEmail(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordChangedEmail", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordChangedEmail indicates an expected call of SendPasswordChangedEmail.
+func (mr *MockEmailUseCaseMockRecorder) SendPasswordChangedEmail(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordChangedEmail", reflect.TypeOf((*MockEmailUseCase)(nil).SendPasswordChangedEmail), userID)
+}
+
+// SendPasswordResetEmail mocks base method.
+func (m *MockEmailUseCase) SendPasswordResetEmail(userID int, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetEmail", userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetEmail indicates an expected call of SendPasswordResetEmail.
+func (mr *MockEmailUseCaseMockRecorder) SendPasswordResetEmail(userID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetEmail", reflect.TypeOf((*MockEmailUseCase)(nil).SendPasswordResetEmail), userID, token)
+}
+
+// SendRefundProcessedEmail mocks base method.
+func (m *MockEmailUseCase) SendRefundProcessedEmail(orderID int, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRefundProcessedEmail", orderID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRefundProcessedEmail indicates an expected call of SendRefundProcessedEmail.
+func (mr *MockEmailUseCaseMockRecorder) SendRefundProcessedEmail(orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRefundProcessedEmail", reflect.TypeOf((*MockEmailUseCase)(nil).SendRefundProcessedEmail), orderID, amount)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockEmailUseCase) SendVerificationEmail(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationEmail", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockEmailUseCaseMockRecorder) SendVerificationEmail(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockEmailUseCase)(nil).SendVerificationEmail), userID)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockEmailUseCase) VerifyEmail(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockEmailUseCaseMockRecorder) VerifyEmail(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockEmailUseCase)(nil).VerifyEmail), token)
+}
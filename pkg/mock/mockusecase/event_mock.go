@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/event.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	interfaces "jerseyhub/pkg/usecase/interface"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventUseCase is a mock of EventUseCase interface.
+type MockEventUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventUseCaseMockRecorder
+}
+
+// MockEventUseCaseMockRecorder is the mock recorder for MockEventUseCase.
+type MockEventUseCaseMockRecorder struct {
+	mock *MockEventUseCase
+}
+
+// NewMockEventUseCase creates a new mock instance.
+func NewMockEventUseCase(ctrl *gomock.Controller) *MockEventUseCase {
+	mock := &MockEventUseCase{ctrl: ctrl}
+	mock.recorder = &MockEventUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventUseCase) EXPECT() *MockEventUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DispatchPending mocks base method.
+func (m *MockEventUseCase) DispatchPending() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchPending")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispatchPending indicates an expected call of DispatchPending.
+func (mr *MockEventUseCaseMockRecorder) DispatchPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchPending", reflect.TypeOf((*MockEventUseCase)(nil).DispatchPending))
+}
+
+// Start mocks base method.
+func (m *MockEventUseCase) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockEventUseCaseMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockEventUseCase)(nil).Start))
+}
+
+// Subscribe mocks base method.
+func (m *MockEventUseCase) Subscribe(event, subscriber string, handler interfaces.EventHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", event, subscriber, handler)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventUseCaseMockRecorder) Subscribe(event, subscriber, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventUseCase)(nil).Subscribe), event, subscriber, handler)
+}
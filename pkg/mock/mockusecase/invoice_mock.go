@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/invoice.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceUseCase is a mock of InvoiceUseCase interface.
+type MockInvoiceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceUseCaseMockRecorder
+}
+
+// MockInvoiceUseCaseMockRecorder is the mock recorder for MockInvoiceUseCase.
+type MockInvoiceUseCaseMockRecorder struct {
+	mock *MockInvoiceUseCase
+}
+
+// NewMockInvoiceUseCase creates a new mock instance.
+func NewMockInvoiceUseCase(ctrl *gomock.Controller) *MockInvoiceUseCase {
+	mock := &MockInvoiceUseCase{ctrl: ctrl}
+	mock.recorder = &MockInvoiceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceUseCase) EXPECT() *MockInvoiceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetCategoryTaxes mocks base method.
+func (m *MockInvoiceUseCase) GetCategoryTaxes() ([]models.CategoryTax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTaxes")
+	ret0, _ := ret[0].([]models.CategoryTax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTaxes indicates an expected call of GetCategoryTaxes.
+func (mr *MockInvoiceUseCaseMockRecorder) GetCategoryTaxes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTaxes", reflect.TypeOf((*MockInvoiceUseCase)(nil).GetCategoryTaxes))
+}
+
+// GetInvoice mocks base method.
+func (m *MockInvoiceUseCase) GetInvoice(orderID, userID int) (models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", orderID, userID)
+	ret0, _ := ret[0].(models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockInvoiceUseCaseMockRecorder) GetInvoice(orderID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockInvoiceUseCase)(nil).GetInvoice), orderID, userID)
+}
+
+// GetInvoiceDocument mocks base method.
+func (m *MockInvoiceUseCase) GetInvoiceDocument(orderID, userID int, format string) (models.InvoiceDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceDocument", orderID, userID, format)
+	ret0, _ := ret[0].(models.InvoiceDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceDocument indicates an expected call of GetInvoiceDocument.
+func (mr *MockInvoiceUseCaseMockRecorder) GetInvoiceDocument(orderID, userID, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceDocument", reflect.TypeOf((*MockInvoiceUseCase)(nil).GetInvoiceDocument), orderID, userID, format)
+}
+
+// ItemTaxes mocks base method.
+func (m *MockInvoiceUseCase) ItemTaxes(addressID int, cart []models.GetCart, couponRate int) (map[int]models.ItemTax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ItemTaxes", addressID, cart, couponRate)
+	ret0, _ := ret[0].(map[int]models.ItemTax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ItemTaxes indicates an expected call of ItemTaxes.
+func (mr *MockInvoiceUseCaseMockRecorder) ItemTaxes(addressID, cart, couponRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemTaxes", reflect.TypeOf((*MockInvoiceUseCase)(nil).ItemTaxes), addressID, cart, couponRate)
+}
+
+// SetCategoryTax mocks base method.
+func (m *MockInvoiceUseCase) SetCategoryTax(tax models.CategoryTax) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryTax", tax)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryTax indicates an expected call of SetCategoryTax.
+func (mr *MockInvoiceUseCaseMockRecorder) SetCategoryTax(tax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryTax", reflect.TypeOf((*MockInvoiceUseCase)(nil).SetCategoryTax), tax)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/job.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	interfaces "jerseyhub/pkg/usecase/interface"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJobUseCase is a mock of JobUseCase interface.
+type MockJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobUseCaseMockRecorder
+}
+
+// MockJobUseCaseMockRecorder is the mock recorder for MockJobUseCase.
+type MockJobUseCaseMockRecorder struct {
+	mock *MockJobUseCase
+}
+
+// NewMockJobUseCase creates a new mock instance.
+func NewMockJobUseCase(ctrl *gomock.Controller) *MockJobUseCase {
+	mock := &MockJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobUseCase) EXPECT() *MockJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockJobUseCase) Enqueue(name string, payload interface{}, runAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", name, payload, runAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockJobUseCaseMockRecorder) Enqueue(name, payload, runAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockJobUseCase)(nil).Enqueue), name, payload, runAt)
+}
+
+// GetJobStatus mocks base method.
+func (m *MockJobUseCase) GetJobStatus() (models.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus")
+	ret0, _ := ret[0].(models.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *MockJobUseCaseMockRecorder) GetJobStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*MockJobUseCase)(nil).GetJobStatus))
+}
+
+// GetJobs mocks base method.
+func (m *MockJobUseCase) GetJobs(status string, page int) ([]models.JobDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", status, page)
+	ret0, _ := ret[0].([]models.JobDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockJobUseCaseMockRecorder) GetJobs(status, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobUseCase)(nil).GetJobs), status, page)
+}
+
+// Register mocks base method.
+func (m *MockJobUseCase) Register(name string, handler interfaces.JobHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", name, handler)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockJobUseCaseMockRecorder) Register(name, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockJobUseCase)(nil).Register), name, handler)
+}
+
+// RetryJob mocks base method.
+func (m *MockJobUseCase) RetryJob(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockJobUseCaseMockRecorder) RetryJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobUseCase)(nil).RetryJob), id)
+}
+
+// Schedule mocks base method.
+func (m *MockJobUseCase) Schedule(spec, name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Schedule", spec, name)
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockJobUseCaseMockRecorder) Schedule(spec, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockJobUseCase)(nil).Schedule), spec, name)
+}
+
+// Start mocks base method.
+func (m *MockJobUseCase) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockJobUseCaseMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockJobUseCase)(nil).Start))
+}
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/notification.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationUseCase is a mock of NotificationUseCase interface.
+type MockNotificationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUseCaseMockRecorder
+}
+
+// MockNotificationUseCaseMockRecorder is the mock recorder for MockNotificationUseCase.
+type MockNotificationUseCaseMockRecorder struct {
+	mock *MockNotificationUseCase
+}
+
+// NewMockNotificationUseCase creates a new mock instance.
+func NewMockNotificationUseCase(ctrl *gomock.Controller) *MockNotificationUseCase {
+	mock := &MockNotificationUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUseCase) EXPECT() *MockNotificationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationUseCase) GetNotifications(userID, page int, unreadOnly bool) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", userID, page, unreadOnly)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) GetNotifications(userID, page, unreadOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).GetNotifications), userID, page, unreadOnly)
+}
+
+// GetPreferences mocks base method.
+func (m *MockNotificationUseCase) GetPreferences(userID int) ([]models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", userID)
+	ret0, _ := ret[0].([]models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockNotificationUseCaseMockRecorder) GetPreferences(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockNotificationUseCase)(nil).GetPreferences), userID)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationUseCase) GetUnreadCount(userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationUseCaseMockRecorder) GetUnreadCount(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationUseCase)(nil).GetUnreadCount), userID)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationUseCase) MarkAllRead(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationUseCaseMockRecorder) MarkAllRead(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationUseCase)(nil).MarkAllRead), userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationUseCase) MarkRead(userID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationUseCaseMockRecorder) MarkRead(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationUseCase)(nil).MarkRead), userID, id)
+}
+
+// Notify mocks base method.
+func (m *MockNotificationUseCase) Notify(userID int, notification models.Notification) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", userID, notification)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationUseCaseMockRecorder) Notify(userID, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationUseCase)(nil).Notify), userID, notification)
+}
+
+// PublishWalletCredit mocks base method.
+func (m *MockNotificationUseCase) PublishWalletCredit(orderID int, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWalletCredit", orderID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishWalletCredit indicates an expected call of PublishWalletCredit.
+func (mr *MockNotificationUseCaseMockRecorder) PublishWalletCredit(orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWalletCredit", reflect.TypeOf((*MockNotificationUseCase)(nil).PublishWalletCredit), orderID, amount)
+}
+
+// SetPreference mocks base method.
+func (m *MockNotificationUseCase) SetPreference(userID int, preference models.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", userID, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreference indicates an expected call of SetPreference.
+func (mr *MockNotificationUseCaseMockRecorder) SetPreference(userID, preference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockNotificationUseCase)(nil).SetPreference), userID, preference)
+}
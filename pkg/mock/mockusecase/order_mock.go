@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/order.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	domain "jerseyhub/pkg/domain"
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderUseCase is a mock of OrderUseCase interface.
+type MockOrderUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderUseCaseMockRecorder
+}
+
+// MockOrderUseCaseMockRecorder is the mock recorder for MockOrderUseCase.
+type MockOrderUseCaseMockRecorder struct {
+	mock *MockOrderUseCase
+}
+
+// NewMockOrderUseCase creates a new mock instance.
+func NewMockOrderUseCase(ctrl *gomock.Controller) *MockOrderUseCase {
+	mock := &MockOrderUseCase{ctrl: ctrl}
+	mock.recorder = &MockOrderUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderUseCase) EXPECT() *MockOrderUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AdminOrders mocks base method.
+func (m *MockOrderUseCase) AdminOrders() (domain.AdminOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminOrders")
+	ret0, _ := ret[0].(domain.AdminOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminOrders indicates an expected call of AdminOrders.
+func (mr *MockOrderUseCaseMockRecorder) AdminOrders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminOrders", reflect.TypeOf((*MockOrderUseCase)(nil).AdminOrders))
+}
+
+// CancelOrder mocks base method.
+func (m *MockOrderUseCase) CancelOrder(userID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrder", userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrder indicates an expected call of CancelOrder.
+func (mr *MockOrderUseCaseMockRecorder) CancelOrder(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockOrderUseCase)(nil).CancelOrder), userID, id)
+}
+
+// CancelOrderItems mocks base method.
+func (m *MockOrderUseCase) CancelOrderItems(userID, orderID int, request []models.OrderItemRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderItems", userID, orderID, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrderItems indicates an expected call of CancelOrderItems.
+func (mr *MockOrderUseCaseMockRecorder) CancelOrderItems(userID, orderID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderItems", reflect.TypeOf((*MockOrderUseCase)(nil).CancelOrderItems), userID, orderID, request)
+}
+
+// EditOrderStatus mocks base method.
+func (m *MockOrderUseCase) EditOrderStatus(status string, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditOrderStatus", status, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditOrderStatus indicates an expected call of EditOrderStatus.
+func (mr *MockOrderUseCaseMockRecorder) EditOrderStatus(status, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditOrderStatus", reflect.TypeOf((*MockOrderUseCase)(nil).EditOrderStatus), status, id)
+}
+
+// GetIndividualOrderDetails mocks base method.
+func (m *MockOrderUseCase) GetIndividualOrderDetails(id int) (models.IndividualOrderDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndividualOrderDetails", id)
+	ret0, _ := ret[0].(models.IndividualOrderDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndividualOrderDetails indicates an expected call of GetIndividualOrderDetails.
+func (mr *MockOrderUseCaseMockRecorder) GetIndividualOrderDetails(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndividualOrderDetails", reflect.TypeOf((*MockOrderUseCase)(nil).GetIndividualOrderDetails), id)
+}
+
+// GetOrders mocks base method.
+func (m *MockOrderUseCase) GetOrders(id int) ([]domain.OrderDetailsWithImages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", id)
+	ret0, _ := ret[0].([]domain.OrderDetailsWithImages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockOrderUseCaseMockRecorder) GetOrders(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderUseCase)(nil).GetOrders), id)
+}
+
+// MakePaymentStatusAsPaid mocks base method.
+func (m *MockOrderUseCase) MakePaymentStatusAsPaid(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakePaymentStatusAsPaid", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakePaymentStatusAsPaid indicates an expected call of MakePaymentStatusAsPaid.
+func (mr *MockOrderUseCaseMockRecorder) MakePaymentStatusAsPaid(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakePaymentStatusAsPaid", reflect.TypeOf((*MockOrderUseCase)(nil).MakePaymentStatusAsPaid), id)
+}
+
+// OrderItemsFromCart mocks base method.
+func (m *MockOrderUseCase) OrderItemsFromCart(userid, addressid, paymentid, couponID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderItemsFromCart", userid, addressid, paymentid, couponID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderItemsFromCart indicates an expected call of OrderItemsFromCart.
+func (mr *MockOrderUseCaseMockRecorder) OrderItemsFromCart(userid, addressid, paymentid, couponID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderItemsFromCart", reflect.TypeOf((*MockOrderUseCase)(nil).OrderItemsFromCart), userid, addressid, paymentid, couponID)
+}
+
+// ReturnOrderItems mocks base method.
+func (m *MockOrderUseCase) ReturnOrderItems(userID, orderID int, request []models.OrderItemRequest) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnOrderItems", userID, orderID, request)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnOrderItems indicates an expected call of ReturnOrderItems.
+func (mr *MockOrderUseCaseMockRecorder) ReturnOrderItems(userID, orderID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderItems", reflect.TypeOf((*MockOrderUseCase)(nil).ReturnOrderItems), userID, orderID, request)
+}
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/question.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQuestionUseCase is a mock of QuestionUseCase interface.
+type MockQuestionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuestionUseCaseMockRecorder
+}
+
+// MockQuestionUseCaseMockRecorder is the mock recorder for MockQuestionUseCase.
+type MockQuestionUseCaseMockRecorder struct {
+	mock *MockQuestionUseCase
+}
+
+// NewMockQuestionUseCase creates a new mock instance.
+func NewMockQuestionUseCase(ctrl *gomock.Controller) *MockQuestionUseCase {
+	mock := &MockQuestionUseCase{ctrl: ctrl}
+	mock.recorder = &MockQuestionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuestionUseCase) EXPECT() *MockQuestionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AnswerQuestion mocks base method.
+func (m *MockQuestionUseCase) AnswerQuestion(userID, questionID int, answer string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerQuestion", userID, questionID, answer)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerQuestion indicates an expected call of AnswerQuestion.
+func (mr *MockQuestionUseCaseMockRecorder) AnswerQuestion(userID, questionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerQuestion", reflect.TypeOf((*MockQuestionUseCase)(nil).AnswerQuestion), userID, questionID, answer)
+}
+
+// AskQuestion mocks base method.
+func (m *MockQuestionUseCase) AskQuestion(userID int, question models.AddQuestion) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskQuestion", userID, question)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskQuestion indicates an expected call of AskQuestion.
+func (mr *MockQuestionUseCaseMockRecorder) AskQuestion(userID, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskQuestion", reflect.TypeOf((*MockQuestionUseCase)(nil).AskQuestion), userID, question)
+}
+
+// DeleteAnswer mocks base method.
+func (m *MockQuestionUseCase) DeleteAnswer(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnswer", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnswer indicates an expected call of DeleteAnswer.
+func (mr *MockQuestionUseCaseMockRecorder) DeleteAnswer(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnswer", reflect.TypeOf((*MockQuestionUseCase)(nil).DeleteAnswer), id)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockQuestionUseCase) DeleteQuestion(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockQuestionUseCaseMockRecorder) DeleteQuestion(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockQuestionUseCase)(nil).DeleteQuestion), id)
+}
+
+// GetProductQuestions mocks base method.
+func (m *MockQuestionUseCase) GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductQuestions", inventoryID, page)
+	ret0, _ := ret[0].([]models.QuestionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductQuestions indicates an expected call of GetProductQuestions.
+func (mr *MockQuestionUseCaseMockRecorder) GetProductQuestions(inventoryID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductQuestions", reflect.TypeOf((*MockQuestionUseCase)(nil).GetProductQuestions), inventoryID, page)
+}
+
+// GetUnansweredQuestions mocks base method.
+func (m *MockQuestionUseCase) GetUnansweredQuestions(page int) ([]models.QuestionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnansweredQuestions", page)
+	ret0, _ := ret[0].([]models.QuestionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnansweredQuestions indicates an expected call of GetUnansweredQuestions.
+func (mr *MockQuestionUseCaseMockRecorder) GetUnansweredQuestions(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnansweredQuestions", reflect.TypeOf((*MockQuestionUseCase)(nil).GetUnansweredQuestions), page)
+}
+
+// VoteAnswer mocks base method.
+func (m *MockQuestionUseCase) VoteAnswer(userID, id int, upvote bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteAnswer", userID, id, upvote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoteAnswer indicates an expected call of VoteAnswer.
+func (mr *MockQuestionUseCaseMockRecorder) VoteAnswer(userID, id, upvote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteAnswer", reflect.TypeOf((*MockQuestionUseCase)(nil).VoteAnswer), userID, id, upvote)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/shipment.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShipmentUseCase is a mock of ShipmentUseCase interface.
+type MockShipmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentUseCaseMockRecorder
+}
+
+// MockShipmentUseCaseMockRecorder is the mock recorder for MockShipmentUseCase.
+type MockShipmentUseCaseMockRecorder struct {
+	mock *MockShipmentUseCase
+}
+
+// NewMockShipmentUseCase creates a new mock instance.
+func NewMockShipmentUseCase(ctrl *gomock.Controller) *MockShipmentUseCase {
+	mock := &MockShipmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockShipmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentUseCase) EXPECT() *MockShipmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddTrackingEvent mocks base method.
+func (m *MockShipmentUseCase) AddTrackingEvent(shipmentID int, event models.TrackingEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrackingEvent", shipmentID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTrackingEvent indicates an expected call of AddTrackingEvent.
+func (mr *MockShipmentUseCaseMockRecorder) AddTrackingEvent(shipmentID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrackingEvent", reflect.TypeOf((*MockShipmentUseCase)(nil).AddTrackingEvent), shipmentID, event)
+}
+
+// CompleteOrder mocks base method.
+func (m *MockShipmentUseCase) CompleteOrder(orderID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOrder", orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteOrder indicates an expected call of CompleteOrder.
+func (mr *MockShipmentUseCaseMockRecorder) CompleteOrder(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOrder", reflect.TypeOf((*MockShipmentUseCase)(nil).CompleteOrder), orderID)
+}
+
+// CreateShipment mocks base method.
+func (m *MockShipmentUseCase) CreateShipment(orderID int, shipment models.CreateShipment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", orderID, shipment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockShipmentUseCaseMockRecorder) CreateShipment(orderID, shipment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockShipmentUseCase)(nil).CreateShipment), orderID, shipment)
+}
+
+// GetShipments mocks base method.
+func (m *MockShipmentUseCase) GetShipments(orderID int) ([]models.ShipmentDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipments", orderID)
+	ret0, _ := ret[0].([]models.ShipmentDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipments indicates an expected call of GetShipments.
+func (mr *MockShipmentUseCaseMockRecorder) GetShipments(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipments", reflect.TypeOf((*MockShipmentUseCase)(nil).GetShipments), orderID)
+}
+
+// RefreshTracking mocks base method.
+func (m *MockShipmentUseCase) RefreshTracking(shipmentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTracking", shipmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTracking indicates an expected call of RefreshTracking.
+func (mr *MockShipmentUseCaseMockRecorder) RefreshTracking(shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTracking", reflect.TypeOf((*MockShipmentUseCase)(nil).RefreshTracking), shipmentID)
+}
@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/usecase/interface/shipping.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	models "jerseyhub/pkg/utils/models"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShippingUseCase is a mock of ShippingUseCase interface.
+type MockShippingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingUseCaseMockRecorder
+}
+
+// MockShippingUseCaseMockRecorder is the mock recorder for MockShippingUseCase.
+type MockShippingUseCaseMockRecorder struct {
+	mock *MockShippingUseCase
+}
+
+// NewMockShippingUseCase creates a new mock instance.
+func NewMockShippingUseCase(ctrl *gomock.Controller) *MockShippingUseCase {
+	mock := &MockShippingUseCase{ctrl: ctrl}
+	mock.recorder = &MockShippingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingUseCase) EXPECT() *MockShippingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddShippingZone mocks base method.
+func (m *MockShippingUseCase) AddShippingZone(zone models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddShippingZone", zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddShippingZone indicates an expected call of AddShippingZone.
+func (mr *MockShippingUseCaseMockRecorder) AddShippingZone(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddShippingZone", reflect.TypeOf((*MockShippingUseCase)(nil).AddShippingZone), zone)
+}
+
+// CalculateShipping mocks base method.
+func (m *MockShippingUseCase) CalculateShipping(address models.Address, items int, subtotal float64, cod bool) (models.ShippingCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateShipping", address, items, subtotal, cod)
+	ret0, _ := ret[0].(models.ShippingCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateShipping indicates an expected call of CalculateShipping.
+func (mr *MockShippingUseCaseMockRecorder) CalculateShipping(address, items, subtotal, cod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateShipping", reflect.TypeOf((*MockShippingUseCase)(nil).CalculateShipping), address, items, subtotal, cod)
+}
+
+// CheckServiceability mocks base method.
+func (m *MockShippingUseCase) CheckServiceability(pin string) (models.Serviceability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckServiceability", pin)
+	ret0, _ := ret[0].(models.Serviceability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckServiceability indicates an expected call of CheckServiceability.
+func (mr *MockShippingUseCaseMockRecorder) CheckServiceability(pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckServiceability", reflect.TypeOf((*MockShippingUseCase)(nil).CheckServiceability), pin)
+}
+
+// DeleteServiceablePin mocks base method.
+func (m *MockShippingUseCase) DeleteServiceablePin(pin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceablePin", pin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceablePin indicates an expected call of DeleteServiceablePin.
+func (mr *MockShippingUseCaseMockRecorder) DeleteServiceablePin(pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceablePin", reflect.TypeOf((*MockShippingUseCase)(nil).DeleteServiceablePin), pin)
+}
+
+// DeleteShippingZone mocks base method.
+func (m *MockShippingUseCase) DeleteShippingZone(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShippingZone", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShippingZone indicates an expected call of DeleteShippingZone.
+func (mr *MockShippingUseCaseMockRecorder) DeleteShippingZone(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShippingZone", reflect.TypeOf((*MockShippingUseCase)(nil).DeleteShippingZone), id)
+}
+
+// GetServiceablePins mocks base method.
+func (m *MockShippingUseCase) GetServiceablePins(page int) ([]models.ServiceablePin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceablePins", page)
+	ret0, _ := ret[0].([]models.ServiceablePin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceablePins indicates an expected call of GetServiceablePins.
+func (mr *MockShippingUseCaseMockRecorder) GetServiceablePins(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceablePins", reflect.TypeOf((*MockShippingUseCase)(nil).GetServiceablePins), page)
+}
+
+// GetShippingZones mocks base method.
+func (m *MockShippingUseCase) GetShippingZones() ([]models.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShippingZones")
+	ret0, _ := ret[0].([]models.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShippingZones indicates an expected call of GetShippingZones.
+func (mr *MockShippingUseCaseMockRecorder) GetShippingZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShippingZones", reflect.TypeOf((*MockShippingUseCase)(nil).GetShippingZones))
+}
+
+// ImportServiceablePins mocks base method.
+func (m *MockShippingUseCase) ImportServiceablePins(file *multipart.FileHeader) (models.ServiceabilityImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportServiceablePins", file)
+	ret0, _ := ret[0].(models.ServiceabilityImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportServiceablePins indicates an expected call of ImportServiceablePins.
+func (mr *MockShippingUseCaseMockRecorder) ImportServiceablePins(file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportServiceablePins", reflect.TypeOf((*MockShippingUseCase)(nil).ImportServiceablePins), file)
+}
+
+// ShippingForOrder mocks base method.
+func (m *MockShippingUseCase) ShippingForOrder(addressID, paymentID, items int, subtotal float64) (models.ShippingCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShippingForOrder", addressID, paymentID, items, subtotal)
+	ret0, _ := ret[0].(models.ShippingCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShippingForOrder indicates an expected call of ShippingForOrder.
+func (mr *MockShippingUseCaseMockRecorder) ShippingForOrder(addressID, paymentID, items, subtotal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShippingForOrder", reflect.TypeOf((*MockShippingUseCase)(nil).ShippingForOrder), addressID, paymentID, items, subtotal)
+}
+
+// UpdateShippingZone mocks base method.
+func (m *MockShippingUseCase) UpdateShippingZone(zone models.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShippingZone", zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShippingZone indicates an expected call of UpdateShippingZone.
+func (mr *MockShippingUseCaseMockRecorder) UpdateShippingZone(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShippingZone", reflect.TypeOf((*MockShippingUseCase)(nil).UpdateShippingZone), zone)
+}
@@ -8,6 +8,7 @@ import (
 	domain "jerseyhub/pkg/domain"
 	models "jerseyhub/pkg/utils/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -63,6 +64,34 @@ func (mr *MockUserUseCaseMockRecorder) ChangePassword(id, old, password, repassw
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUseCase)(nil).ChangePassword), id, old, password, repassword)
 }
 
+// DeleteAddress mocks base method.
+func (m *MockUserUseCase) DeleteAddress(userID, addressID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", userID, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockUserUseCaseMockRecorder) DeleteAddress(userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockUserUseCase)(nil).DeleteAddress), userID, addressID)
+}
+
+// EditAddress mocks base method.
+func (m *MockUserUseCase) EditAddress(userID, addressID int, address models.AddAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAddress", userID, addressID, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditAddress indicates an expected call of EditAddress.
+func (mr *MockUserUseCaseMockRecorder) EditAddress(userID, addressID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAddress", reflect.TypeOf((*MockUserUseCase)(nil).EditAddress), userID, addressID, address)
+}
+
 // EditEmail mocks base method.
 func (m *MockUserUseCase) EditEmail(id int, email string) error {
 	m.ctrl.T.Helper()
@@ -105,6 +134,20 @@ func (mr *MockUserUseCaseMockRecorder) EditPhone(id, phone interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPhone", reflect.TypeOf((*MockUserUseCase)(nil).EditPhone), id, phone)
 }
 
+// ForgotPasswordEmailSend mocks base method.
+func (m *MockUserUseCase) ForgotPasswordEmailSend(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPasswordEmailSend", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPasswordEmailSend indicates an expected call of ForgotPasswordEmailSend.
+func (mr *MockUserUseCaseMockRecorder) ForgotPasswordEmailSend(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPasswordEmailSend", reflect.TypeOf((*MockUserUseCase)(nil).ForgotPasswordEmailSend), email)
+}
+
 // ForgotPasswordSend mocks base method.
 func (m *MockUserUseCase) ForgotPasswordSend(phone string) error {
 	m.ctrl.T.Helper()
@@ -178,6 +221,21 @@ func (mr *MockUserUseCaseMockRecorder) GetMyReferenceLink(id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyReferenceLink", reflect.TypeOf((*MockUserUseCase)(nil).GetMyReferenceLink), id)
 }
 
+// GetSessionsRevokedAt mocks base method.
+func (m *MockUserUseCase) GetSessionsRevokedAt(id int) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsRevokedAt", id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsRevokedAt indicates an expected call of GetSessionsRevokedAt.
+func (mr *MockUserUseCaseMockRecorder) GetSessionsRevokedAt(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsRevokedAt", reflect.TypeOf((*MockUserUseCase)(nil).GetSessionsRevokedAt), id)
+}
+
 // GetUserDetails mocks base method.
 func (m *MockUserUseCase) GetUserDetails(id int) (models.UserDetailsResponse, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +280,34 @@ func (mr *MockUserUseCaseMockRecorder) RemoveFromCart(cart, inventory interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromCart", reflect.TypeOf((*MockUserUseCase)(nil).RemoveFromCart), cart, inventory)
 }
 
+// ResetPasswordWithToken mocks base method.
+func (m *MockUserUseCase) ResetPasswordWithToken(model models.ResetPassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordWithToken", model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPasswordWithToken indicates an expected call of ResetPasswordWithToken.
+func (mr *MockUserUseCaseMockRecorder) ResetPasswordWithToken(model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordWithToken", reflect.TypeOf((*MockUserUseCase)(nil).ResetPasswordWithToken), model)
+}
+
+// SetDefaultAddress mocks base method.
+func (m *MockUserUseCase) SetDefaultAddress(userID, addressID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAddress", userID, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultAddress indicates an expected call of SetDefaultAddress.
+func (mr *MockUserUseCaseMockRecorder) SetDefaultAddress(userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAddress", reflect.TypeOf((*MockUserUseCase)(nil).SetDefaultAddress), userID, addressID)
+}
+
 // UpdateQuantityAdd mocks base method.
 func (m *MockUserUseCase) UpdateQuantityAdd(id, inv_id int) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,9 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type Provider interface {
+	Name() string
+	AuthCodeURL(state string, nonce string, codeVerifier string) (string, error)
+	Exchange(code string, codeVerifier string, nonce string) (models.OIDCClaims, error)
+}
@@ -0,0 +1,271 @@
+package oidc
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	cfg "jerseyhub/pkg/config"
+	interfaces "jerseyhub/pkg/oidc/interface"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const googleIssuer = "https://accounts.google.com"
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type provider struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	client       *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+}
+
+// NewGoogleProvider talks to accounts.google.com unless GOOGLE_ISSUER points somewhere else, which is how a local provider is used
+func NewGoogleProvider(config cfg.Config) interfaces.Provider {
+	issuer := config.GOOGLE_ISSUER
+	if issuer == "" {
+		issuer = googleIssuer
+	}
+
+	return NewProvider("google", issuer, config.GOOGLE_CLIENT_ID, config.GOOGLE_CLIENT_SECRET, config.GOOGLE_REDIRECT_URL)
+}
+
+func NewProvider(name, issuer, clientID, clientSecret, redirectURL string) interfaces.Provider {
+	return &provider{
+		name:         name,
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *provider) Name() string {
+	return p.name
+}
+
+func (p *provider) AuthCodeURL(state string, nonce string, codeVerifier string) (string, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.clientID)
+	params.Set("redirect_uri", p.redirectURL)
+	params.Set("scope", "openid email profile")
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	return d.AuthorizationEndpoint + "?" + params.Encode(), nil
+}
+
+func (p *provider) Exchange(code string, codeVerifier string, nonce string) (models.OIDCClaims, error) {
+	d, err := p.getDiscovery()
+	if err != nil {
+		return models.OIDCClaims{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("client_id", p.clientID)
+	form.Set("client_secret", p.clientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	res, err := p.client.PostForm(d.TokenEndpoint, form)
+	if err != nil {
+		return models.OIDCClaims{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return models.OIDCClaims{}, fmt.Errorf("token endpoint returned %d", res.StatusCode)
+	}
+
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return models.OIDCClaims{}, err
+	}
+
+	if token.IDToken == "" {
+		return models.OIDCClaims{}, errors.New("no id token in the token response")
+	}
+
+	return p.verify(d, token.IDToken, nonce)
+}
+
+// verify checks the signature of the id token against the provider keys and the claims meant for us
+func (p *provider) verify(d *discovery, idToken string, nonce string) (models.OIDCClaims, error) {
+	keys, err := p.getKeys(d)
+	if err != nil {
+		return models.OIDCClaims{}, err
+	}
+
+	token, err := jwt.Parse(idToken, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+
+		kid, _ := t.Header["kid"].(string)
+		key, ok := keys[kid]
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+
+		return key, nil
+	})
+	if err != nil {
+		return models.OIDCClaims{}, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return models.OIDCClaims{}, errors.New("invalid id token")
+	}
+
+	if !claims.VerifyIssuer(d.Issuer, true) {
+		return models.OIDCClaims{}, errors.New("id token issuer mismatch")
+	}
+
+	if !claims.VerifyAudience(p.clientID, true) {
+		return models.OIDCClaims{}, errors.New("id token audience mismatch")
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return models.OIDCClaims{}, errors.New("id token expired")
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return models.OIDCClaims{}, errors.New("id token nonce mismatch")
+	}
+
+	var result models.OIDCClaims
+	result.Subject, _ = claims["sub"].(string)
+	result.Email, _ = claims["email"].(string)
+	result.Name, _ = claims["name"].(string)
+
+	// some providers send email_verified as a string
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		result.EmailVerified = v
+	case string:
+		result.EmailVerified = v == "true"
+	}
+
+	if result.Subject == "" {
+		return models.OIDCClaims{}, errors.New("id token has no subject")
+	}
+
+	return result, nil
+}
+
+func (p *provider) getDiscovery() (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var d discovery
+	if err := p.getJSON(p.issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, err
+	}
+
+	if d.Issuer != p.issuer {
+		return nil, errors.New("issuer in the discovery document does not match")
+	}
+
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKeys is fetched on every login, keys get rotated and logins are not frequent enough to need a cache
+func (p *provider) getKeys(d *discovery) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := p.getJSON(d.JwksURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func (p *provider) getJSON(url string, v interface{}) error {
+	res, err := p.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", url, res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// CodeChallenge is the S256 PKCE challenge for the verifier
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
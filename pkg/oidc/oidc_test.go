@@ -0,0 +1,160 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProvider is a minimal OpenID provider that hands out an id token for one authorization code
+type fakeProvider struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	challenge string
+	nonce     string
+	audience  string
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeProvider{key: key, audience: "client-id"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(discovery{
+			Issuer:                f.server.URL,
+			AuthorizationEndpoint: f.server.URL + "/authorize",
+			TokenEndpoint:         f.server.URL + "/token",
+			JwksURI:               f.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string][]jwk{"keys": {{
+			Kid: "test",
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(f.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(f.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code" || CodeChallenge(r.FormValue("code_verifier")) != f.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            f.server.URL,
+			"aud":            f.audience,
+			"sub":            "10769150350006150715113082367",
+			"email":          "arthur@gmail.com",
+			"email_verified": true,
+			"name":           "Arthur",
+			"nonce":          f.nonce,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = "test"
+
+		idToken, err := token.SignedString(f.key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "id_token": idToken})
+	})
+
+	f.server = httptest.NewServer(mux)
+	return f
+}
+
+// authorize does what the browser would, it remembers the challenge and nonce sent in the login url
+func (f *fakeProvider) authorize(t *testing.T, loginURL string) {
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.challenge = u.Query().Get("code_challenge")
+	f.nonce = u.Query().Get("nonce")
+}
+
+func Test_ProviderLogin(t *testing.T) {
+
+	testData := map[string]struct {
+		verifier       string
+		nonce          string
+		audience       string
+		expectedOutput models.OIDCClaims
+		expectedError  error
+	}{
+		"success": {
+			verifier: "verifier",
+			nonce:    "nonce",
+			audience: "client-id",
+			expectedOutput: models.OIDCClaims{
+				Subject:       "10769150350006150715113082367",
+				Email:         "arthur@gmail.com",
+				EmailVerified: true,
+				Name:          "Arthur",
+			},
+			expectedError: nil,
+		},
+		"wrong code verifier": {
+			verifier:       "other-verifier",
+			nonce:          "nonce",
+			audience:       "client-id",
+			expectedOutput: models.OIDCClaims{},
+			expectedError:  errors.New("token endpoint returned 400"),
+		},
+		"wrong nonce": {
+			verifier:       "verifier",
+			nonce:          "other-nonce",
+			audience:       "client-id",
+			expectedOutput: models.OIDCClaims{},
+			expectedError:  errors.New("id token nonce mismatch"),
+		},
+		"token for another client": {
+			verifier:       "verifier",
+			nonce:          "nonce",
+			audience:       "other-client",
+			expectedOutput: models.OIDCClaims{},
+			expectedError:  errors.New("id token audience mismatch"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			fake := newFakeProvider(t)
+			defer fake.server.Close()
+			fake.audience = test.audience
+
+			p := NewProvider("google", fake.server.URL, "client-id", "secret", "http://localhost:3000/users/login/google/callback")
+
+			loginURL, err := p.AuthCodeURL("state", "nonce", "verifier")
+			assert.NoError(t, err)
+			fake.authorize(t, loginURL)
+
+			claims, err := p.Exchange("code", test.verifier, test.nonce)
+			assert.Equal(t, test.expectedOutput, claims)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -85,9 +85,9 @@ func (ad *adminRepository) GetUsers(page int) ([]models.UserDetailsAtAdmin, erro
 
 }
 
-func (i *adminRepository) NewPaymentMethod(pay string) error {
+func (i *adminRepository) NewPaymentMethod(method models.NewPaymentMethod) error {
 
-	if err := i.DB.Exec("insert into payment_methods(payment_name)values($1)", pay).Error; err != nil {
+	if err := i.DB.Exec("insert into payment_methods(payment_name,cash_on_delivery)values($1,$2)", method.PaymentMethod, method.CashOnDelivery).Error; err != nil {
 		return err
 	}
 
@@ -123,3 +123,112 @@ func (a *adminRepository) DeletePaymentMethod(id int) error {
 
 	return nil
 }
+
+func (a *adminRepository) GetAdminByID(id int) (domain.Admin, error) {
+	var admin domain.Admin
+	err := a.DB.Raw("SELECT * FROM admins WHERE id = ?", id).Scan(&admin).Error
+	if err != nil {
+		return domain.Admin{}, err
+	}
+
+	if admin.ID == 0 {
+		return domain.Admin{}, errors.New("admin does not exist")
+	}
+
+	return admin, nil
+}
+
+func (a *adminRepository) SetTotpSecret(id int, secret string) error {
+	err := a.DB.Exec("UPDATE admins SET totp_secret = $1, totp_enabled = false WHERE id = $2", secret, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *adminRepository) EnableTwoFactor(id int, step int64, codeHashes []string) error {
+
+	return a.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE admins SET totp_enabled = true, totp_last_step = $1 WHERE id = $2", step, id).Error; err != nil {
+			return err
+		}
+
+		return replaceRecoveryCodes(tx, id, codeHashes)
+	})
+}
+
+func (a *adminRepository) DisableTwoFactor(id int) error {
+
+	return a.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE admins SET totp_enabled = false, totp_secret = '', totp_last_step = 0 WHERE id = $1", id).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM admin_recovery_codes WHERE admin_id = $1", id).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// UpdateTotpLastStep only moves forward, so a code cannot be used twice even by two requests at the same time
+func (a *adminRepository) UpdateTotpLastStep(id int, step int64) (bool, error) {
+	result := a.DB.Exec("UPDATE admins SET totp_last_step = $1 WHERE id = $2 AND totp_last_step < $1", step, id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (a *adminRepository) UseRecoveryCode(id int, codeHash string) (bool, error) {
+	result := a.DB.Exec("UPDATE admin_recovery_codes SET used = true WHERE admin_id = $1 AND code_hash = $2 AND used = false", id, codeHash)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (a *adminRepository) ReplaceRecoveryCodes(id int, codeHashes []string) error {
+
+	return a.DB.Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, id, codeHashes)
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, id int, codeHashes []string) error {
+	if err := tx.Exec("DELETE FROM admin_recovery_codes WHERE admin_id = $1", id).Error; err != nil {
+		return err
+	}
+
+	for _, hash := range codeHashes {
+		if err := tx.Exec("INSERT INTO admin_recovery_codes (admin_id,code_hash) VALUES ($1,$2)", id, hash).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *adminRepository) GetAdminPolicy() (domain.AdminPolicy, error) {
+	var policy domain.AdminPolicy
+	err := a.DB.Raw("SELECT * FROM admin_policies WHERE id = 1").Scan(&policy).Error
+	if err != nil {
+		return domain.AdminPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (a *adminRepository) UpdateTwoFactorPolicy(required bool) error {
+	err := a.DB.Exec(`INSERT INTO admin_policies (id,two_factor_required) VALUES (1,$1)
+	ON CONFLICT (id) DO UPDATE SET two_factor_required = EXCLUDED.two_factor_required`, required).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type alertRepository struct {
+	DB *gorm.DB
+}
+
+func NewAlertRepository(db *gorm.DB) *alertRepository {
+	return &alertRepository{
+		DB: db,
+	}
+}
+
+// offerDiscount is the discount rate of the offer running on the category of a product, 0 without one
+const offerDiscount = `COALESCE((SELECT MAX(offers.discount_rate) FROM offers
+	WHERE offers.category_id = inventories.category_id AND offers.valid = true),0)`
+
+// offerPrice is the price of a product after the offer running on its category
+const offerPrice = `ROUND((inventories.price - inventories.price * ` + offerDiscount + ` / 100.0)::numeric,2)`
+
+// AddAlert subscribes the user, subscribing again turns a closed alert back on with the current price
+func (a *alertRepository) AddAlert(userID, inventoryID int, kind string, price float64) (int, error) {
+
+	var id int
+	err := a.DB.Raw(`INSERT INTO product_alerts (user_id,inventory_id,kind,price,active,created_at)
+	VALUES ($1,$2,$3,$4,true,$5)
+	ON CONFLICT (user_id,inventory_id,kind) DO UPDATE SET price = EXCLUDED.price, active = true
+	RETURNING id`, userID, inventoryID, kind, price, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (a *alertRepository) RemoveAlert(userID, id int) (bool, error) {
+
+	result := a.DB.Exec("DELETE FROM product_alerts WHERE id = $1 AND user_id = $2", id, userID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (a *alertRepository) RemoveProductAlerts(userID, inventoryID int) error {
+
+	if err := a.DB.Exec("DELETE FROM product_alerts WHERE user_id = $1 AND inventory_id = $2", userID, inventoryID).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *alertRepository) GetAlerts(userID int) ([]models.ProductAlertDetails, error) {
+
+	var alerts []models.ProductAlertDetails
+	err := a.DB.Raw(`SELECT product_alerts.id, product_alerts.inventory_id, inventories.product_name, product_alerts.kind,
+	product_alerts.price, product_alerts.active, product_alerts.last_notified_at, product_alerts.created_at
+	FROM product_alerts
+	JOIN inventories ON inventories.id = product_alerts.inventory_id
+	WHERE product_alerts.user_id = $1
+	ORDER BY product_alerts.created_at DESC`, userID).Scan(&alerts).Error
+	if err != nil {
+		return []models.ProductAlertDetails{}, err
+	}
+
+	return alerts, nil
+}
+
+func (a *alertRepository) GetAlertProduct(inventoryID int) (models.AlertProduct, error) {
+
+	var product models.AlertProduct
+	err := a.DB.Raw(`SELECT inventories.id, inventories.product_name, inventories.stock, `+offerPrice+` AS price
+	FROM inventories WHERE inventories.id = $1`, inventoryID).Scan(&product).Error
+	if err != nil {
+		return models.AlertProduct{}, err
+	}
+
+	return product, nil
+}
+
+// GetStockAlerts gives the open back in stock alerts of a product that has stock again
+func (a *alertRepository) GetStockAlerts(inventoryID int) ([]models.TriggeredAlert, error) {
+
+	var alerts []models.TriggeredAlert
+	err := a.DB.Raw(`SELECT product_alerts.id, product_alerts.user_id, product_alerts.inventory_id, inventories.product_name,
+	product_alerts.price AS old_price, `+offerPrice+` AS price, product_alerts.last_notified_at
+	FROM product_alerts
+	JOIN inventories ON inventories.id = product_alerts.inventory_id
+	WHERE product_alerts.inventory_id = $1 AND product_alerts.kind = 'BACK_IN_STOCK' AND product_alerts.active = true
+	AND inventories.stock > 0`, inventoryID).Scan(&alerts).Error
+	if err != nil {
+		return []models.TriggeredAlert{}, err
+	}
+
+	return alerts, nil
+}
+
+// GetPriceDropAlerts gives the price alerts whose product now costs less than when the user was last told,
+// either a single product or a whole category can be checked
+func (a *alertRepository) GetPriceDropAlerts(inventoryID, categoryID int) ([]models.TriggeredAlert, error) {
+
+	var alerts []models.TriggeredAlert
+	err := a.DB.Raw(`SELECT * FROM (SELECT product_alerts.id, product_alerts.user_id, product_alerts.inventory_id,
+	inventories.product_name, product_alerts.price AS old_price, `+offerPrice+` AS price, product_alerts.last_notified_at
+	FROM product_alerts
+	JOIN inventories ON inventories.id = product_alerts.inventory_id
+	WHERE product_alerts.kind = 'PRICE_DROP' AND product_alerts.active = true
+	AND ($1 = 0 OR inventories.id = $1) AND ($2 = 0 OR inventories.category_id = $2)) AS alerts
+	WHERE alerts.price < alerts.old_price`, inventoryID, categoryID).Scan(&alerts).Error
+	if err != nil {
+		return []models.TriggeredAlert{}, err
+	}
+
+	return alerts, nil
+}
+
+// CloseAlert is for one shot alerts like back in stock
+func (a *alertRepository) CloseAlert(id int) error {
+
+	if err := a.DB.Exec("UPDATE product_alerts SET active = false, last_notified_at = $1 WHERE id = $2", time.Now(), id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkAlertNotified moves the price of the alert so the next alert needs a further drop
+func (a *alertRepository) MarkAlertNotified(id int, price float64) error {
+
+	if err := a.DB.Exec("UPDATE product_alerts SET price = $1, last_notified_at = $2 WHERE id = $3", price, time.Now(), id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"jerseyhub/pkg/utils/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -20,7 +21,7 @@ func (ad *cartRepository) GetAddresses(id int) ([]models.Address, error) {
 
 	var addresses []models.Address
 
-	if err := ad.DB.Raw("SELECT * FROM addresses WHERE user_id=$1", id).Scan(&addresses).Error; err != nil {
+	if err := ad.DB.Raw("SELECT * FROM addresses WHERE user_id=$1 AND is_deleted=false ORDER BY id", id).Scan(&addresses).Error; err != nil {
 		return []models.Address{}, err
 	}
 
@@ -83,8 +84,8 @@ func (i *cartRepository) CreateNewCart(user_id int) (int, error) {
 func (i *cartRepository) AddLineItems(cart_id, inventory_id int) error {
 
 	err := i.DB.Exec(`
-		INSERT INTO line_items (cart_id,inventory_id)
-		VALUES ($1,$2)`, cart_id, inventory_id).Error
+		INSERT INTO line_items (cart_id,inventory_id,added_price,added_discount)
+		SELECT $1, $2, inventories.price, `+offerDiscount+` FROM inventories WHERE inventories.id = $2`, cart_id, inventory_id).Error
 	if err != nil {
 		return err
 	}
@@ -103,3 +104,246 @@ func (ad *cartRepository) CheckIfItemIsAlreadyAdded(cart_id, inventory_id int) (
 	return count > 0, nil
 
 }
+
+// carts with their items, value and when they were last touched
+const cartActivity = `WITH activity AS (
+	SELECT line_items.cart_id, COUNT(*) AS items, SUM(line_items.quantity * ` + offerPrice + `) AS value,
+	MAX(line_items.updated_at) AS last_activity
+	FROM line_items JOIN inventories ON inventories.id = line_items.inventory_id
+	GROUP BY line_items.cart_id)`
+
+// GetAbandonedCarts gives the carts nobody touched since the time, 20 at a time longest idle first. A page of 0 gives all of them
+func (ad *cartRepository) GetAbandonedCarts(idleSince time.Time, page int) ([]models.AbandonedCart, error) {
+
+	query := cartActivity + `
+	SELECT carts.id AS cart_id, carts.user_id, users.name, users.email, activity.items, activity.value, activity.last_activity,
+	(SELECT COUNT(*) FROM cart_reminders WHERE cart_reminders.cart_id = carts.id AND cart_reminders.sent_at > activity.last_activity) AS reminders
+	FROM activity
+	JOIN carts ON carts.id = activity.cart_id
+	JOIN users ON users.id = carts.user_id
+	WHERE activity.last_activity <= $1
+	ORDER BY activity.last_activity, carts.id`
+
+	args := []interface{}{idleSince}
+	if page > 0 {
+		query += " LIMIT 20 OFFSET $2"
+		args = append(args, (page-1)*20)
+	}
+
+	var carts []models.AbandonedCart
+	if err := ad.DB.Raw(query, args...).Scan(&carts).Error; err != nil {
+		return []models.AbandonedCart{}, err
+	}
+
+	return carts, nil
+}
+
+func (ad *cartRepository) AddCartReminder(reminder models.CartReminder) error {
+
+	err := ad.DB.Exec(`INSERT INTO cart_reminders (cart_id,user_id,step,cart_value,sent,coupon_id,coupon,sent_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`, reminder.CartID, reminder.UserID, reminder.Step, reminder.CartValue, reminder.Sent,
+		reminder.CouponID, reminder.Coupon, time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttributeOrder puts the order down to the latest reminder the user got within the window, a reminder whose
+// coupon the order used comes first. An order is only ever put down to one reminder
+func (ad *cartRepository) AttributeOrder(orderID int, window time.Duration) error {
+
+	now := time.Now()
+	err := ad.DB.Exec(`UPDATE cart_reminders SET order_id = $1, converted_at = $2
+	WHERE id = (SELECT cart_reminders.id FROM cart_reminders JOIN orders ON orders.user_id = cart_reminders.user_id
+		WHERE orders.id = $1 AND cart_reminders.sent = true AND cart_reminders.order_id IS NULL AND cart_reminders.sent_at >= $3
+		ORDER BY (cart_reminders.coupon <> '' AND cart_reminders.coupon = orders.coupon_used) DESC, cart_reminders.sent_at DESC
+		LIMIT 1)
+	AND NOT EXISTS (SELECT 1 FROM cart_reminders WHERE order_id = $1)`, orderID, now, now.Add(-window)).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCartRecoverySummary sums up the carts abandoned now and how the reminders did since the time
+func (ad *cartRepository) GetCartRecoverySummary(idleSince, since time.Time) (models.CartRecoverySummary, error) {
+
+	var summary models.CartRecoverySummary
+	err := ad.DB.Raw(cartActivity+`
+	SELECT (SELECT COUNT(*) FROM activity WHERE last_activity <= $1) AS abandoned_carts,
+	(SELECT COALESCE(SUM(value),0) FROM activity WHERE last_activity <= $1) AS abandoned_value,
+	(SELECT COUNT(*) FROM cart_reminders WHERE sent = true AND sent_at >= $2) AS reminders_sent,
+	(SELECT COUNT(*) FROM cart_reminders WHERE order_id IS NOT NULL AND converted_at >= $2) AS recovered,
+	(SELECT COALESCE(SUM(orders.final_price),0) FROM cart_reminders JOIN orders ON orders.id = cart_reminders.order_id
+		WHERE cart_reminders.converted_at >= $2) AS recovered_value`, idleSince, since).Scan(&summary).Error
+	if err != nil {
+		return models.CartRecoverySummary{}, err
+	}
+
+	return summary, nil
+}
+
+// SetCartQuantity sets the quantity of a line, the price and offer are taken afresh as the user has just seen them.
+// It reports false when the product is not in the cart
+func (ad *cartRepository) SetCartQuantity(cartID, inventoryID, quantity int) (bool, error) {
+
+	result := ad.DB.Exec(`UPDATE line_items SET quantity = $1, updated_at = $2, added_price = inventories.price, added_discount = `+offerDiscount+`
+	FROM inventories WHERE inventories.id = line_items.inventory_id AND line_items.cart_id = $3 AND line_items.inventory_id = $4`,
+		quantity, time.Now(), cartID, inventoryID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (ad *cartRepository) GetCartQuantity(cartID, inventoryID int) (int, error) {
+
+	var quantity int
+	if err := ad.DB.Raw("SELECT COALESCE(SUM(quantity),0) FROM line_items WHERE cart_id = $1 AND inventory_id = $2", cartID, inventoryID).Scan(&quantity).Error; err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+// GetCartItemStatus gives the lines of the cart with the stock, price and offer now against when they were added
+func (ad *cartRepository) GetCartItemStatus(cartID int) ([]models.CartItemStatus, error) {
+
+	var items []models.CartItemStatus
+	err := ad.DB.Raw(`SELECT line_items.inventory_id, line_items.quantity, inventories.stock, inventories.price, line_items.added_price,
+	`+offerDiscount+` AS discount, line_items.added_discount
+	FROM line_items JOIN inventories ON inventories.id = line_items.inventory_id
+	WHERE line_items.cart_id = $1`, cartID).Scan(&items).Error
+	if err != nil {
+		return []models.CartItemStatus{}, err
+	}
+
+	return items, nil
+}
+
+func (ad *cartRepository) CreateGuestCart(inventoryID, quantity int) (int, error) {
+
+	var id int
+	now := time.Now()
+	err := ad.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("INSERT INTO guest_carts (created_at,updated_at) VALUES ($1,$1) RETURNING id", now).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("INSERT INTO guest_cart_items (guest_cart_id,inventory_id,quantity,created_at) VALUES ($1,$2,$3,$4)", id, inventoryID, quantity, now).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// TouchGuestCart keeps the cart from expiring, it reports false for a cart that is already gone
+func (ad *cartRepository) TouchGuestCart(id int) (bool, error) {
+
+	result := ad.DB.Exec("UPDATE guest_carts SET updated_at = $1 WHERE id = $2", time.Now(), id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (ad *cartRepository) GetGuestCartQuantity(guestCartID, inventoryID int) (int, error) {
+
+	var quantity int
+	if err := ad.DB.Raw("SELECT COALESCE(SUM(quantity),0) FROM guest_cart_items WHERE guest_cart_id = $1 AND inventory_id = $2", guestCartID, inventoryID).Scan(&quantity).Error; err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+func (ad *cartRepository) SetGuestCartItem(guestCartID, inventoryID, quantity int) error {
+
+	err := ad.DB.Exec(`INSERT INTO guest_cart_items (guest_cart_id,inventory_id,quantity,created_at) VALUES ($1,$2,$3,$4)
+	ON CONFLICT (guest_cart_id,inventory_id) DO UPDATE SET quantity = EXCLUDED.quantity`, guestCartID, inventoryID, quantity, time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ad *cartRepository) RemoveGuestCartItem(guestCartID, inventoryID int) error {
+
+	if err := ad.DB.Exec("DELETE FROM guest_cart_items WHERE guest_cart_id = $1 AND inventory_id = $2", guestCartID, inventoryID).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ad *cartRepository) GetGuestCart(guestCartID int) ([]models.GetCart, error) {
+
+	var cart []models.GetCart
+	err := ad.DB.Raw(`SELECT inventories.id, inventories.product_name, inventories.image, inventories.category_id, guest_cart_items.quantity,
+	inventories.stock AS stock_available, guest_cart_items.quantity * inventories.price AS total,
+	guest_cart_items.quantity * `+offerPrice+` AS discounted_price
+	FROM guest_cart_items JOIN inventories ON inventories.id = guest_cart_items.inventory_id
+	WHERE guest_cart_items.guest_cart_id = $1 ORDER BY guest_cart_items.id`, guestCartID).Scan(&cart).Error
+	if err != nil {
+		return []models.GetCart{}, err
+	}
+
+	return cart, nil
+}
+
+// MergeGuestCart moves the guest cart into the user cart and deletes it. Quantities add up but never past the
+// stock or the most allowed, a line the user already has over them is left as it is. Merging a cart that is gone is a no-op
+func (ad *cartRepository) MergeGuestCart(guestCartID, cartID, maxQuantity int) error {
+
+	return ad.DB.Transaction(func(tx *gorm.DB) error {
+
+		// two logins with the same token wait here, the second finds the cart gone
+		var id int
+		if err := tx.Raw("SELECT id FROM guest_carts WHERE id = $1 FOR UPDATE", guestCartID).Scan(&id).Error; err != nil {
+			return err
+		}
+		if id == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		err := tx.Exec(`UPDATE line_items SET quantity = GREATEST(line_items.quantity, LEAST(line_items.quantity + guest_cart_items.quantity, inventories.stock, $4)), updated_at = $3
+		FROM guest_cart_items JOIN inventories ON inventories.id = guest_cart_items.inventory_id
+		WHERE guest_cart_items.guest_cart_id = $1 AND line_items.cart_id = $2 AND line_items.inventory_id = guest_cart_items.inventory_id`,
+			guestCartID, cartID, now, maxQuantity).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`INSERT INTO line_items (cart_id,inventory_id,quantity,created_at,updated_at,added_price,added_discount)
+		SELECT $2, guest_cart_items.inventory_id, LEAST(guest_cart_items.quantity, inventories.stock, $4), $3, $3, inventories.price, `+offerDiscount+`
+		FROM guest_cart_items JOIN inventories ON inventories.id = guest_cart_items.inventory_id
+		WHERE guest_cart_items.guest_cart_id = $1 AND inventories.stock > 0
+		AND NOT EXISTS (SELECT 1 FROM line_items WHERE line_items.cart_id = $2 AND line_items.inventory_id = guest_cart_items.inventory_id)
+		ORDER BY guest_cart_items.id`, guestCartID, cartID, now, maxQuantity).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM guest_carts WHERE id = $1", guestCartID).Error
+	})
+}
+
+// DeleteStaleGuestCarts clears out the guest carts not touched since the time, their items go with them
+func (ad *cartRepository) DeleteStaleGuestCarts(before time.Time) (int, error) {
+
+	result := ad.DB.Exec("DELETE FROM guest_carts WHERE updated_at < $1", before)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
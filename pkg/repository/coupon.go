@@ -3,6 +3,7 @@ package repository
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -18,7 +19,7 @@ func NewCouponRepository(db *gorm.DB) *couponRepository {
 }
 
 func (repo *couponRepository) AddCoupon(coup models.Coupons) error {
-	if err := repo.DB.Exec("INSERT INTO coupons(coupon,discount_rate,valid) values($1,$2,$3)", coup.Coupon, coup.DiscountRate, coup.Valid).Error; err != nil {
+	if err := repo.DB.Exec("INSERT INTO coupons(coupon,discount_rate,valid,expires_at) values($1,$2,$3,$4)", coup.Coupon, coup.DiscountRate, coup.Valid, coup.ExpiresAt).Error; err != nil {
 		return err
 	}
 
@@ -48,15 +49,38 @@ func (repo *couponRepository) FindCouponDetails(couponID int) (domain.Coupons, e
 		return domain.Coupons{}, err
 	}
 
-	return domain.Coupons{}, nil
+	return coupon, nil
 }
 
+// GetAllCoupons lists the coupons anyone can use, the ones made for a single user are left out
 func (c *couponRepository) GetAllCoupons() ([]domain.Coupons, error) {
 	var model []domain.Coupons
-	err := c.DB.Raw("SELECT * FROM coupons").Scan(&model).Error
+	err := c.DB.Raw("SELECT * FROM coupons WHERE user_id IS NULL").Scan(&model).Error
 	if err != nil {
 		return []domain.Coupons{}, err
 	}
 
 	return model, nil
 }
+
+func (c *couponRepository) ExpireCoupons(now time.Time) error {
+
+	if err := c.DB.Exec("UPDATE coupons SET valid = false WHERE valid = true AND expires_at <= $1", now).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddUserCoupon adds a coupon only the user can use
+func (c *couponRepository) AddUserCoupon(code string, discountRate, userID int, expiresAt time.Time) (int, error) {
+
+	var id int
+	err := c.DB.Raw(`INSERT INTO coupons (created_at,updated_at,coupon,discount_rate,valid,expires_at,user_id)
+	VALUES ($1,$1,$2,$3,true,$4,$5) RETURNING id`, time.Now(), code, discountRate, expiresAt, userID).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
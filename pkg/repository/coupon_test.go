@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+
+	"jerseyhub/pkg/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func Test_GetAllCoupons(t *testing.T) {
+
+	mockDB, mockSQL, _ := sqlmock.New()
+	defer mockDB.Close()
+
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDB,
+	}), &gorm.Config{})
+
+	// coupons made for one user, like the cart recovery ones, are not listed
+	mockSQL.ExpectQuery(`^SELECT \* FROM coupons WHERE user_id IS NULL$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "coupon", "discount_rate", "valid"}).AddRow(1, "JERSEY10", 10, true))
+
+	c := NewCouponRepository(gormDB)
+
+	got, err := c.GetAllCoupons()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Coupons{{Model: gorm.Model{ID: 1}, Coupon: "JERSEY10", DiscountRate: 10, Valid: true}}, got)
+	assert.Nil(t, mockSQL.ExpectationsWereMet())
+}
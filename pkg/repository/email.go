@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"errors"
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type emailRepository struct {
+	DB *gorm.DB
+}
+
+func NewEmailRepository(db *gorm.DB) *emailRepository {
+	return &emailRepository{
+		DB: db,
+	}
+}
+
+func (e *emailRepository) GetEmailRecipient(userID int) (models.EmailRecipient, error) {
+
+	var recipient models.EmailRecipient
+	if err := e.DB.Raw("SELECT id,name,email FROM users WHERE id = ?", userID).Scan(&recipient).Error; err != nil {
+		return models.EmailRecipient{}, err
+	}
+
+	if recipient.ID == 0 {
+		return models.EmailRecipient{}, errors.New("user does not exist")
+	}
+
+	return recipient, nil
+}
+
+func (e *emailRepository) GetOrderEmailDetails(orderID int) (models.OrderEmailDetails, error) {
+
+	var details models.OrderEmailDetails
+	err := e.DB.Raw(`SELECT orders.id AS order_id,
+	users.name,
+	users.email,
+	orders.final_price AS amount
+	FROM orders
+	JOIN users ON users.id = orders.user_id
+	WHERE orders.id = $1`, orderID).Scan(&details).Error
+	if err != nil {
+		return models.OrderEmailDetails{}, err
+	}
+
+	if details.OrderID == 0 {
+		return models.OrderEmailDetails{}, errors.New("order does not exist")
+	}
+
+	return details, nil
+}
+
+func (e *emailRepository) CheckIfEmailVerified(userID int) (bool, error) {
+
+	var verified bool
+	if err := e.DB.Raw("SELECT email_verified FROM users WHERE id = ?", userID).Scan(&verified).Error; err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
+
+func (e *emailRepository) CreateEmailVerification(userID int, email string, tokenHash string, expiresAt time.Time) error {
+
+	err := e.DB.Exec(`INSERT INTO email_verifications (user_id,email,token_hash,expires_at)
+	VALUES ($1,$2,$3,$4)`, userID, email, tokenHash, expiresAt).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *emailRepository) FindEmailVerification(tokenHash string) (domain.EmailVerification, error) {
+
+	var verification domain.EmailVerification
+	if err := e.DB.Raw("SELECT * FROM email_verifications WHERE token_hash = ?", tokenHash).Scan(&verification).Error; err != nil {
+		return domain.EmailVerification{}, err
+	}
+
+	return verification, nil
+}
+
+// MarkEmailAsVerified spends the link and verifies the address it was sent to, a link used twice at once
+// or sent to an address the user has since changed verifies nothing
+func (e *emailRepository) MarkEmailAsVerified(verificationID int, userID int, email string) error {
+
+	return e.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE email_verifications SET used = true WHERE id = $1 AND used = false", verificationID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != 1 {
+			return errors.New("verification link already used")
+		}
+
+		result = tx.Exec("UPDATE users SET email_verified = true WHERE id = $1 AND email = $2", userID, email)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != 1 {
+			return errors.New("verification link was sent to an email the account no longer uses")
+		}
+
+		return nil
+	})
+}
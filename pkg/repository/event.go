@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"encoding/json"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// addEvent writes a domain event to the outbox, pass it the transaction of the change so both
+// are saved or neither is
+func addEvent(tx *gorm.DB, name string, payload interface{}) error {
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return tx.Exec("INSERT INTO outbox_events (name,payload,status,attempts,next_attempt_at,created_at) VALUES ($1,$2,'PENDING',0,$3,$3)",
+		name, string(data), now).Error
+}
+
+type eventRepository struct {
+	DB *gorm.DB
+}
+
+func NewEventRepository(db *gorm.DB) *eventRepository {
+	return &eventRepository{
+		DB: db,
+	}
+}
+
+// ClaimDueEvents takes the pending events whose time has come and pushes their next attempt out by the
+// lease, so a second dispatcher does not pick them up while these are being delivered
+func (e *eventRepository) ClaimDueEvents(limit int, lease time.Duration) ([]models.Event, error) {
+
+	var events []models.Event
+	now := time.Now()
+	err := e.DB.Raw(`UPDATE outbox_events SET next_attempt_at = $1
+	WHERE id IN (SELECT id FROM outbox_events WHERE status = 'PENDING' AND next_attempt_at <= $2
+		ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED)
+	RETURNING id, name, payload, attempts, created_at`, now.Add(lease), now, limit).Scan(&events).Error
+	if err != nil {
+		return []models.Event{}, err
+	}
+
+	return events, nil
+}
+
+func (e *eventRepository) GetDeliveredSubscribers(eventID int) ([]string, error) {
+
+	var subscribers []string
+	if err := e.DB.Raw("SELECT subscriber FROM event_deliveries WHERE outbox_event_id = $1", eventID).Scan(&subscribers).Error; err != nil {
+		return []string{}, err
+	}
+
+	return subscribers, nil
+}
+
+func (e *eventRepository) AddDelivery(eventID int, subscriber string) error {
+
+	err := e.DB.Exec(`INSERT INTO event_deliveries (outbox_event_id,subscriber,delivered_at) VALUES ($1,$2,$3)
+	ON CONFLICT (outbox_event_id,subscriber) DO NOTHING`, eventID, subscriber, time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventRepository) MarkEventDone(id int) error {
+
+	if err := e.DB.Exec("UPDATE outbox_events SET status = 'DONE', processed_at = $1 WHERE id = $2", time.Now(), id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventRepository) RetryEvent(id int, next time.Time, lastError string) error {
+
+	err := e.DB.Exec("UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = $1, last_error = $2 WHERE id = $3",
+		next, lastError, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FailEvent gives up on an event, it stays in the outbox for someone to look at
+func (e *eventRepository) FailEvent(id int, lastError string) error {
+
+	err := e.DB.Exec("UPDATE outbox_events SET status = 'FAILED', attempts = attempts + 1, last_error = $1, processed_at = $2 WHERE id = $3",
+		lastError, time.Now(), id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func Test_UpdateInventory_addEvent(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		stub    func(sqlmock.Sqlmock)
+		want    models.InventoryResponse
+		wantErr error
+	}{
+		{
+			name: "stock change and its event are saved together",
+			stub: func(mockSQL sqlmock.Sqlmock) {
+
+				mockSQL.ExpectBegin()
+				mockSQL.ExpectQuery(`^UPDATE inventories SET stock = stock \+ \$1 WHERE id= \$2 RETURNING stock$`).WithArgs(5, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"stock"}).AddRow(12))
+				mockSQL.ExpectExec(`^INSERT INTO outbox_events (.+)$`).WithArgs(models.EventStockChanged, `{"inventory_id":3,"change":5,"stock":12}`, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mockSQL.ExpectCommit()
+
+			},
+
+			want:    models.InventoryResponse{ProductID: 3, Stock: 12},
+			wantErr: nil,
+		},
+		{
+			name: "stock change is rolled back when the event is not saved",
+			stub: func(mockSQL sqlmock.Sqlmock) {
+
+				mockSQL.ExpectBegin()
+				mockSQL.ExpectQuery(`^UPDATE inventories SET stock = stock \+ \$1 WHERE id= \$2 RETURNING stock$`).WithArgs(5, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"stock"}).AddRow(12))
+				mockSQL.ExpectExec(`^INSERT INTO outbox_events (.+)$`).
+					WillReturnError(errors.New("text string"))
+				mockSQL.ExpectRollback()
+
+			},
+
+			want:    models.InventoryResponse{},
+			wantErr: errors.New("text string"),
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New()
+			defer mockDB.Close()
+
+			gormDB, _ := gorm.Open(postgres.New(postgres.Config{
+				Conn: mockDB,
+			}), &gorm.Config{})
+
+			tt.stub(mockSQL)
+
+			i := NewInventoryRepository(gormDB)
+
+			got, err := i.UpdateInventory(3, 5)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			assert.Nil(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type identityRepository struct {
+	DB *gorm.DB
+}
+
+func NewIdentityRepository(db *gorm.DB) *identityRepository {
+	return &identityRepository{
+		DB: db,
+	}
+}
+
+func (i *identityRepository) CreateLoginState(state domain.OidcLoginState) error {
+
+	// logins that were never completed are cleared here instead of a separate job
+	if err := i.DB.Exec("DELETE FROM oidc_login_states WHERE expires_at < $1", time.Now()).Error; err != nil {
+		return err
+	}
+
+	if err := i.DB.Exec(`INSERT INTO oidc_login_states (state,provider,code_verifier,nonce,expires_at)
+	VALUES ($1,$2,$3,$4,$5)`, state.State, state.Provider, state.CodeVerifier, state.Nonce, state.ExpiresAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeLoginState deletes the state while reading it so a callback cannot be replayed
+func (i *identityRepository) ConsumeLoginState(state string) (domain.OidcLoginState, error) {
+
+	var loginState domain.OidcLoginState
+	if err := i.DB.Raw("DELETE FROM oidc_login_states WHERE state = ? RETURNING *", state).Scan(&loginState).Error; err != nil {
+		return domain.OidcLoginState{}, err
+	}
+
+	return loginState, nil
+}
+
+func (i *identityRepository) FindUserIDByIdentity(provider string, subject string) (int, error) {
+
+	var userID int
+	if err := i.DB.Raw("SELECT user_id FROM user_identities WHERE provider = $1 AND subject = $2", provider, subject).Scan(&userID).Error; err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+func (i *identityRepository) CheckEmailVerified(userID int) (bool, error) {
+
+	var verified bool
+	if err := i.DB.Raw("SELECT email_verified FROM users WHERE id = $1", userID).Scan(&verified).Error; err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
+
+func (i *identityRepository) CreateSocialUser(name string, email string, referral string) (models.UserDetailsResponse, error) {
+
+	// the password is left empty, such users sign in through the provider or set one with the reset link
+	var userDetails models.UserDetailsResponse
+	err := i.DB.Raw(`INSERT INTO users (name, email, password, phone, referral_code, email_verified)
+	VALUES ($1, $2, '', '', $3, true) RETURNING id, name, email, phone`, name, email, referral).Scan(&userDetails).Error
+	if err != nil {
+		return models.UserDetailsResponse{}, err
+	}
+
+	return userDetails, nil
+}
+
+func (i *identityRepository) LinkIdentity(userID int, provider string, subject string, email string) error {
+
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO user_identities (user_id,provider,subject,email,created_at)
+		VALUES ($1,$2,$3,$4,$5)`, userID, provider, subject, email, time.Now()).Error; err != nil {
+			return err
+		}
+
+		// the provider has verified the address, so there is no need for our verification mail
+		if err := tx.Exec("UPDATE users SET email_verified = true WHERE id = $1 AND email = $2", userID, email).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
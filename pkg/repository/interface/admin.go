@@ -10,8 +10,18 @@ type AdminRepository interface {
 	GetUserByID(id string) (domain.Users, error)
 	UpdateBlockUserByID(user domain.Users) error
 	GetUsers(page int) ([]models.UserDetailsAtAdmin, error)
-	NewPaymentMethod(string) error
+	NewPaymentMethod(method models.NewPaymentMethod) error
 	ListPaymentMethods() ([]domain.PaymentMethod, error)
 	CheckIfPaymentMethodAlreadyExists(payment string) (bool, error)
 	DeletePaymentMethod(id int) error
+
+	GetAdminByID(id int) (domain.Admin, error)
+	SetTotpSecret(id int, secret string) error
+	EnableTwoFactor(id int, step int64, codeHashes []string) error
+	DisableTwoFactor(id int) error
+	UpdateTotpLastStep(id int, step int64) (bool, error)
+	UseRecoveryCode(id int, codeHash string) (bool, error)
+	ReplaceRecoveryCodes(id int, codeHashes []string) error
+	GetAdminPolicy() (domain.AdminPolicy, error)
+	UpdateTwoFactorPolicy(required bool) error
 }
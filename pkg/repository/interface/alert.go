@@ -0,0 +1,15 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type AlertRepository interface {
+	AddAlert(userID, inventoryID int, kind string, price float64) (int, error)
+	RemoveAlert(userID, id int) (bool, error)
+	RemoveProductAlerts(userID, inventoryID int) error
+	GetAlerts(userID int) ([]models.ProductAlertDetails, error)
+	GetAlertProduct(inventoryID int) (models.AlertProduct, error)
+	GetStockAlerts(inventoryID int) ([]models.TriggeredAlert, error)
+	GetPriceDropAlerts(inventoryID, categoryID int) ([]models.TriggeredAlert, error)
+	CloseAlert(id int) error
+	MarkAlertNotified(id int, price float64) error
+}
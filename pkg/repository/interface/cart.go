@@ -1,6 +1,9 @@
 package interfaces
 
-import "jerseyhub/pkg/utils/models"
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
 
 type CartRepository interface {
 	GetCart(id int) ([]models.GetCart, error)
@@ -10,4 +13,20 @@ type CartRepository interface {
 	CreateNewCart(user_id int) (int, error)
 	AddLineItems(cart_id, inventory_id int) error
 	CheckIfItemIsAlreadyAdded(cart_id, inventory_id int) (bool, error)
+	SetCartQuantity(cartID, inventoryID, quantity int) (bool, error)
+	GetCartQuantity(cartID, inventoryID int) (int, error)
+	GetCartItemStatus(cartID int) ([]models.CartItemStatus, error)
+	GetAbandonedCarts(idleSince time.Time, page int) ([]models.AbandonedCart, error)
+	AddCartReminder(reminder models.CartReminder) error
+	AttributeOrder(orderID int, window time.Duration) error
+	GetCartRecoverySummary(idleSince, since time.Time) (models.CartRecoverySummary, error)
+
+	CreateGuestCart(inventoryID, quantity int) (int, error)
+	TouchGuestCart(id int) (bool, error)
+	GetGuestCartQuantity(guestCartID, inventoryID int) (int, error)
+	SetGuestCartItem(guestCartID, inventoryID, quantity int) error
+	RemoveGuestCartItem(guestCartID, inventoryID int) error
+	GetGuestCart(guestCartID int) ([]models.GetCart, error)
+	MergeGuestCart(guestCartID, cartID, maxQuantity int) error
+	DeleteStaleGuestCarts(before time.Time) (int, error)
 }
@@ -3,6 +3,7 @@ package interfaces
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 )
 
 type CouponRepository interface {
@@ -11,4 +12,6 @@ type CouponRepository interface {
 	ReActivateCoupon(id int) error
 	FindCouponDetails(couponID int) (domain.Coupons, error)
 	GetAllCoupons() ([]domain.Coupons, error)
+	ExpireCoupons(now time.Time) error
+	AddUserCoupon(code string, discountRate, userID int, expiresAt time.Time) (int, error)
 }
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type EmailRepository interface {
+	GetEmailRecipient(userID int) (models.EmailRecipient, error)
+	GetOrderEmailDetails(orderID int) (models.OrderEmailDetails, error)
+
+	CheckIfEmailVerified(userID int) (bool, error)
+	CreateEmailVerification(userID int, email string, tokenHash string, expiresAt time.Time) error
+	FindEmailVerification(tokenHash string) (domain.EmailVerification, error)
+	MarkEmailAsVerified(verificationID int, userID int, email string) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type EventRepository interface {
+	ClaimDueEvents(limit int, lease time.Duration) ([]models.Event, error)
+	GetDeliveredSubscribers(eventID int) ([]string, error)
+	AddDelivery(eventID int, subscriber string) error
+	MarkEventDone(id int) error
+	RetryEvent(id int, next time.Time, lastError string) error
+	FailEvent(id int, lastError string) error
+}
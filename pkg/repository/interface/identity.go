@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+)
+
+type IdentityRepository interface {
+	CreateLoginState(state domain.OidcLoginState) error
+	ConsumeLoginState(state string) (domain.OidcLoginState, error)
+
+	FindUserIDByIdentity(provider string, subject string) (int, error)
+	CheckEmailVerified(userID int) (bool, error)
+	CreateSocialUser(name string, email string, referral string) (models.UserDetailsResponse, error)
+	LinkIdentity(userID int, provider string, subject string, email string) error
+}
@@ -10,11 +10,12 @@ type InventoryRepository interface {
 	UpdateInventory(pid int, stock int) (models.InventoryResponse, error)
 	DeleteInventory(id string) error
 	ShowIndividualProducts(id string) (models.Inventories, error)
-	ListProducts(page int) ([]models.Inventories, error)
+	ListProducts(page int, sort string) ([]models.Inventories, error)
 	ListProductsByCategory(id int) ([]models.Inventories, error)
 	CheckStock(inventory_id int) (int, error)
 	CheckPrice(inventory_id int) (float64, error)
 	SearchProducts(key string) ([]models.Inventories, error)
 	UpdateProductImage(int, string) error
 	EditInventoryDetails(id int, model models.EditInventoryDetails) error
+	RebuildSearchIndex() error
 }
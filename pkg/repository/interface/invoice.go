@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+)
+
+type InvoiceRepository interface {
+	CheckCategoryExists(categoryID int) (bool, error)
+	SetCategoryTax(tax models.CategoryTax) error
+	GetCategoryTaxes() ([]models.CategoryTax, error)
+	GetCategoryTax(categoryID int) (models.CategoryTax, error)
+	GetAddressState(addressID int) (string, error)
+
+	GetInvoiceCustomer(orderID int) (models.InvoiceCustomer, error)
+	GetInvoiceByOrderID(orderID int) (domain.Invoice, error)
+	CreateInvoice(orderID int, financialYear string) (domain.Invoice, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type JobRepository interface {
+	AddJob(name, payload string, runAt time.Time, maxAttempts int, uniqueKey *string) (int, error)
+	ClaimDueJobs(limit int, lease time.Duration) ([]models.Job, error)
+	MarkJobDone(id int) error
+	RetryJob(id int, next time.Time, lastError string) error
+	KillJob(id int, lastError string) error
+	ReviveJob(id int) (bool, error)
+	GetJobs(status string, page int) ([]models.JobDetails, error)
+	CountJobs() ([]models.JobStatusCount, error)
+}
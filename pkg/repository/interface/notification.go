@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type NotificationRepository interface {
+	AddNotification(userID int, notification models.Notification) error
+	CheckNotificationSent(userID int, dedupeKey string, since time.Time) (bool, error)
+	CountNotifications(userID int, category string, since time.Time) (int, error)
+	GetNotifications(userID, page int, unreadOnly bool) ([]domain.Notification, error)
+	CountUnread(userID int) (int, error)
+	MarkRead(userID, id int) (bool, error)
+	MarkAllRead(userID int) error
+	GetPreferences(userID int) ([]models.NotificationPreference, error)
+	SetPreference(userID int, preference models.NotificationPreference) error
+	GetOrderUser(orderID int) (int, error)
+}
@@ -3,6 +3,7 @@ package interfaces
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 )
 
 type OfferRepository interface {
@@ -10,4 +11,5 @@ type OfferRepository interface {
 	MakeOfferExpire(id int) error
 	FindDiscountPercentage(int) (int, error)
 	GetOffers() ([]domain.Offer, error)
+	ExpireOffers(now time.Time) error
 }
@@ -3,14 +3,13 @@ package interfaces
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 )
 
 type OrderRepository interface {
 	GetOrders(id int) ([]domain.Order, error)
 	GetCart(userid int) ([]models.GetCart, error)
-	OrderItems(userid, addressid, paymentid int, total float64, coupon string) (int, error)
-	AddOrderProducts(order_id int, cart []models.GetCart) error
-	CancelOrder(id int) error
+	OrderItems(userid, addressid, paymentid int, total float64, coupon string, shipping models.ShippingCharge, cart []models.GetCart, taxes map[int]models.ItemTax) (int, error)
 	EditOrderStatus(status string, id int) error
 	AdminOrders(status string) ([]domain.OrderDetails, error)
 
@@ -18,7 +17,6 @@ type OrderRepository interface {
 	GetOrderDetail(orderID string) (domain.Order, error)
 
 	CheckOrderStatusByID(id int) (string, error)
-	ReturnOrder(id int) error
 	FindAmountFromOrderID(id int) (float64, error)
 	CreditToUserWallet(amount float64, walletID int) error
 	FindUserIdFromOrderID(id int) (int, error)
@@ -31,4 +29,8 @@ type OrderRepository interface {
 	GetProductDetailsInOrder(id int) ([]models.ProductDetails, error)
 
 	FindPaymentMethodOfOrder(id int) (string, error)
+
+	GetOrderItemStates(orderID int) ([]models.OrderItemState, error)
+	UpdateOrderItems(orderID, userID int, changes []models.OrderItemChange, returned bool, extraRefund float64) error
+	GetUnpaidOrders(placedBefore time.Time) ([]int, error)
 }
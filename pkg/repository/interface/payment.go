@@ -1,7 +1,10 @@
 package interfaces
 
+import "jerseyhub/pkg/utils/models"
+
 type PaymentRepository interface {
 	FindUsername(user_id int) (string, error)
 	FindPrice(order_id int) (float64, error)
 	UpdatePaymentDetails(orderID, paymentID, razorID string) error
+	GetOrderPayment(orderID int) (models.OrderPayment, error)
 }
@@ -0,0 +1,17 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type QuestionRepository interface {
+	AddQuestion(userID int, question models.AddQuestion) (int, error)
+	GetQuestion(id int) (models.QuestionDetails, error)
+	GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error)
+	GetUnansweredQuestions(page int) ([]models.QuestionDetails, error)
+	DeleteQuestion(id int) error
+	GetAnswers(questionID int) ([]models.AnswerDetails, error)
+	GetAnswer(id int) (models.AnswerDetails, error)
+	AddAnswer(questionID, userID int, answer string) (int, error)
+	DeleteAnswer(id int) error
+	AddAnswerVote(id, userID int) error
+	RemoveAnswerVote(id, userID int) error
+}
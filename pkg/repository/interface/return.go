@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type ReturnRepository interface {
+	GetDeliveryDate(orderID int) (time.Time, error)
+	GetOpenReturnQuantities(orderID int) ([]models.ReturnItem, error)
+	CreateReturnRequest(orderID, userID int, request models.ReturnRequest, items []models.OrderItemRequest) (int, error)
+	GetReturnRequest(id int) (models.ReturnRequestDetails, error)
+	GetReturnRequests(userID int, status string, page int) ([]models.ReturnRequestDetails, error)
+	GetReturnItems(id int) ([]models.ReturnItem, error)
+	GetReturnImages(id int) ([]string, error)
+	CountReturnImages(id int) (int, error)
+	AddReturnImage(id int, url string) error
+	UpdateReturnStatus(id int, from, to, note string) (bool, error)
+	SchedulePickup(id int, date time.Time) (bool, error)
+	SetReturnRefund(id int, amount float64) error
+
+	GetOrderItemProduct(orderItemID int) (models.ExchangeProduct, error)
+	GetExchangeProduct(inventoryID int) (models.ExchangeProduct, error)
+	CreateExchangeRequest(orderID, userID int, request models.ExchangeRequest, difference float64) (int, error)
+	ReleaseExchangeStock(id int) error
+	CompleteExchange(id int, changes []models.OrderItemChange, paid float64) (int, error)
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type ReviewRepository interface {
+	CheckDeliveredPurchase(userID, inventoryID int) (bool, error)
+	CheckReviewExists(userID, inventoryID int) (bool, error)
+	AddReview(userID int, review models.AddReview) (int, error)
+	UpdateReview(id int, review models.EditReview) error
+	DeleteReview(id int) error
+	GetReview(id int) (models.ReviewDetails, error)
+	GetProductReviews(inventoryID, page int, sort string) ([]models.ReviewDetails, error)
+	GetProductRating(inventoryID int) (models.ProductReviews, error)
+	GetReviews(userID int, status string, page int) ([]models.ReviewDetails, error)
+	GetReviewImages(id int) ([]string, error)
+	CountReviewImages(id int) (int, error)
+	AddReviewImage(id int, url string) error
+	AddReviewVote(id, userID int) error
+	RemoveReviewVote(id, userID int) error
+	SetReviewStatus(id int, status string) error
+	ReplyToReview(id int, reply string) error
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type ShipmentRepository interface {
+	GetOrderItemQuantities(orderID int) ([]models.OrderItemQuantity, error)
+	GetShippingAddress(orderID int) (models.CourierShipmentRequest, error)
+	CreateShipment(orderID int, booking models.CourierBooking, items []models.ShipmentItemRequest) (int, error)
+
+	GetShipment(id int) (domain.Shipment, error)
+	GetShipmentsByOrder(orderID int) ([]models.ShipmentDetails, error)
+	GetShipmentItems(shipmentID int) ([]models.ShipmentItemDetails, error)
+	GetTrackingEvents(shipmentID int) ([]models.TrackingEvent, error)
+
+	AddTrackingEvent(shipmentID int, event models.TrackingEvent) error
+	CheckTrackingEventExists(shipmentID int, status string, occurredAt time.Time) (bool, error)
+	CountUndeliveredShipments(orderID int) (int, error)
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+)
+
+type ShippingRepository interface {
+	AddShippingZone(zone models.ShippingZone) error
+	UpdateShippingZone(zone models.ShippingZone) error
+	DeleteShippingZone(id int) error
+	GetShippingZones() ([]models.ShippingZone, error)
+	GetZoneAreas(zoneID int) ([]domain.ShippingZoneArea, error)
+	CheckShippingZoneExists(id int) (bool, error)
+
+	FindShippingZone(state string, pin string) (domain.ShippingZone, error)
+	GetAddress(addressID int) (models.Address, error)
+	CheckIfCashOnDelivery(paymentID int) (bool, error)
+
+	ImportServiceablePins(pins []models.ServiceablePin) error
+	GetServiceablePins(page int) ([]models.ServiceablePin, error)
+	DeleteServiceablePin(pin string) (bool, error)
+	FindServiceablePin(pin string) (domain.ServiceablePin, error)
+	CountServiceablePins() (int, error)
+}
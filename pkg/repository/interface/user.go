@@ -3,6 +3,7 @@ package interfaces
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 )
 
 type UserRepository interface {
@@ -12,6 +13,11 @@ type UserRepository interface {
 	UserBlockStatus(email string) (bool, error)
 	AddAddress(id int, address models.AddAddress, result bool) error
 	GetAddresses(id int) ([]domain.Address, error)
+	GetAddress(userID, addressID int) (domain.Address, error)
+	CheckIfAddressInOrders(addressID int) (bool, error)
+	UpdateAddress(userID, addressID int, address models.AddAddress, isDefault bool) error
+	DeleteAddress(userID, addressID int, soft bool) error
+	SetDefaultAddress(userID, addressID int) error
 	GetUserDetails(id int) (models.UserDetailsResponse, error)
 	ChangePassword(id int, password string) error
 	GetPassword(id int) (string, error)
@@ -41,4 +47,9 @@ type UserRepository interface {
 
 	FindProductImage(id int) (string, error)
 	FindStock(id int) (int, error)
+
+	CreatePasswordReset(userID int, tokenHash string, expiresAt time.Time) error
+	FindPasswordReset(tokenHash string) (domain.PasswordReset, error)
+	ResetPassword(resetID int, userID int, password string) error
+	GetSessionsRevokedAt(id int) (time.Time, error)
 }
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+type WebhookRepository interface {
+	AddWebhook(url, secret, events string) (int, error)
+	GetWebhooks() ([]models.WebhookDetails, error)
+	GetWebhook(id int) (domain.WebhookEndpoint, error)
+	DeleteWebhook(id int) (bool, error)
+	AddEventDeliveries(eventID int, event string, payload string) error
+	AddDelivery(webhookID int, event string, payload string, replayOf *int, next time.Time) (int, error)
+	GetDelivery(id int) (models.WebhookDeliveryDetails, error)
+	GetDeliveries(webhookID, page int) ([]models.WebhookDeliveryDetails, error)
+	ClaimDueDeliveries(limit int, lease time.Duration) ([]models.WebhookDelivery, error)
+	MarkDelivered(id int, result models.WebhookResult) error
+	RetryDelivery(id int, result models.WebhookResult, lastError string, next time.Time) error
+	FailDelivery(id int, result models.WebhookResult, lastError string) error
+}
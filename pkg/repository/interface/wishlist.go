@@ -5,7 +5,9 @@ import "jerseyhub/pkg/utils/models"
 type WishlistRepository interface {
 	AddToWishlist(user_id, inventory_id int) error
 	RemoveFromWishlist(inventory_id, UserID int) error
-	GetWishList(id int) ([]models.Inventories, error)
+	GetWishList(id int) ([]models.WishlistItem, error)
 	CheckIfTheItemIsPresentAtWishlist(userID, productID int) (bool, error)
 	CheckIfTheItemIsPresentAtCart(userID, productID int) (bool, error)
+	MoveToWishlist(userID, inventoryID int) error
+	MoveToCart(userID, inventoryID int) error
 }
@@ -56,15 +56,17 @@ func (i *inventoryRepository) UpdateInventory(pid int, stock int) (models.Invent
 		return models.InventoryResponse{}, errors.New("database connection is nil")
 	}
 
-	// Update the
-	if err := i.DB.Exec("UPDATE inventories SET stock = stock + $1 WHERE id= $2", stock, pid).Error; err != nil {
-		return models.InventoryResponse{}, err
-	}
-
-	// Retrieve the update
+	// Update the stock and retrieve the update
 	var newdetails models.InventoryResponse
 	var newstock int
-	if err := i.DB.Raw("SELECT stock FROM inventories WHERE id=?", pid).Scan(&newstock).Error; err != nil {
+	err := i.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("UPDATE inventories SET stock = stock + $1 WHERE id= $2 RETURNING stock", stock, pid).Scan(&newstock).Error; err != nil {
+			return err
+		}
+
+		return addEvent(tx, models.EventStockChanged, models.StockChangedEvent{InventoryID: pid, Change: stock, Stock: newstock})
+	})
+	if err != nil {
 		return models.InventoryResponse{}, err
 	}
 	newdetails.ProductID = pid
@@ -97,10 +99,10 @@ func (i *inventoryRepository) ShowIndividualProducts(id string) (models.Inventor
 	var product models.Inventories
 	err := i.DB.Raw(`
 	SELECT
-		*
+		inventories.*, `+ratingColumns+`
 		FROM
 			inventories
-		
+		`+ratingJoin+`
 		WHERE
 			inventories.id = ?
 			`, pid).Scan(&product).Error
@@ -112,7 +114,13 @@ func (i *inventoryRepository) ShowIndividualProducts(id string) (models.Inventor
 
 }
 
-func (ad *inventoryRepository) ListProducts(page int) ([]models.Inventories, error) {
+// ratings of a product come from its approved reviews, products without any get 0
+const ratingColumns = `COALESCE(ratings.average_rating,0) AS average_rating, COALESCE(ratings.rating_count,0) AS rating_count`
+
+const ratingJoin = `LEFT JOIN (SELECT inventory_id, ROUND(AVG(rating),1) AS average_rating, COUNT(*) AS rating_count
+	FROM reviews WHERE status = 'APPROVED' GROUP BY inventory_id) ratings ON ratings.inventory_id = inventories.id`
+
+func (ad *inventoryRepository) ListProducts(page int, sort string) ([]models.Inventories, error) {
 	// pagination purpose -
 	if page == 0 {
 		page = 1
@@ -120,7 +128,14 @@ func (ad *inventoryRepository) ListProducts(page int) ([]models.Inventories, err
 	offset := (page - 1) * 10
 	var productDetails []models.Inventories
 
-	if err := ad.DB.Raw("select id,category_id,product_name,image,size,stock,price from inventories limit $1 offset $2", 10, offset).Scan(&productDetails).Error; err != nil {
+	order := "inventories.id"
+	if sort == "rating" {
+		order = "average_rating DESC, rating_count DESC, inventories.id"
+	}
+
+	query := "select inventories.id,inventories.category_id,inventories.product_name,inventories.image,inventories.size,inventories.stock,inventories.price," + ratingColumns +
+		" from inventories " + ratingJoin + " order by " + order + " limit $1 offset $2"
+	if err := ad.DB.Raw(query, 10, offset).Scan(&productDetails).Error; err != nil {
 		return []models.Inventories{}, err
 	}
 
@@ -132,7 +147,9 @@ func (ad *inventoryRepository) ListProductsByCategory(id int) ([]models.Inventor
 
 	var productDetails []models.Inventories
 
-	if err := ad.DB.Raw("select id,category_id,product_name,image,size,stock,price from inventories WHERE category_id = $1", id).Scan(&productDetails).Error; err != nil {
+	query := "select inventories.id,inventories.category_id,inventories.product_name,inventories.image,inventories.size,inventories.stock,inventories.price," + ratingColumns +
+		" from inventories " + ratingJoin + " WHERE inventories.category_id = $1"
+	if err := ad.DB.Raw(query, id).Scan(&productDetails).Error; err != nil {
 		return []models.Inventories{}, err
 	}
 
@@ -162,10 +179,11 @@ func (ad *inventoryRepository) SearchProducts(key string) ([]models.Inventories,
 	var productDetails []models.Inventories
 
 	query := `
-	SELECT i.*
-	FROM inventories i
-	LEFT JOIN categories c ON i.category_id = c.id
-	WHERE i.product_name ILIKE '%' || ? || '%'
+	SELECT inventories.*, ` + ratingColumns + `
+	FROM inventories
+	LEFT JOIN categories c ON inventories.category_id = c.id
+	` + ratingJoin + `
+	WHERE inventories.product_name ILIKE '%' || ? || '%'
 	OR
 	c.category ILIKE '%' || ? || '%'
 `
@@ -195,3 +213,22 @@ func (i *inventoryRepository) EditInventoryDetails(id int, model models.EditInve
 
 	return nil
 }
+
+// RebuildSearchIndex rebuilds the product indexes and refreshes the planner statistics product search relies on,
+// it is run at night since the rebuild holds off writes to the products
+func (i *inventoryRepository) RebuildSearchIndex() error {
+
+	if err := i.DB.Exec("REINDEX TABLE inventories").Error; err != nil {
+		return err
+	}
+
+	if err := i.DB.Exec("ANALYZE inventories").Error; err != nil {
+		return err
+	}
+
+	if err := i.DB.Exec("ANALYZE categories").Error; err != nil {
+		return err
+	}
+
+	return nil
+}
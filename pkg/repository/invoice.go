@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"fmt"
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+
+	"gorm.io/gorm"
+)
+
+type invoiceRepository struct {
+	DB *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) *invoiceRepository {
+	return &invoiceRepository{
+		DB: db,
+	}
+}
+
+func (i *invoiceRepository) CheckCategoryExists(categoryID int) (bool, error) {
+
+	var count int
+	if err := i.DB.Raw("SELECT COUNT(*) FROM categories WHERE id = ?", categoryID).Scan(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (i *invoiceRepository) SetCategoryTax(tax models.CategoryTax) error {
+
+	return i.DB.Exec("UPDATE categories SET hsn_code = $1, gst_rate = $2 WHERE id = $3", tax.HsnCode, tax.GstRate, tax.CategoryID).Error
+}
+
+func (i *invoiceRepository) GetCategoryTaxes() ([]models.CategoryTax, error) {
+
+	var taxes []models.CategoryTax
+	if err := i.DB.Raw("SELECT id AS category_id, category, hsn_code, gst_rate FROM categories ORDER BY id").Scan(&taxes).Error; err != nil {
+		return []models.CategoryTax{}, err
+	}
+
+	return taxes, nil
+}
+
+func (i *invoiceRepository) GetCategoryTax(categoryID int) (models.CategoryTax, error) {
+
+	var tax models.CategoryTax
+	if err := i.DB.Raw("SELECT id AS category_id, category, hsn_code, gst_rate FROM categories WHERE id = ?", categoryID).Scan(&tax).Error; err != nil {
+		return models.CategoryTax{}, err
+	}
+
+	return tax, nil
+}
+
+func (i *invoiceRepository) GetAddressState(addressID int) (string, error) {
+
+	var state string
+	if err := i.DB.Raw("SELECT state FROM addresses WHERE id = ?", addressID).Scan(&state).Error; err != nil {
+		return "", err
+	}
+
+	return state, nil
+}
+
+func (i *invoiceRepository) GetInvoiceCustomer(orderID int) (models.InvoiceCustomer, error) {
+
+	var customer models.InvoiceCustomer
+	err := i.DB.Raw(`SELECT users.id AS user_id, orders.ship_name AS name, users.email, orders.ship_state AS state, COALESCE(orders.created_at, NOW()) AS ordered_at
+	FROM orders
+	JOIN users ON users.id = orders.user_id
+	WHERE orders.id = $1`, orderID).Scan(&customer).Error
+	if err != nil {
+		return models.InvoiceCustomer{}, err
+	}
+
+	return customer, nil
+}
+
+func (i *invoiceRepository) GetInvoiceByOrderID(orderID int) (domain.Invoice, error) {
+
+	var invoice domain.Invoice
+	if err := i.DB.Raw("SELECT * FROM invoices WHERE order_id = ?", orderID).Scan(&invoice).Error; err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return invoice, nil
+}
+
+// CreateInvoice takes the next number of the financial year, the row lock on invoice_sequences
+// keeps two invoices from getting the same number and a rollback gives the number back
+func (i *invoiceRepository) CreateInvoice(orderID int, financialYear string) (domain.Invoice, error) {
+
+	var invoice domain.Invoice
+	err := i.DB.Transaction(func(tx *gorm.DB) error {
+		var number int
+		if err := tx.Raw(`INSERT INTO invoice_sequences (financial_year,last_number) VALUES ($1,1)
+		ON CONFLICT (financial_year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+		RETURNING last_number`, financialYear).Scan(&number).Error; err != nil {
+			return err
+		}
+
+		invoiceNumber := fmt.Sprintf("INV/%s/%06d", financialYear, number)
+		return tx.Raw(`INSERT INTO invoices (order_id,invoice_number,financial_year,created_at)
+		VALUES ($1,$2,$3,NOW()) RETURNING *`, orderID, invoiceNumber, financialYear).Scan(&invoice).Error
+	})
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return invoice, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type jobRepository struct {
+	DB *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *jobRepository {
+	return &jobRepository{
+		DB: db,
+	}
+}
+
+// AddJob queues a job, a job with a unique key that is already queued is skipped and 0 comes back
+func (j *jobRepository) AddJob(name, payload string, runAt time.Time, maxAttempts int, uniqueKey *string) (int, error) {
+
+	var id int
+	err := j.DB.Raw(`INSERT INTO jobs (name,payload,status,attempts,max_attempts,run_at,unique_key,created_at)
+	VALUES ($1,$2,'QUEUED',0,$3,$4,$5,$6) ON CONFLICT (unique_key) DO NOTHING RETURNING id`,
+		name, payload, maxAttempts, runAt, uniqueKey, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ClaimDueJobs counts the attempt when it claims, so a job that keeps taking its worker down still ends up DEAD.
+// A running job whose lease ran out belonged to a worker that went away and is picked up again
+func (j *jobRepository) ClaimDueJobs(limit int, lease time.Duration) ([]models.Job, error) {
+
+	var jobs []models.Job
+	now := time.Now()
+	err := j.DB.Raw(`UPDATE jobs SET status = 'RUNNING', attempts = attempts + 1, locked_until = $1
+	WHERE id IN (SELECT id FROM jobs WHERE (status = 'QUEUED' AND run_at <= $2) OR (status = 'RUNNING' AND locked_until <= $2)
+		ORDER BY run_at, id LIMIT $3 FOR UPDATE SKIP LOCKED)
+	RETURNING id, name, payload, attempts, max_attempts`, now.Add(lease), now, limit).Scan(&jobs).Error
+	if err != nil {
+		return []models.Job{}, err
+	}
+
+	return jobs, nil
+}
+
+func (j *jobRepository) MarkJobDone(id int) error {
+
+	err := j.DB.Exec("UPDATE jobs SET status = 'DONE', last_error = '', locked_until = NULL, finished_at = $1 WHERE id = $2", time.Now(), id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (j *jobRepository) RetryJob(id int, next time.Time, lastError string) error {
+
+	err := j.DB.Exec("UPDATE jobs SET status = 'QUEUED', last_error = $1, run_at = $2, locked_until = NULL WHERE id = $3", lastError, next, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (j *jobRepository) KillJob(id int, lastError string) error {
+
+	err := j.DB.Exec("UPDATE jobs SET status = 'DEAD', last_error = $1, locked_until = NULL, finished_at = $2 WHERE id = $3", lastError, time.Now(), id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReviveJob queues a dead job again with its attempts reset, it reports false for a job that is not dead
+func (j *jobRepository) ReviveJob(id int) (bool, error) {
+
+	result := j.DB.Exec("UPDATE jobs SET status = 'QUEUED', attempts = 0, run_at = $1, finished_at = NULL WHERE id = $2 AND status = 'DEAD'", time.Now(), id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetJobs gives the jobs 20 at a time latest first, an empty status gives all of them
+func (j *jobRepository) GetJobs(status string, page int) ([]models.JobDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var jobs []models.JobDetails
+	err := j.DB.Raw(`SELECT id, name, payload, status, attempts, max_attempts, last_error, run_at, created_at, finished_at
+	FROM jobs WHERE $1 = '' OR status = $1 ORDER BY id DESC LIMIT 20 OFFSET $2`, status, offset).Scan(&jobs).Error
+	if err != nil {
+		return []models.JobDetails{}, err
+	}
+
+	return jobs, nil
+}
+
+func (j *jobRepository) CountJobs() ([]models.JobStatusCount, error) {
+
+	var counts []models.JobStatusCount
+	if err := j.DB.Raw("SELECT name, status, COUNT(*) AS count FROM jobs GROUP BY name, status ORDER BY name, status").Scan(&counts).Error; err != nil {
+		return []models.JobStatusCount{}, err
+	}
+
+	return counts, nil
+}
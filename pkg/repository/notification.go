@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"errors"
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type notificationRepository struct {
+	DB *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *notificationRepository {
+	return &notificationRepository{
+		DB: db,
+	}
+}
+
+func (n *notificationRepository) AddNotification(userID int, notification models.Notification) error {
+
+	err := n.DB.Exec(`INSERT INTO notifications (user_id,category,title,message,link,dedupe_key,created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7)`, userID, notification.Category, notification.Title, notification.Message,
+		notification.Link, notification.DedupeKey, time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (n *notificationRepository) CheckNotificationSent(userID int, dedupeKey string, since time.Time) (bool, error) {
+
+	var count int
+	err := n.DB.Raw("SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND dedupe_key = $2 AND created_at > $3",
+		userID, dedupeKey, since).Scan(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (n *notificationRepository) CountNotifications(userID int, category string, since time.Time) (int, error) {
+
+	var count int
+	err := n.DB.Raw("SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND category = $2 AND created_at > $3",
+		userID, category, since).Scan(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetNotifications gives the inbox of the user 20 at a time, latest first
+func (n *notificationRepository) GetNotifications(userID, page int, unreadOnly bool) ([]domain.Notification, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var notifications []domain.Notification
+	err := n.DB.Raw(`SELECT * FROM notifications WHERE user_id = $1 AND ($2 = false OR read = false)
+	ORDER BY created_at DESC, id DESC LIMIT 20 OFFSET $3`, userID, unreadOnly, offset).Scan(&notifications).Error
+	if err != nil {
+		return []domain.Notification{}, err
+	}
+
+	return notifications, nil
+}
+
+func (n *notificationRepository) CountUnread(userID int) (int, error) {
+
+	var count int
+	if err := n.DB.Raw("SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read = false", userID).Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (n *notificationRepository) MarkRead(userID, id int) (bool, error) {
+
+	result := n.DB.Exec("UPDATE notifications SET read = true WHERE id = $1 AND user_id = $2", id, userID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (n *notificationRepository) MarkAllRead(userID int) error {
+
+	if err := n.DB.Exec("UPDATE notifications SET read = true WHERE user_id = $1 AND read = false", userID).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPreferences gives only the categories the user has changed
+func (n *notificationRepository) GetPreferences(userID int) ([]models.NotificationPreference, error) {
+
+	var preferences []models.NotificationPreference
+	err := n.DB.Raw("SELECT category, enabled, email FROM notification_preferences WHERE user_id = $1", userID).Scan(&preferences).Error
+	if err != nil {
+		return []models.NotificationPreference{}, err
+	}
+
+	return preferences, nil
+}
+
+func (n *notificationRepository) SetPreference(userID int, preference models.NotificationPreference) error {
+
+	err := n.DB.Exec(`INSERT INTO notification_preferences (user_id,category,enabled,email) VALUES ($1,$2,$3,$4)
+	ON CONFLICT (user_id,category) DO UPDATE SET enabled = EXCLUDED.enabled, email = EXCLUDED.email`,
+		userID, preference.Category, preference.Enabled, preference.Email).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (n *notificationRepository) GetOrderUser(orderID int) (int, error) {
+
+	var userID int
+	if err := n.DB.Raw("SELECT user_id FROM orders WHERE id = $1", orderID).Scan(&userID).Error; err != nil {
+		return 0, err
+	}
+
+	if userID == 0 {
+		return 0, errors.New("order does not exist")
+	}
+
+	return userID, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -18,7 +19,7 @@ func NewOfferRepository(db *gorm.DB) *offerRepository {
 }
 
 func (repo *offerRepository) AddNewOffer(model models.OfferMaking) error {
-	if err := repo.DB.Exec("INSERT INTO offers(category_id,discount_rate) values($1,$2)", model.CategoryID, model.Discount).Error; err != nil {
+	if err := repo.DB.Exec("INSERT INTO offers(category_id,discount_rate,expires_at) values($1,$2,$3)", model.CategoryID, model.Discount, model.ExpiresAt).Error; err != nil {
 		return err
 	}
 
@@ -52,3 +53,13 @@ func (c *offerRepository) GetOffers() ([]domain.Offer, error) {
 
 	return model, nil
 }
+
+// ExpireOffers removes the offers that ran out, the same as an admin expiring them
+func (o *offerRepository) ExpireOffers(now time.Time) error {
+
+	if err := o.DB.Exec("DELETE FROM offers WHERE expires_at <= $1", now).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -41,34 +42,62 @@ func (ad *orderRepository) GetCart(id int) ([]models.GetCart, error) {
 
 }
 
-func (i *orderRepository) OrderItems(userid, addressid, paymentid int, total float64, coupon string) (int, error) {
+// OrderItems places the order along with its products. Every order takes its units off the stock when it
+// is placed, cash on delivery included, and gives them back if it is canceled before it goes out
+func (i *orderRepository) OrderItems(userid, addressid, paymentid int, total float64, coupon string, shipping models.ShippingCharge, cart []models.GetCart, taxes map[int]models.ItemTax) (int, error) {
 
 	var id int
+	// the address is copied onto the order so later edits to it do not change the order
 	query := `
-    INSERT INTO orders (user_id,address_id, payment_method_id, final_price,coupon_used)
-    VALUES (?, ?, ?, ?, ?)
+    INSERT INTO orders (created_at,updated_at,user_id,address_id, payment_method_id, final_price,coupon_used,shipping_charge,cod_charge,
+	ship_name,ship_house_name,ship_street,ship_city,ship_state,ship_pin,ship_phone)
+    SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, name, house_name, street, city, state, pin, phone
+	FROM addresses WHERE id = ?
     RETURNING id
     `
-	i.DB.Raw(query, userid, addressid, paymentid, total, coupon).Scan(&id)
+	err := i.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Raw(query, now, now, userid, addressid, paymentid, total, coupon, shipping.Shipping, shipping.CodSurcharge, addressid).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		if err := addOrderProducts(tx, id, cart, taxes); err != nil {
+			return err
+		}
+
+		return addEvent(tx, models.EventOrderPlaced, models.OrderPlacedEvent{OrderID: id, UserID: userid, FinalPrice: total})
+	})
+	if err != nil {
+		return 0, err
+	}
 
 	return id, nil
 
 }
 
-func (i *orderRepository) AddOrderProducts(order_id int, cart []models.GetCart) error {
+func addOrderProducts(tx *gorm.DB, order_id int, cart []models.GetCart, taxes map[int]models.ItemTax) error {
 
 	query := `
-    INSERT INTO order_items (order_id,inventory_id,quantity,total_price)
-    VALUES (?, ?, ?, ?)
+    INSERT INTO order_items (order_id,inventory_id,product_name,image,price,quantity,total_price,hsn_code,gst_rate,taxable_value,cgst,sgst,igst,reserved_quantity)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
     `
 
+	// sizes of a product share its name so the cart line has to say which one it is
 	for _, v := range cart {
-		var inv int
-		if err := i.DB.Raw("select id from inventories where product_name=$1", v.ProductName).Scan(&inv).Error; err != nil {
+		var stock []int
+		if err := tx.Raw("UPDATE inventories SET stock = stock - $1 WHERE id = $2 AND stock >= $1 RETURNING stock", v.Quantity, v.ID).Scan(&stock).Error; err != nil {
+			return err
+		}
+		if len(stock) == 0 {
+			return errors.New(v.ProductName + " does not have enough stock left")
+		}
+		if err := addEvent(tx, models.EventStockChanged, models.StockChangedEvent{InventoryID: v.ID, Change: -v.Quantity, Stock: stock[0]}); err != nil {
 			return err
 		}
 
-		if err := i.DB.Exec(query, order_id, inv, v.Quantity, v.Total).Error; err != nil {
+		// price is what one unit sold for, the cart line carries the total of all its units
+		tax := taxes[v.ID]
+		if err := tx.Exec(query, order_id, v.ID, v.ProductName, v.Image, v.Total/float64(v.Quantity), v.Quantity, v.Total, tax.HsnCode, tax.GstRate, tax.TaxableValue, tax.Cgst, tax.Sgst, tax.Igst, v.Quantity).Error; err != nil {
 			return err
 		}
 	}
@@ -77,30 +106,76 @@ func (i *orderRepository) AddOrderProducts(order_id int, cart []models.GetCart)
 
 }
 
-func (i *orderRepository) CancelOrder(id int) error {
+// releaseReservedStock puts back what an order item still holds, up to the units canceled or returned
+func releaseReservedStock(tx *gorm.DB, orderItemID, quantity int) error {
 
-	if err := i.DB.Exec("update orders set order_status='CANCELED' where id=$1", id).Error; err != nil {
+	var item struct {
+		InventoryID      int
+		ReservedQuantity int
+	}
+	if err := tx.Raw("SELECT inventory_id, reserved_quantity FROM order_items WHERE id = $1", orderItemID).Scan(&item).Error; err != nil {
 		return err
 	}
 
-	return nil
+	release := quantity
+	if item.ReservedQuantity < release {
+		release = item.ReservedQuantity
+	}
+	if release <= 0 {
+		return nil
+	}
 
+	if err := tx.Exec("UPDATE order_items SET reserved_quantity = reserved_quantity - $1 WHERE id = $2", release, orderItemID).Error; err != nil {
+		return err
+	}
+
+	var stock int
+	if err := tx.Raw("UPDATE inventories SET stock = stock + $1 WHERE id = $2 RETURNING stock", release, item.InventoryID).Scan(&stock).Error; err != nil {
+		return err
+	}
+
+	return addEvent(tx, models.EventStockChanged, models.StockChangedEvent{InventoryID: item.InventoryID, Change: release, Stock: stock})
 }
 
 func (i *orderRepository) EditOrderStatus(status string, id int) error {
 
-	if err := i.DB.Exec("update orders set order_status=$1 where id=$2", status, id).Error; err != nil {
-		return err
-	}
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		var userID int
+		if err := tx.Raw("update orders set order_status=$1, delivered_at = CASE WHEN $1 = 'DELIVERED' THEN $3 ELSE delivered_at END where id=$2 returning user_id", status, id, time.Now()).Scan(&userID).Error; err != nil {
+			return err
+		}
 
-	return nil
+		// canceled and returned items keep their status whatever happens to the rest of the order
+		if err := tx.Exec("update order_items set item_status=$1 where order_id=$2 and item_status not in ('CANCELED','RETURNED')", status, id).Error; err != nil {
+			return err
+		}
+
+		// an order canceled by the admin gives back the stock its items still hold
+		if status == "CANCELED" {
+			var items []struct {
+				ID               int
+				ReservedQuantity int
+			}
+			if err := tx.Raw("select id, reserved_quantity from order_items where order_id=$1 and reserved_quantity > 0", id).Scan(&items).Error; err != nil {
+				return err
+			}
+
+			for _, item := range items {
+				if err := releaseReservedStock(tx, item.ID, item.ReservedQuantity); err != nil {
+					return err
+				}
+			}
+		}
+
+		return addEvent(tx, models.EventOrderStatusChanged, models.OrderStatusChangedEvent{OrderID: id, UserID: userID, Status: status})
+	})
 
 }
 
 func (or *orderRepository) AdminOrders(status string) ([]domain.OrderDetails, error) {
 
 	var orders []domain.OrderDetails
-	if err := or.DB.Raw("SELECT orders.id AS id, users.name AS username, CONCAT('House Name:',addresses.house_name, ',', 'Street:', addresses.street, ',', 'City:', addresses.city, ',', 'State', addresses.state, ',', 'Phone:', addresses.phone) AS address, payment_methods.payment_name AS payment_method, orders.final_price As total FROM orders JOIN users ON users.id = orders.user_id JOIN payment_methods ON payment_methods.id = orders.payment_method_id JOIN addresses ON orders.address_id = addresses.id WHERE order_status = $1", status).Scan(&orders).Error; err != nil {
+	if err := or.DB.Raw("SELECT orders.id AS id, users.name AS username, CONCAT('House Name:',orders.ship_house_name, ',', 'Street:', orders.ship_street, ',', 'City:', orders.ship_city, ',', 'State', orders.ship_state, ',', 'Phone:', orders.ship_phone) AS address, payment_methods.payment_name AS payment_method, orders.final_price As total FROM orders JOIN users ON users.id = orders.user_id JOIN payment_methods ON payment_methods.id = orders.payment_method_id WHERE order_status = $1", status).Scan(&orders).Error; err != nil {
 		return []domain.OrderDetails{}, err
 	}
 
@@ -143,16 +218,6 @@ func (o *orderRepository) GetOrderDetail(orderID string) (domain.Order, error) {
 
 }
 
-func (i *orderRepository) ReturnOrder(id int) error {
-
-	if err := i.DB.Exec("update orders set order_status='RETURNED' where id=$1", id).Error; err != nil {
-		return err
-	}
-
-	return nil
-
-}
-
 func (o *orderRepository) CheckOrderStatusByID(id int) (string, error) {
 
 	var status string
@@ -177,7 +242,7 @@ func (o *orderRepository) FindAmountFromOrderID(id int) (float64, error) {
 
 func (i *orderRepository) CreditToUserWallet(amount float64, walletId int) error {
 
-	if err := i.DB.Exec("update wallets set amount=$1 where id=$2", amount, walletId).Error; err != nil {
+	if err := i.DB.Exec("update wallets set amount=amount+$1 where id=$2", amount, walletId).Error; err != nil {
 		return err
 	}
 
@@ -219,12 +284,15 @@ func (o *orderRepository) FindWalletIdFromUserID(userId int) (int, error) {
 func (o *orderRepository) CreateNewWallet(userID int) (int, error) {
 
 	var walletID int
-	err := o.DB.Exec("Insert into wallets(user_id,amount) values($1,$2)", userID, 0).Error
-	if err != nil {
-		return 0, err
-	}
+	// every signup ends with the wallet, so the user is announced along with it
+	err := o.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("Insert into wallets(user_id,amount) values($1,$2) returning id", userID, 0).Scan(&walletID).Error; err != nil {
+			return err
+		}
 
-	if err := o.DB.Raw("select id from wallets where user_id=$1", userID).Scan(&walletID).Error; err != nil {
+		return addEvent(tx, models.EventUserRegistered, models.UserRegisteredEvent{UserID: userID})
+	})
+	if err != nil {
 		return 0, err
 	}
 
@@ -233,22 +301,27 @@ func (o *orderRepository) CreateNewWallet(userID int) (int, error) {
 
 func (o *orderRepository) MakePaymentStatusAsPaid(id int) error {
 
-	err := o.DB.Exec("UPDATE orders SET payment_status = 'PAID' WHERE id = $1", id).Error
-	if err != nil {
-		return err
-	}
+	return o.DB.Transaction(func(tx *gorm.DB) error {
+		var userID int
+		if err := tx.Raw("UPDATE orders SET payment_status = 'PAID' WHERE id = $1 AND payment_status <> 'PAID' RETURNING user_id", id).Scan(&userID).Error; err != nil {
+			return err
+		}
 
-	return nil
+		// already paid
+		if userID == 0 {
+			return nil
+		}
+
+		return addEvent(tx, models.EventPaymentCaptured, models.PaymentCapturedEvent{OrderID: id, UserID: userID})
+	})
 }
 
 func (o *orderRepository) GetProductImagesInAOrder(id int) ([]string, error) {
 
 	var images []string
-	err := o.DB.Raw(`SELECT inventories.image
+	err := o.DB.Raw(`SELECT order_items.image
 	FROM order_items 
-	JOIN inventories ON inventories.id = order_items.inventory_id
-	JOIN orders ON orders.id = order_items.order_id 
-	WHERE orders.id = $1`, id).Scan(&images).Error
+	WHERE order_items.order_id = $1`, id).Scan(&images).Error
 	if err != nil {
 		return []string{}, err
 	}
@@ -260,16 +333,17 @@ func (o *orderRepository) GetIndividualOrderDetails(id int) (models.IndividualOr
 
 	var details models.IndividualOrderDetails
 	err := o.DB.Raw(`SELECT orders.id AS order_id,
-	CONCAT('House Name:',addresses.house_name, ' ', 'Street:', addresses.street, ' ', 'City:', addresses.city, ' ', 'State', addresses.state) AS address,
-	addresses.phone AS phone, 
+	CONCAT('House Name:',orders.ship_house_name, ' ', 'Street:', orders.ship_street, ' ', 'City:', orders.ship_city, ' ', 'State', orders.ship_state) AS address,
+	orders.ship_phone AS phone, 
 	orders.coupon_used,
 	payment_methods.payment_name AS payment_method, 
 	orders.final_price As total_amount ,
+	orders.shipping_charge,
+	orders.cod_charge,
 	orders.order_status,
 	orders.payment_status
 	FROM orders 
 	 JOIN payment_methods ON payment_methods.id = orders.payment_method_id 
-	JOIN addresses ON orders.address_id = addresses.id 
 	WHERE orders.id = $1`, id).Scan(&details).Error
 	if err != nil {
 		return models.IndividualOrderDetails{}, err
@@ -281,14 +355,23 @@ func (o *orderRepository) GetIndividualOrderDetails(id int) (models.IndividualOr
 func (o *orderRepository) GetProductDetailsInOrder(id int) ([]models.ProductDetails, error) {
 
 	var products []models.ProductDetails
-	err := o.DB.Raw(`SELECT  inventories.product_name,
-	inventories.image,
+	err := o.DB.Raw(`SELECT  order_items.id AS order_item_id,
+	order_items.product_name,
+	order_items.image,
 	order_items.quantity,
-	order_items.total_price AS amount
+	order_items.total_price AS amount,
+	order_items.hsn_code,
+	order_items.gst_rate,
+	order_items.taxable_value,
+	order_items.cgst,
+	order_items.sgst,
+	order_items.igst,
+	order_items.item_status,
+	order_items.canceled_quantity,
+	order_items.returned_quantity,
+	order_items.refunded_amount
 	FROM order_items 
-	JOIN inventories ON inventories.id = order_items.inventory_id 
-	JOIN orders ON order_items.order_id = orders.id 
-	WHERE orders.id = $1`, id).Scan(&products).Error
+	WHERE order_items.order_id = $1`, id).Scan(&products).Error
 	if err != nil {
 		return []models.ProductDetails{}, err
 	}
@@ -308,3 +391,125 @@ func (o *orderRepository) FindPaymentMethodOfOrder(id int) (string, error) {
 	}
 	return payment, nil
 }
+
+func (o *orderRepository) GetOrderItemStates(orderID int) ([]models.OrderItemState, error) {
+
+	var items []models.OrderItemState
+	err := o.DB.Raw(`SELECT order_items.id,
+	order_items.quantity,
+	order_items.canceled_quantity,
+	order_items.returned_quantity,
+	COALESCE((SELECT SUM(shipment_items.quantity) FROM shipment_items WHERE shipment_items.order_item_id = order_items.id),0) AS shipped,
+	order_items.total_price,
+	order_items.taxable_value + order_items.cgst + order_items.sgst + order_items.igst AS line_paid,
+	order_items.refunded_amount
+	FROM order_items
+	WHERE order_items.order_id = $1
+	ORDER BY order_items.id`, orderID).Scan(&items).Error
+	if err != nil {
+		return []models.OrderItemState{}, err
+	}
+
+	return items, nil
+}
+
+// UpdateOrderItems cancels or returns the given quantities, derives the order status from its items and
+// credits the refund to the wallet, all or nothing so a refund is never paid twice
+func (o *orderRepository) UpdateOrderItems(orderID, userID int, changes []models.OrderItemChange, returned bool, extraRefund float64) error {
+
+	return o.DB.Transaction(func(tx *gorm.DB) error {
+		return updateOrderItems(tx, orderID, userID, changes, returned, extraRefund)
+	})
+}
+
+func updateOrderItems(tx *gorm.DB, orderID, userID int, changes []models.OrderItemChange, returned bool, extraRefund float64) error {
+
+	column := "canceled_quantity"
+	if returned {
+		column = "returned_quantity"
+	}
+
+	var refund float64
+	for _, change := range changes {
+		// the quantity check is repeated here so two requests at the same time can not both take the last one
+		result := tx.Exec(`UPDATE order_items SET `+column+` = `+column+` + $1, refunded_amount = refunded_amount + $2,
+		item_status = CASE WHEN quantity - canceled_quantity - returned_quantity - $1 > 0 THEN item_status
+			WHEN returned_quantity > 0 OR $3 THEN 'RETURNED' ELSE 'CANCELED' END
+		WHERE id = $4 AND order_id = $5 AND quantity - canceled_quantity - returned_quantity >= $1`,
+			change.Quantity, change.Refund, returned, change.OrderItemID, orderID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("quantity is more than what is left of the item")
+		}
+
+		// canceled units go back on the shelf, and so do returned ones as they have passed the inspection
+		if err := releaseReservedStock(tx, change.OrderItemID, change.Quantity); err != nil {
+			return err
+		}
+
+		refund += change.Refund
+	}
+
+	var oldStatus, status string
+	if err := tx.Raw("SELECT order_status FROM orders WHERE id = $1 FOR UPDATE", orderID).Scan(&oldStatus).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Raw(`UPDATE orders SET order_status = CASE
+		WHEN EXISTS (SELECT 1 FROM order_items WHERE order_id = $1 AND item_status NOT IN ('CANCELED','RETURNED')) THEN order_status
+		WHEN EXISTS (SELECT 1 FROM order_items WHERE order_id = $1 AND item_status = 'RETURNED') THEN 'RETURNED'
+		ELSE 'CANCELED' END
+		WHERE id = $1 RETURNING order_status`, orderID).Scan(&status).Error; err != nil {
+		return err
+	}
+
+	if status != oldStatus {
+		if err := addEvent(tx, models.EventOrderStatusChanged, models.OrderStatusChangedEvent{OrderID: orderID, UserID: userID, Status: status}); err != nil {
+			return err
+		}
+	}
+
+	refund += extraRefund
+	if refund <= 0 {
+		return nil
+	}
+
+	if err := tx.Exec("UPDATE orders SET refunded_amount = refunded_amount + $1 WHERE id = $2", refund, orderID).Error; err != nil {
+		return err
+	}
+
+	return creditWallet(tx, userID, refund)
+}
+
+func creditWallet(tx *gorm.DB, userID int, amount float64) error {
+
+	var walletID int
+	if err := tx.Raw("SELECT id FROM wallets WHERE user_id = $1", userID).Scan(&walletID).Error; err != nil {
+		return err
+	}
+
+	if walletID == 0 {
+		return tx.Exec("INSERT INTO wallets (user_id,amount) VALUES ($1,$2)", userID, amount).Error
+	}
+
+	return tx.Exec("UPDATE wallets SET amount = amount + $1 WHERE id = $2", amount, walletID).Error
+}
+
+// GetUnpaidOrders gives the pending orders placed before the time with a method other than cash on delivery
+// that were never paid for. Replacement orders are left out, they are always free
+func (o *orderRepository) GetUnpaidOrders(placedBefore time.Time) ([]int, error) {
+
+	var orders []int
+	err := o.DB.Raw(`SELECT orders.id FROM orders
+	JOIN payment_methods ON payment_methods.id = orders.payment_method_id
+	WHERE payment_methods.cash_on_delivery = false AND orders.payment_status = 'NOT PAID' AND orders.order_status = 'PENDING'
+	AND orders.replacement_for_id IS NULL AND orders.created_at <= $1
+	ORDER BY orders.id`, placedBefore).Scan(&orders).Error
+	if err != nil {
+		return []int{}, err
+	}
+
+	return orders, nil
+}
@@ -1,6 +1,10 @@
 package repository
 
-import "gorm.io/gorm"
+import (
+	"jerseyhub/pkg/utils/models"
+
+	"gorm.io/gorm"
+)
 
 type paymentRepository struct {
 	DB *gorm.DB
@@ -32,9 +36,50 @@ func (p *paymentRepository) FindPrice(order_id int) (float64, error) {
 
 func (p *paymentRepository) UpdatePaymentDetails(orderID, paymentID, razorID string) error {
 	status := "PAID"
-	if err := p.DB.Exec(`UPDATE orders SET payment_status = $1 WHERE id = $2`, status, orderID).Error; err != nil {
-		return err
+	return p.DB.Transaction(func(tx *gorm.DB) error {
+		var order struct {
+			ID          int
+			UserID      int
+			OrderStatus string
+			Unrefunded  float64
+		}
+		if err := tx.Raw(`UPDATE orders SET payment_status = $1 WHERE id = $2 AND payment_status <> $1
+		RETURNING id, user_id, order_status, final_price - refunded_amount AS unrefunded`, status, orderID).Scan(&order).Error; err != nil {
+			return err
+		}
+
+		// a payment verified twice is only captured once
+		if order.ID == 0 {
+			return nil
+		}
+
+		// the payment window closed while the user was paying, the money goes to their wallet
+		if order.OrderStatus == "CANCELED" && order.Unrefunded > 0 {
+			if err := tx.Exec("UPDATE orders SET refunded_amount = final_price WHERE id = $1", order.ID).Error; err != nil {
+				return err
+			}
+
+			if err := creditWallet(tx, order.UserID, order.Unrefunded); err != nil {
+				return err
+			}
+		}
+
+		return addEvent(tx, models.EventPaymentCaptured, models.PaymentCapturedEvent{OrderID: order.ID, UserID: order.UserID, PaymentID: paymentID})
+	})
+}
+
+func (p *paymentRepository) GetOrderPayment(orderID int) (models.OrderPayment, error) {
+
+	var order models.OrderPayment
+	err := p.DB.Raw(`SELECT orders.id AS order_id, orders.user_id, users.name AS username, orders.final_price, orders.order_status,
+	orders.payment_status, payment_methods.cash_on_delivery, orders.created_at
+	FROM orders
+	JOIN users ON users.id = orders.user_id
+	JOIN payment_methods ON payment_methods.id = orders.payment_method_id
+	WHERE orders.id = $1`, orderID).Scan(&order).Error
+	if err != nil {
+		return models.OrderPayment{}, err
 	}
 
-	return nil
+	return order, nil
 }
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type questionRepository struct {
+	DB *gorm.DB
+}
+
+func NewQuestionRepository(db *gorm.DB) *questionRepository {
+	return &questionRepository{
+		DB: db,
+	}
+}
+
+const questionColumns = `SELECT product_questions.id, product_questions.inventory_id, inventories.product_name,
+	product_questions.user_id, users.name AS username, product_questions.question, product_questions.created_at
+	FROM product_questions
+	JOIN inventories ON inventories.id = product_questions.inventory_id
+	JOIN users ON users.id = product_questions.user_id`
+
+const answerColumns = `SELECT product_answers.id, product_answers.product_question_id AS question_id,
+	COALESCE(product_answers.user_id,0) AS user_id, COALESCE(users.name,'jerseyhub') AS username, product_answers.by_admin,
+	product_answers.answer, (SELECT COUNT(*) FROM answer_votes WHERE answer_votes.product_answer_id = product_answers.id) AS upvotes,
+	product_answers.created_at
+	FROM product_answers
+	LEFT JOIN users ON users.id = product_answers.user_id`
+
+func (q *questionRepository) AddQuestion(userID int, question models.AddQuestion) (int, error) {
+
+	var id int
+	err := q.DB.Raw(`INSERT INTO product_questions (inventory_id,user_id,question,created_at)
+	VALUES ($1,$2,$3,$4) RETURNING id`, question.InventoryID, userID, question.Question, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (q *questionRepository) GetQuestion(id int) (models.QuestionDetails, error) {
+
+	var question models.QuestionDetails
+	if err := q.DB.Raw(questionColumns+" WHERE product_questions.id = $1", id).Scan(&question).Error; err != nil {
+		return models.QuestionDetails{}, err
+	}
+
+	return question, nil
+}
+
+// GetProductQuestions gives the questions on a product 5 at a time, latest first
+func (q *questionRepository) GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 5
+
+	var questions []models.QuestionDetails
+	err := q.DB.Raw(questionColumns+` WHERE product_questions.inventory_id = $1
+	ORDER BY product_questions.created_at DESC, product_questions.id DESC LIMIT 5 OFFSET $2`, inventoryID, offset).Scan(&questions).Error
+	if err != nil {
+		return []models.QuestionDetails{}, err
+	}
+
+	return questions, nil
+}
+
+// GetUnansweredQuestions is the admin queue, the oldest question waits the longest so it comes first
+func (q *questionRepository) GetUnansweredQuestions(page int) ([]models.QuestionDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var questions []models.QuestionDetails
+	err := q.DB.Raw(questionColumns+` WHERE NOT EXISTS (SELECT 1 FROM product_answers WHERE product_answers.product_question_id = product_questions.id)
+	ORDER BY product_questions.created_at, product_questions.id LIMIT 20 OFFSET $1`, offset).Scan(&questions).Error
+	if err != nil {
+		return []models.QuestionDetails{}, err
+	}
+
+	return questions, nil
+}
+
+func (q *questionRepository) DeleteQuestion(id int) error {
+
+	return q.DB.Exec("DELETE FROM product_questions WHERE id = $1", id).Error
+}
+
+// GetAnswers puts the most upvoted answers first and the store's own answer ahead of a tie
+func (q *questionRepository) GetAnswers(questionID int) ([]models.AnswerDetails, error) {
+
+	var answers []models.AnswerDetails
+	err := q.DB.Raw(answerColumns+` WHERE product_answers.product_question_id = $1
+	ORDER BY upvotes DESC, product_answers.by_admin DESC, product_answers.created_at, product_answers.id`, questionID).Scan(&answers).Error
+	if err != nil {
+		return []models.AnswerDetails{}, err
+	}
+
+	return answers, nil
+}
+
+func (q *questionRepository) GetAnswer(id int) (models.AnswerDetails, error) {
+
+	var answer models.AnswerDetails
+	if err := q.DB.Raw(answerColumns+" WHERE product_answers.id = $1", id).Scan(&answer).Error; err != nil {
+		return models.AnswerDetails{}, err
+	}
+
+	return answer, nil
+}
+
+// AddAnswer stores an answer, userID 0 is an admin answering for the store
+func (q *questionRepository) AddAnswer(questionID, userID int, answer string) (int, error) {
+
+	var user *int
+	if userID != 0 {
+		user = &userID
+	}
+
+	var id int
+	err := q.DB.Raw(`INSERT INTO product_answers (product_question_id,user_id,by_admin,answer,created_at)
+	VALUES ($1,$2,$3,$4,$5) RETURNING id`, questionID, user, userID == 0, answer, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (q *questionRepository) DeleteAnswer(id int) error {
+
+	return q.DB.Exec("DELETE FROM product_answers WHERE id = $1", id).Error
+}
+
+func (q *questionRepository) AddAnswerVote(id, userID int) error {
+
+	return q.DB.Exec("INSERT INTO answer_votes (product_answer_id,user_id) VALUES ($1,$2) ON CONFLICT DO NOTHING", id, userID).Error
+}
+
+func (q *questionRepository) RemoveAnswerVote(id, userID int) error {
+
+	return q.DB.Exec("DELETE FROM answer_votes WHERE product_answer_id = $1 AND user_id = $2", id, userID).Error
+}
@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"errors"
+	"jerseyhub/pkg/utils/models"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type returnRepository struct {
+	DB *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) *returnRepository {
+	return &returnRepository{
+		DB: db,
+	}
+}
+
+func (r *returnRepository) GetDeliveryDate(orderID int) (time.Time, error) {
+
+	var delivered time.Time
+	if err := r.DB.Raw("SELECT COALESCE(delivered_at, updated_at) FROM orders WHERE id = $1", orderID).Scan(&delivered).Error; err != nil {
+		return time.Time{}, err
+	}
+
+	return delivered, nil
+}
+
+// GetOpenReturnQuantities gives the units of an order already asked for in returns which are not closed yet
+func (r *returnRepository) GetOpenReturnQuantities(orderID int) ([]models.ReturnItem, error) {
+
+	var items []models.ReturnItem
+	err := r.DB.Raw(`SELECT return_request_items.order_item_id, SUM(return_request_items.quantity) AS quantity
+	FROM return_request_items
+	JOIN return_requests ON return_requests.id = return_request_items.return_request_id
+	WHERE return_requests.order_id = $1 AND return_requests.status IN ('REQUESTED','APPROVED','PICKUP_SCHEDULED','RECEIVED')
+	GROUP BY return_request_items.order_item_id`, orderID).Scan(&items).Error
+	if err != nil {
+		return []models.ReturnItem{}, err
+	}
+
+	return items, nil
+}
+
+func (r *returnRepository) CreateReturnRequest(orderID, userID int, request models.ReturnRequest, items []models.OrderItemRequest) (int, error) {
+
+	var id int
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Raw(`INSERT INTO return_requests (order_id,user_id,reason_code,comments,status,created_at,updated_at)
+		VALUES ($1,$2,$3,$4,'REQUESTED',$5,$5) RETURNING id`, orderID, userID, request.ReasonCode, request.Comments, now).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := tx.Exec("INSERT INTO return_request_items (return_request_id,order_item_id,quantity) VALUES ($1,$2,$3)", id, item.OrderItemID, item.Quantity).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *returnRepository) GetReturnRequest(id int) (models.ReturnRequestDetails, error) {
+
+	var request models.ReturnRequestDetails
+	err := r.DB.Raw(`SELECT id,order_id,user_id,reason_code,comments,status,admin_note,pickup_date,refund_amount,kind,
+	exchange_inventory_id,price_difference,replacement_order_id,created_at,updated_at
+	FROM return_requests WHERE id = $1`, id).Scan(&request).Error
+	if err != nil {
+		return models.ReturnRequestDetails{}, err
+	}
+
+	return request, nil
+}
+
+// GetReturnRequests lists the returns of a user, or everyone's when userID is 0, newest first
+func (r *returnRepository) GetReturnRequests(userID int, status string, page int) ([]models.ReturnRequestDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var requests []models.ReturnRequestDetails
+	err := r.DB.Raw(`SELECT id,order_id,user_id,reason_code,comments,status,admin_note,pickup_date,refund_amount,kind,
+	exchange_inventory_id,price_difference,replacement_order_id,created_at,updated_at
+	FROM return_requests
+	WHERE ($1 = 0 OR user_id = $1) AND ($2 = '' OR status = $2)
+	ORDER BY created_at DESC, id DESC LIMIT 20 OFFSET $3`, userID, status, offset).Scan(&requests).Error
+	if err != nil {
+		return []models.ReturnRequestDetails{}, err
+	}
+
+	return requests, nil
+}
+
+func (r *returnRepository) GetReturnItems(id int) ([]models.ReturnItem, error) {
+
+	var items []models.ReturnItem
+	err := r.DB.Raw(`SELECT return_request_items.order_item_id,
+	order_items.product_name,
+	return_request_items.quantity
+	FROM return_request_items
+	JOIN order_items ON order_items.id = return_request_items.order_item_id
+	WHERE return_request_items.return_request_id = $1
+	ORDER BY return_request_items.id`, id).Scan(&items).Error
+	if err != nil {
+		return []models.ReturnItem{}, err
+	}
+
+	return items, nil
+}
+
+func (r *returnRepository) GetReturnImages(id int) ([]string, error) {
+
+	var images []string
+	if err := r.DB.Raw("SELECT url FROM return_request_images WHERE return_request_id = $1 ORDER BY id", id).Scan(&images).Error; err != nil {
+		return []string{}, err
+	}
+
+	return images, nil
+}
+
+func (r *returnRepository) CountReturnImages(id int) (int, error) {
+
+	var count int
+	if err := r.DB.Raw("SELECT COUNT(*) FROM return_request_images WHERE return_request_id = $1", id).Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *returnRepository) AddReturnImage(id int, url string) error {
+
+	return r.DB.Exec("INSERT INTO return_request_images (return_request_id,url) VALUES ($1,$2)", id, url).Error
+}
+
+// UpdateReturnStatus moves a return on only if it is still where the caller saw it, so two admins
+// acting at once cannot both move it
+func (r *returnRepository) UpdateReturnStatus(id int, from, to, note string) (bool, error) {
+
+	result := r.DB.Exec(`UPDATE return_requests SET status = $1, admin_note = CASE WHEN $2 = '' THEN admin_note ELSE $2 END, updated_at = $3
+	WHERE id = $4 AND status = $5`, to, note, time.Now(), id, from)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// SchedulePickup also moves an already scheduled pickup to a new date
+func (r *returnRepository) SchedulePickup(id int, date time.Time) (bool, error) {
+
+	result := r.DB.Exec(`UPDATE return_requests SET status = 'PICKUP_SCHEDULED', pickup_date = $1, updated_at = $2
+	WHERE id = $3 AND status IN ('APPROVED','PICKUP_SCHEDULED')`, date, time.Now(), id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *returnRepository) SetReturnRefund(id int, amount float64) error {
+
+	return r.DB.Exec("UPDATE return_requests SET refund_amount = $1, updated_at = $2 WHERE id = $3", amount, time.Now(), id).Error
+}
+
+// GetOrderItemProduct gives the product an order item was bought as, to find its other sizes
+func (r *returnRepository) GetOrderItemProduct(orderItemID int) (models.ExchangeProduct, error) {
+
+	var product models.ExchangeProduct
+	err := r.DB.Raw(`SELECT inventories.id AS inventory_id,inventories.product_name,inventories.category_id,inventories.size,inventories.stock,inventories.price
+	FROM order_items
+	JOIN inventories ON inventories.id = order_items.inventory_id
+	WHERE order_items.id = $1`, orderItemID).Scan(&product).Error
+	if err != nil {
+		return models.ExchangeProduct{}, err
+	}
+
+	return product, nil
+}
+
+func (r *returnRepository) GetExchangeProduct(inventoryID int) (models.ExchangeProduct, error) {
+
+	var product models.ExchangeProduct
+	err := r.DB.Raw(`SELECT id AS inventory_id,product_name,category_id,size,stock,price
+	FROM inventories WHERE id = $1`, inventoryID).Scan(&product).Error
+	if err != nil {
+		return models.ExchangeProduct{}, err
+	}
+
+	return product, nil
+}
+
+// CreateExchangeRequest holds the stock of the new size along with the request, so the size is still
+// there once the original is back and passes the inspection
+func (r *returnRepository) CreateExchangeRequest(orderID, userID int, request models.ExchangeRequest, difference float64) (int, error) {
+
+	var id int
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE inventories SET stock = stock - $1 WHERE id = $2 AND stock >= $1", request.Quantity, request.InventoryID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("the size asked for is out of stock")
+		}
+
+		var stock int
+		if err := tx.Raw("SELECT stock FROM inventories WHERE id = $1", request.InventoryID).Scan(&stock).Error; err != nil {
+			return err
+		}
+
+		if err := addEvent(tx, models.EventStockChanged, models.StockChangedEvent{InventoryID: request.InventoryID, Change: -request.Quantity, Stock: stock}); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := tx.Raw(`INSERT INTO return_requests (order_id,user_id,reason_code,comments,status,kind,exchange_inventory_id,price_difference,created_at,updated_at)
+		VALUES ($1,$2,'WRONG_SIZE',$3,'REQUESTED','EXCHANGE',$4,$5,$6,$6) RETURNING id`, orderID, userID, request.Comments, request.InventoryID, difference, now).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("INSERT INTO return_request_items (return_request_id,order_item_id,quantity) VALUES ($1,$2,$3)", id, request.OrderItemID, request.Quantity).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ReleaseExchangeStock puts back the stock held for an exchange which did not go ahead
+func (r *returnRepository) ReleaseExchangeStock(id int) error {
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var released models.StockChangedEvent
+		if err := tx.Raw(`UPDATE inventories SET stock = inventories.stock + return_request_items.quantity
+		FROM return_requests
+		JOIN return_request_items ON return_request_items.return_request_id = return_requests.id
+		WHERE return_requests.id = $1 AND inventories.id = return_requests.exchange_inventory_id
+		RETURNING inventories.id AS inventory_id, return_request_items.quantity AS change, inventories.stock`, id).Scan(&released).Error; err != nil {
+			return err
+		}
+
+		if released.InventoryID == 0 {
+			return nil
+		}
+
+		return addEvent(tx, models.EventStockChanged, released)
+	})
+}
+
+// exchangeLine is the tax split of the line going back in an exchange
+type exchangeLine struct {
+	HsnCode      string
+	GstRate      float64
+	TaxableValue float64
+	Cgst         float64
+	Sgst         float64
+	Igst         float64
+}
+
+// carry scales the tax split of the original line down to what the replacement stands for, the taxable
+// value takes the rounding so the line still adds up to paid
+func (l exchangeLine) carry(paid float64) exchangeLine {
+
+	linePaid := l.TaxableValue + l.Cgst + l.Sgst + l.Igst
+	if linePaid == 0 {
+		// orders placed before tax was stored per item
+		return exchangeLine{TaxableValue: paid}
+	}
+
+	share := paid / linePaid
+	carried := exchangeLine{
+		HsnCode: l.HsnCode,
+		GstRate: l.GstRate,
+		Cgst:    math.Round(l.Cgst*share*100) / 100,
+		Sgst:    math.Round(l.Sgst*share*100) / 100,
+		Igst:    math.Round(l.Igst*share*100) / 100,
+	}
+	carried.TaxableValue = math.Round((paid-carried.Cgst-carried.Sgst-carried.Igst)*100) / 100
+
+	return carried
+}
+
+// CompleteExchange takes the original items back and places the order shipping the new size to the address
+// of the original, in one go so the item is never returned without its replacement. The replacement is not
+// charged again, it carries what was paid for the original less the refund along with its tax, so returning
+// it refunds that amount. It takes over the stock held for the exchange
+func (r *returnRepository) CompleteExchange(id int, changes []models.OrderItemChange, paid float64) (int, error) {
+
+	var order models.OrderPlacedEvent
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var request struct {
+			OrderID int
+			UserID  int
+		}
+		if err := tx.Raw("SELECT order_id, user_id FROM return_requests WHERE id = $1", id).Scan(&request).Error; err != nil {
+			return err
+		}
+
+		if err := updateOrderItems(tx, request.OrderID, request.UserID, changes, true, 0); err != nil {
+			return err
+		}
+
+		var original exchangeLine
+		if err := tx.Raw(`SELECT order_items.hsn_code, order_items.gst_rate, order_items.taxable_value, order_items.cgst, order_items.sgst, order_items.igst
+		FROM return_request_items
+		JOIN order_items ON order_items.id = return_request_items.order_item_id
+		WHERE return_request_items.return_request_id = $1`, id).Scan(&original).Error; err != nil {
+			return err
+		}
+		line := original.carry(paid)
+
+		if err := tx.Raw(`INSERT INTO orders (created_at,updated_at,user_id,address_id,payment_method_id,final_price,shipping_charge,cod_charge,
+		payment_status,replacement_for_id,ship_name,ship_house_name,ship_street,ship_city,ship_state,ship_pin,ship_phone)
+		SELECT $1, $1, user_id, address_id, payment_method_id, $2, 0, 0, 'PAID', id,
+		ship_name, ship_house_name, ship_street, ship_city, ship_state, ship_pin, ship_phone
+		FROM orders WHERE id = $3
+		RETURNING id AS order_id, user_id, final_price`, time.Now(), paid, request.OrderID).Scan(&order).Error; err != nil {
+			return err
+		}
+		orderID := order.OrderID
+
+		if err := tx.Exec(`INSERT INTO order_items (order_id,inventory_id,product_name,image,price,quantity,total_price,
+		hsn_code,gst_rate,taxable_value,cgst,sgst,igst,reserved_quantity)
+		SELECT $1, inventories.id, inventories.product_name, inventories.image, CAST($2 AS NUMERIC) / return_request_items.quantity,
+		return_request_items.quantity, CAST($2 AS NUMERIC), $3, $4, $5, $6, $7, $8, return_request_items.quantity
+		FROM return_requests
+		JOIN return_request_items ON return_request_items.return_request_id = return_requests.id
+		JOIN inventories ON inventories.id = return_requests.exchange_inventory_id
+		WHERE return_requests.id = $9`, orderID, paid, line.HsnCode, line.GstRate, line.TaxableValue, line.Cgst, line.Sgst, line.Igst, id).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("UPDATE return_requests SET replacement_order_id = $1 WHERE id = $2", orderID, id).Error; err != nil {
+			return err
+		}
+
+		return addEvent(tx, models.EventOrderPlaced, order)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return order.OrderID, nil
+}
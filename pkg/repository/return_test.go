@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_exchangeLine_carry(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		original exchangeLine
+		paid     float64
+		want     exchangeLine
+	}{
+		{
+			name:     "replacement carries all of the line",
+			original: exchangeLine{HsnCode: "6109", GstRate: 12, TaxableValue: 803.57, Cgst: 48.21, Sgst: 48.22},
+			paid:     900,
+			want:     exchangeLine{HsnCode: "6109", GstRate: 12, TaxableValue: 803.57, Cgst: 48.21, Sgst: 48.22},
+		},
+		{
+			name:     "replacement carries one of three units less the price difference",
+			original: exchangeLine{HsnCode: "6109", GstRate: 12, TaxableValue: 2410.71, Igst: 289.29},
+			paid:     850,
+			want:     exchangeLine{HsnCode: "6109", GstRate: 12, TaxableValue: 758.93, Igst: 91.07},
+		},
+		{
+			name:     "line from before tax was stored per item",
+			original: exchangeLine{},
+			paid:     450,
+			want:     exchangeLine{TaxableValue: 450},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.original.carry(tt.paid)
+
+			assert.Equal(t, tt.want, got)
+			assert.InDelta(t, tt.paid, got.TaxableValue+got.Cgst+got.Sgst+got.Igst, 0.001)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type reviewRepository struct {
+	DB *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) *reviewRepository {
+	return &reviewRepository{
+		DB: db,
+	}
+}
+
+// a purchase counts once the item is delivered and stops counting if it is returned
+const deliveredPurchase = `SELECT 1 FROM order_items JOIN orders ON orders.id = order_items.order_id
+	WHERE orders.order_status = 'DELIVERED' AND order_items.item_status = 'DELIVERED'`
+
+const reviewColumns = `SELECT reviews.id, reviews.inventory_id, inventories.product_name, reviews.user_id, users.name AS username,
+	reviews.rating, reviews.title, reviews.comment, reviews.status, reviews.admin_reply, reviews.replied_at,
+	(SELECT COUNT(*) FROM review_votes WHERE review_votes.review_id = reviews.id) AS helpful,
+	EXISTS (` + deliveredPurchase + ` AND orders.user_id = reviews.user_id AND order_items.inventory_id = reviews.inventory_id) AS verified_purchase,
+	reviews.created_at
+	FROM reviews
+	JOIN inventories ON inventories.id = reviews.inventory_id
+	JOIN users ON users.id = reviews.user_id`
+
+func (r *reviewRepository) CheckDeliveredPurchase(userID, inventoryID int) (bool, error) {
+
+	var delivered bool
+	err := r.DB.Raw(`SELECT EXISTS (`+deliveredPurchase+` AND orders.user_id = $1 AND order_items.inventory_id = $2)`, userID, inventoryID).Scan(&delivered).Error
+	if err != nil {
+		return false, err
+	}
+
+	return delivered, nil
+}
+
+func (r *reviewRepository) CheckReviewExists(userID, inventoryID int) (bool, error) {
+
+	var count int
+	if err := r.DB.Raw("SELECT COUNT(*) FROM reviews WHERE user_id = $1 AND inventory_id = $2", userID, inventoryID).Scan(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *reviewRepository) AddReview(userID int, review models.AddReview) (int, error) {
+
+	var id int
+	err := r.DB.Raw(`INSERT INTO reviews (inventory_id,user_id,rating,title,comment,status,created_at,updated_at)
+	VALUES ($1,$2,$3,$4,$5,'PENDING',$6,$6) RETURNING id`, review.InventoryID, userID, review.Rating, review.Title, review.Comment, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateReview sends an edited review back for moderation
+func (r *reviewRepository) UpdateReview(id int, review models.EditReview) error {
+
+	return r.DB.Exec(`UPDATE reviews SET rating = $1, title = $2, comment = $3, status = 'PENDING', updated_at = $4 WHERE id = $5`,
+		review.Rating, review.Title, review.Comment, time.Now(), id).Error
+}
+
+func (r *reviewRepository) DeleteReview(id int) error {
+
+	return r.DB.Exec("DELETE FROM reviews WHERE id = $1", id).Error
+}
+
+func (r *reviewRepository) GetReview(id int) (models.ReviewDetails, error) {
+
+	var review models.ReviewDetails
+	if err := r.DB.Raw(reviewColumns+" WHERE reviews.id = $1", id).Scan(&review).Error; err != nil {
+		return models.ReviewDetails{}, err
+	}
+
+	return review, nil
+}
+
+// GetProductReviews gives the approved reviews of a product 10 at a time, latest first or most helpful first
+func (r *reviewRepository) GetProductReviews(inventoryID, page int, sort string) ([]models.ReviewDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 10
+
+	order := "reviews.created_at DESC, reviews.id DESC"
+	if sort == "helpful" {
+		order = "helpful DESC, reviews.created_at DESC, reviews.id DESC"
+	}
+
+	var reviews []models.ReviewDetails
+	err := r.DB.Raw(reviewColumns+" WHERE reviews.inventory_id = $1 AND reviews.status = 'APPROVED' ORDER BY "+order+" LIMIT 10 OFFSET $2", inventoryID, offset).Scan(&reviews).Error
+	if err != nil {
+		return []models.ReviewDetails{}, err
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepository) GetProductRating(inventoryID int) (models.ProductReviews, error) {
+
+	var rating models.ProductReviews
+	err := r.DB.Raw(`SELECT COALESCE(ROUND(AVG(rating),1),0) AS average_rating, COUNT(*) AS rating_count
+	FROM reviews WHERE inventory_id = $1 AND status = 'APPROVED'`, inventoryID).Scan(&rating).Error
+	if err != nil {
+		return models.ProductReviews{}, err
+	}
+
+	return rating, nil
+}
+
+// GetReviews lists the reviews of a user, or everyone's when userID is 0, newest first
+func (r *reviewRepository) GetReviews(userID int, status string, page int) ([]models.ReviewDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var reviews []models.ReviewDetails
+	err := r.DB.Raw(reviewColumns+` WHERE ($1 = 0 OR reviews.user_id = $1) AND ($2 = '' OR reviews.status = $2)
+	ORDER BY reviews.created_at DESC, reviews.id DESC LIMIT 20 OFFSET $3`, userID, status, offset).Scan(&reviews).Error
+	if err != nil {
+		return []models.ReviewDetails{}, err
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepository) GetReviewImages(id int) ([]string, error) {
+
+	var images []string
+	if err := r.DB.Raw("SELECT url FROM review_images WHERE review_id = $1 ORDER BY id", id).Scan(&images).Error; err != nil {
+		return []string{}, err
+	}
+
+	return images, nil
+}
+
+func (r *reviewRepository) CountReviewImages(id int) (int, error) {
+
+	var count int
+	if err := r.DB.Raw("SELECT COUNT(*) FROM review_images WHERE review_id = $1", id).Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *reviewRepository) AddReviewImage(id int, url string) error {
+
+	return r.DB.Exec("INSERT INTO review_images (review_id,url) VALUES ($1,$2)", id, url).Error
+}
+
+func (r *reviewRepository) AddReviewVote(id, userID int) error {
+
+	return r.DB.Exec("INSERT INTO review_votes (review_id,user_id) VALUES ($1,$2) ON CONFLICT DO NOTHING", id, userID).Error
+}
+
+func (r *reviewRepository) RemoveReviewVote(id, userID int) error {
+
+	return r.DB.Exec("DELETE FROM review_votes WHERE review_id = $1 AND user_id = $2", id, userID).Error
+}
+
+func (r *reviewRepository) SetReviewStatus(id int, status string) error {
+
+	return r.DB.Exec("UPDATE reviews SET status = $1, updated_at = $2 WHERE id = $3", status, time.Now(), id).Error
+}
+
+func (r *reviewRepository) ReplyToReview(id int, reply string) error {
+
+	now := time.Now()
+	return r.DB.Exec("UPDATE reviews SET admin_reply = $1, replied_at = $2, updated_at = $2 WHERE id = $3", reply, now, id).Error
+}
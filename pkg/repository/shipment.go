@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type shipmentRepository struct {
+	DB *gorm.DB
+}
+
+func NewShipmentRepository(db *gorm.DB) *shipmentRepository {
+	return &shipmentRepository{
+		DB: db,
+	}
+}
+
+func (s *shipmentRepository) GetOrderItemQuantities(orderID int) ([]models.OrderItemQuantity, error) {
+
+	var quantities []models.OrderItemQuantity
+	// canceled units are never shipped
+	err := s.DB.Raw(`SELECT order_items.id,
+	order_items.quantity - order_items.canceled_quantity AS quantity,
+	COALESCE(SUM(shipment_items.quantity),0) AS shipped
+	FROM order_items
+	LEFT JOIN shipment_items ON shipment_items.order_item_id = order_items.id
+	WHERE order_items.order_id = $1
+	GROUP BY order_items.id, order_items.quantity, order_items.canceled_quantity`, orderID).Scan(&quantities).Error
+	if err != nil {
+		return []models.OrderItemQuantity{}, err
+	}
+
+	return quantities, nil
+}
+
+func (s *shipmentRepository) GetShippingAddress(orderID int) (models.CourierShipmentRequest, error) {
+
+	var address models.CourierShipmentRequest
+	err := s.DB.Raw(`SELECT orders.id AS order_id,
+	orders.ship_name AS name,
+	orders.ship_house_name AS house_name,
+	orders.ship_street AS street,
+	orders.ship_city AS city,
+	orders.ship_state AS state,
+	orders.ship_pin AS pin,
+	orders.ship_phone AS phone
+	FROM orders
+	WHERE orders.id = $1`, orderID).Scan(&address).Error
+	if err != nil {
+		return models.CourierShipmentRequest{}, err
+	}
+
+	return address, nil
+}
+
+func (s *shipmentRepository) CreateShipment(orderID int, booking models.CourierBooking, items []models.ShipmentItemRequest) (int, error) {
+
+	var id int
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Raw(`INSERT INTO shipments (order_id,courier,tracking_number,status,expected_delivery,created_at,updated_at)
+		VALUES ($1,$2,$3,'BOOKED',$4,$5,$5) RETURNING id`, orderID, booking.Courier, booking.TrackingNumber, booking.ExpectedDelivery, now).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := tx.Exec("INSERT INTO shipment_items (shipment_id,order_item_id,quantity) VALUES ($1,$2,$3)", id, item.OrderItemID, item.Quantity).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Exec(`INSERT INTO shipment_events (shipment_id,status,description,occurred_at)
+		VALUES ($1,'BOOKED','Shipment booked',$2)`, id, now).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *shipmentRepository) GetShipment(id int) (domain.Shipment, error) {
+
+	var shipment domain.Shipment
+	if err := s.DB.Raw("SELECT * FROM shipments WHERE id = ?", id).Scan(&shipment).Error; err != nil {
+		return domain.Shipment{}, err
+	}
+
+	return shipment, nil
+}
+
+func (s *shipmentRepository) GetShipmentsByOrder(orderID int) ([]models.ShipmentDetails, error) {
+
+	var shipments []models.ShipmentDetails
+	err := s.DB.Raw(`SELECT id,courier,tracking_number,status,expected_delivery
+	FROM shipments WHERE order_id = $1 ORDER BY id`, orderID).Scan(&shipments).Error
+	if err != nil {
+		return []models.ShipmentDetails{}, err
+	}
+
+	return shipments, nil
+}
+
+func (s *shipmentRepository) GetShipmentItems(shipmentID int) ([]models.ShipmentItemDetails, error) {
+
+	var items []models.ShipmentItemDetails
+	err := s.DB.Raw(`SELECT shipment_items.order_item_id,
+	order_items.product_name,
+	shipment_items.quantity
+	FROM shipment_items
+	JOIN order_items ON order_items.id = shipment_items.order_item_id
+	WHERE shipment_items.shipment_id = $1`, shipmentID).Scan(&items).Error
+	if err != nil {
+		return []models.ShipmentItemDetails{}, err
+	}
+
+	return items, nil
+}
+
+func (s *shipmentRepository) GetTrackingEvents(shipmentID int) ([]models.TrackingEvent, error) {
+
+	var events []models.TrackingEvent
+	err := s.DB.Raw(`SELECT status,location,description,occurred_at
+	FROM shipment_events WHERE shipment_id = $1 ORDER BY occurred_at, id`, shipmentID).Scan(&events).Error
+	if err != nil {
+		return []models.TrackingEvent{}, err
+	}
+
+	return events, nil
+}
+
+func (s *shipmentRepository) AddTrackingEvent(shipmentID int, event models.TrackingEvent) error {
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO shipment_events (shipment_id,status,location,description,occurred_at)
+		VALUES ($1,$2,$3,$4,$5)`, shipmentID, event.Status, event.Location, event.Description, event.OccurredAt).Error; err != nil {
+			return err
+		}
+
+		// events can arrive late, the shipment status follows the latest one
+		if err := tx.Exec(`UPDATE shipments SET status = (
+			SELECT status FROM shipment_events WHERE shipment_id = $1 ORDER BY occurred_at DESC, id DESC LIMIT 1
+		), updated_at = $2 WHERE id = $1`, shipmentID, time.Now()).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *shipmentRepository) CheckTrackingEventExists(shipmentID int, status string, occurredAt time.Time) (bool, error) {
+
+	var count int
+	err := s.DB.Raw(`SELECT COUNT(*) FROM shipment_events
+	WHERE shipment_id = $1 AND status = $2 AND occurred_at = $3`, shipmentID, status, occurredAt).Scan(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *shipmentRepository) CountUndeliveredShipments(orderID int) (int, error) {
+
+	var count int
+	err := s.DB.Raw("SELECT COUNT(*) FROM shipments WHERE order_id = $1 AND status <> 'DELIVERED'", orderID).Scan(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type shippingRepository struct {
+	DB *gorm.DB
+}
+
+func NewShippingRepository(db *gorm.DB) *shippingRepository {
+	return &shippingRepository{
+		DB: db,
+	}
+}
+
+func (s *shippingRepository) AddShippingZone(zone models.ShippingZone) error {
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		if zone.IsDefault {
+			if err := tx.Exec("UPDATE shipping_zones SET is_default = false").Error; err != nil {
+				return err
+			}
+		}
+
+		var id int
+		if err := tx.Raw(`INSERT INTO shipping_zones (name,flat_rate,per_item_rate,free_shipping_above,cod_surcharge,is_default)
+		VALUES ($1,$2,$3,$4,$5,$6) RETURNING id`, zone.Name, zone.FlatRate, zone.PerItemRate, zone.FreeShippingAbove, zone.CodSurcharge, zone.IsDefault).Scan(&id).Error; err != nil {
+			return err
+		}
+
+		return addZoneAreas(tx, id, zone)
+	})
+}
+
+func (s *shippingRepository) UpdateShippingZone(zone models.ShippingZone) error {
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		if zone.IsDefault {
+			if err := tx.Exec("UPDATE shipping_zones SET is_default = false WHERE id <> $1", zone.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Exec(`UPDATE shipping_zones SET name = $1, flat_rate = $2, per_item_rate = $3,
+		free_shipping_above = $4, cod_surcharge = $5, is_default = $6 WHERE id = $7`,
+			zone.Name, zone.FlatRate, zone.PerItemRate, zone.FreeShippingAbove, zone.CodSurcharge, zone.IsDefault, zone.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM shipping_zone_areas WHERE zone_id = $1", zone.ID).Error; err != nil {
+			return err
+		}
+
+		return addZoneAreas(tx, zone.ID, zone)
+	})
+}
+
+func addZoneAreas(tx *gorm.DB, zoneID int, zone models.ShippingZone) error {
+
+	for _, state := range zone.States {
+		if err := tx.Exec("INSERT INTO shipping_zone_areas (zone_id,state,pin_prefix) VALUES ($1,$2,'')", zoneID, strings.TrimSpace(state)).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, prefix := range zone.PinPrefixes {
+		if err := tx.Exec("INSERT INTO shipping_zone_areas (zone_id,state,pin_prefix) VALUES ($1,'',$2)", zoneID, strings.TrimSpace(prefix)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *shippingRepository) DeleteShippingZone(id int) error {
+
+	if err := s.DB.Exec("UPDATE shipping_zones SET is_deleted = true, is_default = false WHERE id = $1", id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *shippingRepository) GetShippingZones() ([]models.ShippingZone, error) {
+
+	var zones []models.ShippingZone
+	err := s.DB.Raw(`SELECT id,name,flat_rate,per_item_rate,free_shipping_above,cod_surcharge,is_default
+	FROM shipping_zones WHERE is_deleted = false ORDER BY id`).Scan(&zones).Error
+	if err != nil {
+		return []models.ShippingZone{}, err
+	}
+
+	return zones, nil
+}
+
+func (s *shippingRepository) GetZoneAreas(zoneID int) ([]domain.ShippingZoneArea, error) {
+
+	var areas []domain.ShippingZoneArea
+	if err := s.DB.Raw("SELECT * FROM shipping_zone_areas WHERE zone_id = ?", zoneID).Scan(&areas).Error; err != nil {
+		return []domain.ShippingZoneArea{}, err
+	}
+
+	return areas, nil
+}
+
+func (s *shippingRepository) CheckShippingZoneExists(id int) (bool, error) {
+
+	var count int
+	if err := s.DB.Raw("SELECT COUNT(*) FROM shipping_zones WHERE id = $1 AND is_deleted = false", id).Scan(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// FindShippingZone prefers the longest matching PIN prefix, then the state, then the default zone
+func (s *shippingRepository) FindShippingZone(state string, pin string) (domain.ShippingZone, error) {
+
+	var zone domain.ShippingZone
+	err := s.DB.Raw(`SELECT shipping_zones.* FROM shipping_zones
+	LEFT JOIN shipping_zone_areas ON shipping_zone_areas.zone_id = shipping_zones.id
+	AND ((shipping_zone_areas.pin_prefix <> '' AND $2 LIKE shipping_zone_areas.pin_prefix || '%')
+	OR (shipping_zone_areas.pin_prefix = '' AND LOWER(shipping_zone_areas.state) = LOWER($1)))
+	WHERE shipping_zones.is_deleted = false
+	AND (shipping_zone_areas.id IS NOT NULL OR shipping_zones.is_default = true)
+	ORDER BY shipping_zone_areas.id IS NULL, LENGTH(shipping_zone_areas.pin_prefix) DESC
+	LIMIT 1`, strings.TrimSpace(state), strings.TrimSpace(pin)).Scan(&zone).Error
+	if err != nil {
+		return domain.ShippingZone{}, err
+	}
+
+	return zone, nil
+}
+
+func (s *shippingRepository) GetAddress(addressID int) (models.Address, error) {
+
+	var address models.Address
+	if err := s.DB.Raw("SELECT * FROM addresses WHERE id = ? AND is_deleted = false", addressID).Scan(&address).Error; err != nil {
+		return models.Address{}, err
+	}
+
+	return address, nil
+}
+
+func (s *shippingRepository) CheckIfCashOnDelivery(paymentID int) (bool, error) {
+
+	var cod bool
+	if err := s.DB.Raw("SELECT cash_on_delivery FROM payment_methods WHERE id = ?", paymentID).Scan(&cod).Error; err != nil {
+		return false, err
+	}
+
+	return cod, nil
+}
+
+func (s *shippingRepository) ImportServiceablePins(pins []models.ServiceablePin) error {
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		for _, pin := range pins {
+			if err := tx.Exec(`INSERT INTO serviceable_pins (pin,cod_allowed,prepaid_allowed,delivery_days) VALUES ($1,$2,$3,$4)
+			ON CONFLICT (pin) DO UPDATE SET cod_allowed = EXCLUDED.cod_allowed, prepaid_allowed = EXCLUDED.prepaid_allowed,
+			delivery_days = EXCLUDED.delivery_days`, pin.Pin, pin.CodAllowed, pin.PrepaidAllowed, pin.DeliveryDays).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *shippingRepository) GetServiceablePins(page int) ([]models.ServiceablePin, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 50
+
+	var pins []models.ServiceablePin
+	if err := s.DB.Raw("SELECT * FROM serviceable_pins ORDER BY pin LIMIT $1 OFFSET $2", 50, offset).Scan(&pins).Error; err != nil {
+		return []models.ServiceablePin{}, err
+	}
+
+	return pins, nil
+}
+
+func (s *shippingRepository) DeleteServiceablePin(pin string) (bool, error) {
+
+	result := s.DB.Exec("DELETE FROM serviceable_pins WHERE pin = ?", pin)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (s *shippingRepository) FindServiceablePin(pin string) (domain.ServiceablePin, error) {
+
+	var serviceable domain.ServiceablePin
+	if err := s.DB.Raw("SELECT * FROM serviceable_pins WHERE pin = ?", pin).Scan(&serviceable).Error; err != nil {
+		return domain.ServiceablePin{}, err
+	}
+
+	return serviceable, nil
+}
+
+func (s *shippingRepository) CountServiceablePins() (int, error) {
+
+	var count int
+	if err := s.DB.Raw("SELECT COUNT(*) FROM serviceable_pins").Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"jerseyhub/pkg/domain"
 	interfaces "jerseyhub/pkg/repository/interface"
@@ -70,10 +72,19 @@ func (c *userDatabase) FindUserByEmail(user models.UserLogin) (models.UserSignIn
 }
 
 func (i *userDatabase) AddAddress(id int, address models.AddAddress, result bool) error {
-	err := i.DB.Exec(`
+	err := i.DB.Transaction(func(tx *gorm.DB) error {
+		// a user has exactly one default address
+		if result {
+			if err := tx.Exec(`UPDATE addresses SET "default" = false WHERE user_id = $1`, id).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(`
 		INSERT INTO addresses (user_id, name, house_name, street, city, state, phone, pin,"default")
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 )`,
-		id, address.Name, address.HouseName, address.Street, address.City, address.State, address.Phone, address.Pin, result).Error
+			id, address.Name, address.HouseName, address.Street, address.City, address.State, address.Phone, address.Pin, result).Error
+	})
 	if err != nil {
 		return errors.New("could not add address")
 	}
@@ -85,7 +96,7 @@ func (c *userDatabase) CheckIfFirstAddress(id int) bool {
 
 	var count int
 	// query := fmt.Sprintf("select count(*) from addresses where user_id='%s'", id)
-	if err := c.DB.Raw("select count(*) from addresses where user_id=$1", id).Scan(&count).Error; err != nil {
+	if err := c.DB.Raw("select count(*) from addresses where user_id=$1 and is_deleted=false", id).Scan(&count).Error; err != nil {
 		return false
 	}
 	// if count is greater than 0 that means the user already exist
@@ -97,7 +108,7 @@ func (ad *userDatabase) GetAddresses(id int) ([]domain.Address, error) {
 
 	var addresses []domain.Address
 
-	if err := ad.DB.Raw("select * from addresses where user_id=?", id).Scan(&addresses).Error; err != nil {
+	if err := ad.DB.Raw("select * from addresses where user_id=? and is_deleted=false order by id", id).Scan(&addresses).Error; err != nil {
 		return []domain.Address{}, errors.New("error in getting addresses")
 	}
 
@@ -105,6 +116,67 @@ func (ad *userDatabase) GetAddresses(id int) ([]domain.Address, error) {
 
 }
 
+func (ad *userDatabase) GetAddress(userID, addressID int) (domain.Address, error) {
+
+	var address domain.Address
+	if err := ad.DB.Raw("select * from addresses where id=$1 and user_id=$2 and is_deleted=false", addressID, userID).Scan(&address).Error; err != nil {
+		return domain.Address{}, err
+	}
+
+	return address, nil
+}
+
+func (ad *userDatabase) CheckIfAddressInOrders(addressID int) (bool, error) {
+
+	var count int
+	if err := ad.DB.Raw("select count(*) from orders where address_id=?", addressID).Scan(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// UpdateAddress edits the address in place, orders keep a copy of the address they were shipped to
+func (i *userDatabase) UpdateAddress(userID, addressID int, address models.AddAddress, isDefault bool) error {
+
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		if isDefault {
+			if err := tx.Exec(`UPDATE addresses SET "default" = false WHERE user_id = $1 AND id <> $2`, userID, addressID).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(`UPDATE addresses SET name = $1, house_name = $2, street = $3, city = $4, state = $5, phone = $6, pin = $7, "default" = $8
+		WHERE id = $9 AND user_id = $10`,
+			address.Name, address.HouseName, address.Street, address.City, address.State, address.Phone, address.Pin, isDefault, addressID, userID).Error
+	})
+}
+
+func (i *userDatabase) DeleteAddress(userID, addressID int, soft bool) error {
+
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		query := "DELETE FROM addresses WHERE id = $1 AND user_id = $2"
+		if soft {
+			query = `UPDATE addresses SET is_deleted = true, "default" = false WHERE id = $1 AND user_id = $2`
+		}
+
+		if err := tx.Exec(query, addressID, userID).Error; err != nil {
+			return err
+		}
+
+		// the latest address takes over when the default one is removed
+		return tx.Exec(`UPDATE addresses SET "default" = true
+		WHERE id = (SELECT id FROM addresses WHERE user_id = $1 AND is_deleted = false ORDER BY id DESC LIMIT 1)
+		AND NOT EXISTS (SELECT 1 FROM addresses WHERE user_id = $1 AND is_deleted = false AND "default" = true)`, userID).Error
+	})
+}
+
+func (i *userDatabase) SetDefaultAddress(userID, addressID int) error {
+
+	// one statement flips the old default off and the new one on
+	return i.DB.Exec(`UPDATE addresses SET "default" = (id = $1) WHERE user_id = $2 AND is_deleted = false`, addressID, userID).Error
+}
+
 func (ad *userDatabase) GetUserDetails(id int) (models.UserDetailsResponse, error) {
 
 	var details models.UserDetailsResponse
@@ -161,7 +233,7 @@ func (i *userDatabase) EditName(id int, name string) error {
 }
 
 func (i *userDatabase) EditEmail(id int, email string) error {
-	err := i.DB.Exec(`update users set email=$1 where id=$2`, email, id).Error
+	err := i.DB.Exec(`update users set email=$1,email_verified=false where id=$2`, email, id).Error
 	if err != nil {
 		return err
 	}
@@ -204,7 +276,7 @@ func (ad *userDatabase) UpdateQuantityAdd(id, inv_id int) error {
 
 	query := `
 		UPDATE line_items
-		SET quantity = quantity + 1
+		SET quantity = quantity + 1, updated_at = NOW()
 		WHERE cart_id=$1 AND inventory_id=$2
 	`
 
@@ -219,7 +291,7 @@ func (ad *userDatabase) UpdateQuantityAdd(id, inv_id int) error {
 func (ad *userDatabase) UpdateQuantityLess(id, inv_id int) error {
 
 	if err := ad.DB.Exec(`UPDATE line_items
-	SET quantity = quantity - 1
+	SET quantity = quantity - 1, updated_at = NOW()
 	WHERE cart_id = $1 AND inventory_id=$2;
 	`, id, inv_id).Error; err != nil {
 		return err
@@ -359,3 +431,62 @@ func (i *userDatabase) FindStock(id int) (int, error) {
 
 	return stock, nil
 }
+
+func (i *userDatabase) CreatePasswordReset(userID int, tokenHash string, expiresAt time.Time) error {
+
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		// only the latest link sent to the user should work
+		if err := tx.Exec("UPDATE password_resets SET used = true WHERE user_id = $1 AND used = false", userID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`INSERT INTO password_resets (user_id,token_hash,expires_at)
+		VALUES ($1,$2,$3)`, userID, tokenHash, expiresAt).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (i *userDatabase) FindPasswordReset(tokenHash string) (domain.PasswordReset, error) {
+
+	var reset domain.PasswordReset
+	if err := i.DB.Raw("SELECT * FROM password_resets WHERE token_hash = ?", tokenHash).Scan(&reset).Error; err != nil {
+		return domain.PasswordReset{}, err
+	}
+
+	return reset, nil
+}
+
+// ResetPassword spends the reset link and sets the password together, of two requests with the same link only one gets through
+func (i *userDatabase) ResetPassword(resetID int, userID int, password string) error {
+
+	return i.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("UPDATE password_resets SET used = true WHERE id = $1 AND used = false", resetID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != 1 {
+			return errors.New("reset link already used")
+		}
+
+		// changing sessions_revoked_at logs the user out from every device
+		if err := tx.Exec("UPDATE users SET password = $1, sessions_revoked_at = $2 WHERE id = $3", password, time.Now(), userID).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (i *userDatabase) GetSessionsRevokedAt(id int) (time.Time, error) {
+
+	var revokedAt sql.NullTime
+	if err := i.DB.Raw("SELECT sessions_revoked_at FROM users WHERE id = ?", id).Scan(&revokedAt).Error; err != nil {
+		return time.Time{}, err
+	}
+
+	return revokedAt.Time, nil
+}
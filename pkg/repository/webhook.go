@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	DB *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) *webhookRepository {
+	return &webhookRepository{
+		DB: db,
+	}
+}
+
+const deliveryColumns = `SELECT id, webhook_endpoint_id AS webhook_id, event, outbox_event_id, replay_of_id, payload, status, attempts,
+	response_code, response_body, last_error, next_attempt_at, created_at, delivered_at
+	FROM webhook_deliveries`
+
+func (w *webhookRepository) AddWebhook(url, secret, events string) (int, error) {
+
+	var id int
+	err := w.DB.Raw("INSERT INTO webhook_endpoints (url,secret,events,active,created_at) VALUES ($1,$2,$3,true,$4) RETURNING id",
+		url, secret, events, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (w *webhookRepository) GetWebhooks() ([]models.WebhookDetails, error) {
+
+	var webhooks []models.WebhookDetails
+	if err := w.DB.Raw("SELECT id, url, events, active, created_at FROM webhook_endpoints ORDER BY id").Scan(&webhooks).Error; err != nil {
+		return []models.WebhookDetails{}, err
+	}
+
+	return webhooks, nil
+}
+
+func (w *webhookRepository) GetWebhook(id int) (domain.WebhookEndpoint, error) {
+
+	var webhook domain.WebhookEndpoint
+	if err := w.DB.Raw("SELECT * FROM webhook_endpoints WHERE id = $1", id).Scan(&webhook).Error; err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook takes its delivery log with it
+func (w *webhookRepository) DeleteWebhook(id int) (bool, error) {
+
+	result := w.DB.Exec("DELETE FROM webhook_endpoints WHERE id = $1", id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// AddEventDeliveries queues the event for every active endpoint that listens to it. An event the
+// outbox hands over again is not queued twice
+func (w *webhookRepository) AddEventDeliveries(eventID int, event string, payload string) error {
+
+	err := w.DB.Exec(`INSERT INTO webhook_deliveries (webhook_endpoint_id,event,outbox_event_id,payload,status,attempts,next_attempt_at,created_at)
+	SELECT webhook_endpoints.id, $1, $2, $3, 'PENDING', 0, $4, $4
+	FROM webhook_endpoints
+	WHERE webhook_endpoints.active = true AND ',' || webhook_endpoints.events || ',' LIKE '%,' || $1 || ',%'
+	AND NOT EXISTS (SELECT 1 FROM webhook_deliveries WHERE webhook_deliveries.webhook_endpoint_id = webhook_endpoints.id
+		AND webhook_deliveries.outbox_event_id = $2 AND webhook_deliveries.replay_of_id IS NULL)`,
+		event, eventID, payload, time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddDelivery is for pings and replays, next is when the worker may pick it up
+func (w *webhookRepository) AddDelivery(webhookID int, event string, payload string, replayOf *int, next time.Time) (int, error) {
+
+	var id int
+	err := w.DB.Raw(`INSERT INTO webhook_deliveries (webhook_endpoint_id,event,replay_of_id,payload,status,attempts,next_attempt_at,created_at)
+	VALUES ($1,$2,$3,$4,'PENDING',0,$5,$6) RETURNING id`, webhookID, event, replayOf, payload, next, time.Now()).Scan(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (w *webhookRepository) GetDelivery(id int) (models.WebhookDeliveryDetails, error) {
+
+	var delivery models.WebhookDeliveryDetails
+	if err := w.DB.Raw(deliveryColumns+" WHERE id = $1", id).Scan(&delivery).Error; err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	return delivery, nil
+}
+
+// GetDeliveries gives the log of an endpoint 20 at a time, latest first
+func (w *webhookRepository) GetDeliveries(webhookID, page int) ([]models.WebhookDeliveryDetails, error) {
+
+	if page == 0 {
+		page = 1
+	}
+	offset := (page - 1) * 20
+
+	var deliveries []models.WebhookDeliveryDetails
+	err := w.DB.Raw(deliveryColumns+" WHERE webhook_endpoint_id = $1 ORDER BY id DESC LIMIT 20 OFFSET $2", webhookID, offset).Scan(&deliveries).Error
+	if err != nil {
+		return []models.WebhookDeliveryDetails{}, err
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueDeliveries works like the outbox, the lease keeps a second worker off the claimed deliveries
+func (w *webhookRepository) ClaimDueDeliveries(limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+
+	var deliveries []models.WebhookDelivery
+	now := time.Now()
+	err := w.DB.Raw(`UPDATE webhook_deliveries SET next_attempt_at = $1
+	FROM webhook_endpoints
+	WHERE webhook_endpoints.id = webhook_deliveries.webhook_endpoint_id
+	AND webhook_deliveries.id IN (SELECT id FROM webhook_deliveries WHERE status = 'PENDING' AND next_attempt_at <= $2
+		ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED)
+	RETURNING webhook_deliveries.id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.attempts,
+	webhook_endpoints.url, webhook_endpoints.secret`, now.Add(lease), now, limit).Scan(&deliveries).Error
+	if err != nil {
+		return []models.WebhookDelivery{}, err
+	}
+
+	return deliveries, nil
+}
+
+func (w *webhookRepository) MarkDelivered(id int, result models.WebhookResult) error {
+
+	err := w.DB.Exec(`UPDATE webhook_deliveries SET status = 'DELIVERED', attempts = attempts + 1, response_code = $1,
+	response_body = $2, last_error = '', delivered_at = $3 WHERE id = $4`, result.StatusCode, result.Body, time.Now(), id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *webhookRepository) RetryDelivery(id int, result models.WebhookResult, lastError string, next time.Time) error {
+
+	err := w.DB.Exec(`UPDATE webhook_deliveries SET attempts = attempts + 1, response_code = $1, response_body = $2,
+	last_error = $3, next_attempt_at = $4 WHERE id = $5`, result.StatusCode, result.Body, lastError, next, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *webhookRepository) FailDelivery(id int, result models.WebhookResult, lastError string) error {
+
+	err := w.DB.Exec(`UPDATE webhook_deliveries SET status = 'FAILED', attempts = attempts + 1, response_code = $1,
+	response_body = $2, last_error = $3 WHERE id = $4`, result.StatusCode, result.Body, lastError, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
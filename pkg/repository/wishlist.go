@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"errors"
 	"jerseyhub/pkg/utils/models"
 
 	"gorm.io/gorm"
@@ -19,8 +20,8 @@ func NewWishlistRepository(db *gorm.DB) *wishlistRepository {
 func (w *wishlistRepository) AddToWishlist(userID, inventoryID int) error {
 
 	err := w.DB.Exec(`
-		INSERT INTO wishlists (user_id,inventory_id)
-		VALUES ($1,$2)`, userID, inventoryID).Error
+		INSERT INTO wishlists (user_id,inventory_id,added_price)
+		SELECT $1, $2, `+offerPrice+` FROM inventories WHERE inventories.id = $2`, userID, inventoryID).Error
 	if err != nil {
 		return err
 	}
@@ -39,8 +40,8 @@ func (w *wishlistRepository) RemoveFromWishlist(invID, userID int) error {
 
 }
 
-func (w *wishlistRepository) GetWishList(id int) ([]models.Inventories, error) {
-	var productDetails []models.Inventories
+func (w *wishlistRepository) GetWishList(id int) ([]models.WishlistItem, error) {
+	var productDetails []models.WishlistItem
 
 	query := `
         SELECT inventories.id,
@@ -49,10 +50,14 @@ func (w *wishlistRepository) GetWishList(id int) ([]models.Inventories, error) {
                inventories.image,
                inventories.size,
                inventories.stock,
-               inventories.price
+               inventories.price,
+               wishlists.added_price AS saved_price,
+               COALESCE((SELECT SUM(line_items.quantity) FROM line_items JOIN carts ON carts.id = line_items.cart_id
+                   WHERE carts.user_id = wishlists.user_id AND line_items.inventory_id = inventories.id),0) AS cart_quantity
         FROM inventories
         JOIN wishlists ON wishlists.inventory_id = inventories.id
         WHERE wishlists.user_id = ? AND wishlists.is_deleted = false
+        ORDER BY wishlists.id
     `
 
 	if err := w.DB.Raw(query, id).Scan(&productDetails).Error; err != nil {
@@ -98,3 +103,74 @@ func (w *wishlistRepository) CheckIfTheItemIsPresentAtWishlist(userID, productID
 	return result > 0, nil
 
 }
+
+// MoveToWishlist takes the product out of the cart of the user and saves it, all or nothing
+func (w *wishlistRepository) MoveToWishlist(userID, inventoryID int) error {
+
+	return w.DB.Transaction(func(tx *gorm.DB) error {
+
+		result := tx.Exec(`DELETE FROM line_items USING carts
+		WHERE carts.id = line_items.cart_id AND carts.user_id = $1 AND line_items.inventory_id = $2`, userID, inventoryID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.New("product is not in the cart")
+		}
+
+		// a product already in the wishlist keeps its saved price
+		return tx.Exec(`INSERT INTO wishlists (user_id,inventory_id,added_price)
+		SELECT $1, $2, `+offerPrice+` FROM inventories WHERE inventories.id = $2
+		AND NOT EXISTS (SELECT 1 FROM wishlists WHERE user_id = $1 AND inventory_id = $2 AND is_deleted = false)`, userID, inventoryID).Error
+	})
+}
+
+// MoveToCart takes the product out of the wishlist of the user and puts one in the cart, all or nothing.
+// A product already in the cart is left as it is
+func (w *wishlistRepository) MoveToCart(userID, inventoryID int) error {
+
+	return w.DB.Transaction(func(tx *gorm.DB) error {
+
+		result := tx.Exec("UPDATE wishlists SET is_deleted = true WHERE user_id = $1 AND inventory_id = $2 AND is_deleted = false", userID, inventoryID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return errors.New("product is not in the wishlist")
+		}
+
+		var cartID int
+		if err := tx.Raw("SELECT id FROM carts WHERE user_id = $1", userID).Scan(&cartID).Error; err != nil {
+			return err
+		}
+
+		if cartID == 0 {
+			if err := tx.Raw("INSERT INTO carts (user_id) VALUES ($1) RETURNING id", userID).Scan(&cartID).Error; err != nil {
+				return err
+			}
+		}
+
+		var inCart int
+		if err := tx.Raw("SELECT COUNT(*) FROM line_items WHERE cart_id = $1 AND inventory_id = $2", cartID, inventoryID).Scan(&inCart).Error; err != nil {
+			return err
+		}
+
+		if inCart > 0 {
+			return nil
+		}
+
+		var stock int
+		if err := tx.Raw("SELECT stock FROM inventories WHERE id = $1", inventoryID).Scan(&stock).Error; err != nil {
+			return err
+		}
+
+		if stock <= 0 {
+			return errors.New("out of stock")
+		}
+
+		return tx.Exec(`INSERT INTO line_items (cart_id,inventory_id,added_price,added_discount)
+		SELECT $1, $2, inventories.price, `+offerDiscount+` FROM inventories WHERE inventories.id = $2`, cartID, inventoryID).Error
+	})
+}
@@ -14,9 +14,27 @@ func AdminRoutes(engine *gin.RouterGroup,
 	categoryHandler *handler.CategoryHandler,
 	orderHandler *handler.OrderHandler,
 	couponHandler *handler.CouponHandler,
-	offerHandler *handler.OfferHandler) {
+	offerHandler *handler.OfferHandler,
+	shipmentHandler *handler.ShipmentHandler,
+	shippingHandler *handler.ShippingHandler,
+	invoiceHandler *handler.InvoiceHandler,
+	returnHandler *handler.ReturnHandler,
+	reviewHandler *handler.ReviewHandler,
+	questionHandler *handler.QuestionHandler,
+	webhookHandler *handler.WebhookHandler,
+	jobHandler *handler.JobHandler,
+	cartHandler *handler.CartHandler,
+	twoFactorParser middleware.TwoFactorTokenParser) {
 
 	engine.POST("/adminlogin", adminHandler.LoginHandler)
+
+	// reachable with the two factor token given by adminlogin
+	twofactor := engine.Group("", middleware.AdminTwoFactorMiddleware(twoFactorParser))
+	{
+		twofactor.POST("/adminlogin/2fa", adminHandler.VerifyTwoFactorLogin)
+		twofactor.POST("/2fa/enroll", adminHandler.EnrollTwoFactor)
+		twofactor.POST("/2fa/confirm", adminHandler.ConfirmTwoFactor)
+	}
 	// api := router.Group("/admin_panel", middleware.AuthorizationMiddleware)
 	// api.GET("users", adminHandler.GetUsers)
 
@@ -29,12 +47,22 @@ func AdminRoutes(engine *gin.RouterGroup,
 			usermanagement.PUT("/unblock", adminHandler.UnBlockUser)
 		}
 
+		security := engine.Group("/2fa")
+		{
+			security.POST("/recovery-codes", adminHandler.RegenerateRecoveryCodes)
+			security.DELETE("", adminHandler.DisableTwoFactor)
+			security.GET("/policy", adminHandler.GetTwoFactorPolicy)
+			security.PUT("/policy", adminHandler.UpdateTwoFactorPolicy)
+		}
+
 		categorymanagement := engine.Group("/category")
 		{
 			categorymanagement.GET("", categoryHandler.GetCategory)
 			categorymanagement.POST("", categoryHandler.AddCategory)
 			categorymanagement.PUT("", categoryHandler.UpdateCategory)
 			categorymanagement.DELETE("", categoryHandler.DeleteCategory)
+			categorymanagement.GET("/tax", invoiceHandler.GetCategoryTaxes)
+			categorymanagement.PUT("/tax", invoiceHandler.SetCategoryTax)
 		}
 
 		inventorymanagement := engine.Group("/inventories")
@@ -62,6 +90,79 @@ func AdminRoutes(engine *gin.RouterGroup,
 			orders.PUT("/payment-status", orderHandler.MakePaymentStatusAsPaid)
 			orders.GET("", orderHandler.AdminOrders)
 			orders.GET("/:id", orderHandler.GetIndividualOrderDetails)
+			orders.POST("/:id/shipments", shipmentHandler.CreateShipment)
+			orders.GET("/:id/shipments", shipmentHandler.GetShipments)
+			orders.GET("/:id/invoice", invoiceHandler.AdminDownloadInvoice)
+		}
+
+		returns := engine.Group("/returns")
+		{
+			returns.GET("", returnHandler.AdminGetReturns)
+			returns.GET("/:id", returnHandler.AdminGetReturn)
+			returns.PUT("/:id/approve", returnHandler.ApproveReturn)
+			returns.PUT("/:id/reject", returnHandler.RejectReturn)
+			returns.PUT("/:id/pickup", returnHandler.SchedulePickup)
+			returns.PUT("/:id/receive", returnHandler.ReceiveReturn)
+			returns.PUT("/:id/inspect", returnHandler.InspectReturn)
+		}
+
+		reviews := engine.Group("/reviews")
+		{
+			reviews.GET("", reviewHandler.AdminGetReviews)
+			reviews.PUT("/:id/approve", reviewHandler.ApproveReview)
+			reviews.PUT("/:id/hide", reviewHandler.HideReview)
+			reviews.PUT("/:id/reply", reviewHandler.ReplyToReview)
+		}
+
+		questions := engine.Group("/questions")
+		{
+			questions.GET("/unanswered", questionHandler.GetUnansweredQuestions)
+			questions.POST("/:id/answers", questionHandler.AdminAnswerQuestion)
+			questions.DELETE("/:id", questionHandler.DeleteQuestion)
+			questions.DELETE("/answers/:id", questionHandler.DeleteAnswer)
+		}
+
+		webhooks := engine.Group("/webhooks")
+		{
+			webhooks.GET("", webhookHandler.GetWebhooks)
+			webhooks.POST("", webhookHandler.RegisterWebhook)
+			webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+			webhooks.POST("/:id/ping", webhookHandler.Ping)
+			webhooks.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+			webhooks.POST("/deliveries/:id/replay", webhookHandler.ReplayDelivery)
+		}
+
+		jobs := engine.Group("/jobs")
+		{
+			jobs.GET("", jobHandler.GetJobs)
+			jobs.GET("/status", jobHandler.GetJobStatus)
+			jobs.POST("/:id/retry", jobHandler.RetryJob)
+		}
+
+		carts := engine.Group("/carts")
+		{
+			carts.GET("/abandoned", cartHandler.GetAbandonedCarts)
+		}
+
+		shipping := engine.Group("/shipping-zones")
+		{
+			shipping.GET("", shippingHandler.GetShippingZones)
+			shipping.POST("", shippingHandler.AddShippingZone)
+			shipping.PUT("/:id", shippingHandler.UpdateShippingZone)
+			shipping.DELETE("/:id", shippingHandler.DeleteShippingZone)
+		}
+
+		serviceability := engine.Group("/serviceability")
+		{
+			serviceability.GET("", shippingHandler.GetServiceablePins)
+			serviceability.POST("/import", shippingHandler.ImportServiceablePins)
+			serviceability.DELETE("/:pin", shippingHandler.DeleteServiceablePin)
+		}
+
+		shipments := engine.Group("/shipments")
+		{
+			shipments.POST("/:id/events", shipmentHandler.AddTrackingEvent)
+			shipments.POST("/:id/refresh", shipmentHandler.RefreshTracking)
 		}
 
 		coupons := engine.Group("/coupons")
@@ -16,12 +16,28 @@ func UserRoutes(engine *gin.RouterGroup,
 	paymentHandler *handler.PaymentHandler,
 	wishlisthandler *handler.WishlistHandler,
 	categoryHandler *handler.CategoryHandler,
-	couponHandler *handler.CouponHandler) {
+	couponHandler *handler.CouponHandler,
+	emailHandler *handler.EmailHandler,
+	identityHandler *handler.IdentityHandler,
+	invoiceHandler *handler.InvoiceHandler,
+	shippingHandler *handler.ShippingHandler,
+	returnHandler *handler.ReturnHandler,
+	reviewHandler *handler.ReviewHandler,
+	questionHandler *handler.QuestionHandler,
+	alertHandler *handler.AlertHandler,
+	notificationHandler *handler.NotificationHandler,
+	sessionChecker middleware.SessionChecker) {
 
 	engine.POST("/signup", userHandler.UserSignUp)
 	engine.POST("/login", userHandler.LoginHandler)
+	engine.GET("/login/google", identityHandler.GoogleLogin)
+	engine.GET("/login/google/callback", identityHandler.GoogleCallback)
 	engine.GET("/forgot-password", userHandler.ForgotPasswordSend)
 	engine.POST("/forgot-password", userHandler.ForgotPasswordVerifyAndChange)
+	engine.POST("/forgot-password/email", userHandler.ForgotPasswordEmailSend)
+	engine.GET("/reset-password", userHandler.ResetPasswordPage)
+	engine.POST("/reset-password", userHandler.ResetPasswordWithToken)
+	engine.GET("/verify-email", emailHandler.VerifyEmail)
 
 	engine.POST("/otplogin", otpHandler.SendOTP)
 	engine.POST("/verifyotp", otpHandler.VerifyOTP)
@@ -32,7 +48,14 @@ func UserRoutes(engine *gin.RouterGroup,
 		payment.GET("/update_status", paymentHandler.VerifyPayment)
 	}
 
-	engine.Use(middleware.UserAuthMiddleware)
+	guestCart := engine.Group("/guest-cart")
+	{
+		guestCart.GET("", cartHandler.GetGuestCart)
+		guestCart.POST("/items", cartHandler.AddToGuestCart)
+		guestCart.DELETE("/items/:id", cartHandler.RemoveFromGuestCart)
+	}
+
+	engine.Use(middleware.UserAuthMiddleware(sessionChecker))
 	{
 
 		engine.GET("/banners", categoryHandler.GetBannersForUsers)
@@ -46,11 +69,49 @@ func UserRoutes(engine *gin.RouterGroup,
 		{
 			home.GET("/products", inventoryHandler.ListProductsForUser)
 			home.GET("/products/details", inventoryHandler.ShowIndividualProducts)
+			home.GET("/products/delivery", shippingHandler.CheckServiceability)
+			home.GET("/products/reviews", reviewHandler.GetProductReviews)
 			home.POST("/add-to-cart", cartHandler.AddToCart)
 			home.POST("/wishlist/add", wishlisthandler.AddToWishlist)
 
 		}
 
+		reviews := engine.Group("/reviews")
+		{
+			reviews.GET("", reviewHandler.GetMyReviews)
+			reviews.POST("", reviewHandler.AddReview)
+			reviews.PUT("/:id", reviewHandler.EditReview)
+			reviews.DELETE("/:id", reviewHandler.DeleteReview)
+			reviews.POST("/:id/images", reviewHandler.AddReviewImage)
+			reviews.POST("/:id/helpful", reviewHandler.VoteHelpful)
+			reviews.DELETE("/:id/helpful", reviewHandler.RemoveHelpfulVote)
+		}
+
+		questions := engine.Group("/questions")
+		{
+			questions.POST("", questionHandler.AskQuestion)
+			questions.POST("/:id/answers", questionHandler.AnswerQuestion)
+			questions.POST("/answers/:id/upvote", questionHandler.UpvoteAnswer)
+			questions.DELETE("/answers/:id/upvote", questionHandler.RemoveAnswerUpvote)
+		}
+
+		alerts := engine.Group("/alerts")
+		{
+			alerts.GET("", alertHandler.GetAlerts)
+			alerts.POST("", alertHandler.Subscribe)
+			alerts.DELETE("/:id", alertHandler.Unsubscribe)
+		}
+
+		notifications := engine.Group("/notifications")
+		{
+			notifications.GET("", notificationHandler.GetNotifications)
+			notifications.GET("/unread-count", notificationHandler.GetUnreadCount)
+			notifications.PUT("/read-all", notificationHandler.MarkAllRead)
+			notifications.PUT("/:id/read", notificationHandler.MarkRead)
+			notifications.GET("/preferences", notificationHandler.GetPreferences)
+			notifications.PUT("/preferences", notificationHandler.SetPreference)
+		}
+
 		categorymanagement := engine.Group("/category")
 		{
 			categorymanagement.GET("", categoryHandler.GetCategory)
@@ -63,14 +124,29 @@ func UserRoutes(engine *gin.RouterGroup,
 			profile.GET("/details", userHandler.GetUserDetails)
 			profile.GET("/address", userHandler.GetAddresses)
 			profile.POST("/address", userHandler.AddAddress)
+			profile.PUT("/address/:id", userHandler.EditAddress)
+			profile.DELETE("/address/:id", userHandler.DeleteAddress)
+			profile.PUT("/address/:id/default", userHandler.SetDefaultAddress)
 			profile.GET("/reference-link", userHandler.GetMyReferenceLink)
+			profile.POST("/verify-email", emailHandler.ResendVerificationEmail)
 
 			orders := profile.Group("/orders")
 			{
 				orders.GET("", orderHandler.GetOrders)
 				orders.GET("/:id", orderHandler.GetIndividualOrderDetails)
+				orders.GET("/:id/invoice", invoiceHandler.DownloadInvoice)
 				orders.DELETE("", orderHandler.CancelOrder)
-				orders.PUT("/return", orderHandler.ReturnOrder)
+				orders.POST("/:id/cancel-items", orderHandler.CancelOrderItems)
+				orders.POST("/:id/retry-payment", paymentHandler.RetryPayment)
+				orders.POST("/:id/returns", returnHandler.RequestReturn)
+				orders.POST("/:id/exchanges", returnHandler.RequestExchange)
+			}
+
+			returns := profile.Group("/returns")
+			{
+				returns.GET("", returnHandler.GetReturns)
+				returns.GET("/:id", returnHandler.GetReturn)
+				returns.POST("/:id/images", returnHandler.AddReturnImage)
 			}
 
 			edit := profile.Group("/edit")
@@ -88,10 +164,13 @@ func UserRoutes(engine *gin.RouterGroup,
 
 		cart := engine.Group("/cart")
 		{
-			cart.GET("/", userHandler.GetCart)
+			cart.GET("/", cartHandler.GetCart)
 			cart.DELETE("/remove", userHandler.RemoveFromCart)
-			cart.PUT("/updateQuantity/plus", userHandler.UpdateQuantityAdd)
-			cart.PUT("/updateQuantity/minus", userHandler.UpdateQuantityLess)
+			cart.PUT("/updateQuantity/plus", cartHandler.UpdateQuantityAdd)
+			cart.PUT("/updateQuantity/minus", cartHandler.UpdateQuantityLess)
+			cart.PUT("/items/:id", cartHandler.SetQuantity)
+			cart.POST("/items/:id/move-to-wishlist", wishlisthandler.MoveToWishlist)
+			cart.POST("/merge", cartHandler.MergeGuestCart)
 			// hello
 		}
 
@@ -99,6 +178,7 @@ func UserRoutes(engine *gin.RouterGroup,
 		{
 			wishlist.GET("/", wishlisthandler.GetWishList)
 			wishlist.DELETE("/remove", wishlisthandler.RemoveFromWishlist)
+			wishlist.POST("/:id/move-to-cart", wishlisthandler.MoveToCart)
 		}
 
 		checkout := engine.Group("/check-out")
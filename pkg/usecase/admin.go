@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"errors"
+	"strings"
 
 	domain "jerseyhub/pkg/domain"
 	helper_interface "jerseyhub/pkg/helper/interface"
@@ -47,18 +48,52 @@ func (ad *adminUseCase) LoginHandler(adminDetails models.AdminLogin) (domain.Tok
 		return domain.TokenAdmin{}, err
 	}
 
-	access, refresh, err := ad.helper.GenerateTokenAdmin(adminDetailsResponse)
+	// the access token is only handed out once the second factor passes
+	if adminCompareDetails.TotpEnabled {
+		return ad.twoFactorPending(adminDetailsResponse, false)
+	}
+
+	policy, err := ad.adminRepository.GetAdminPolicy()
+	if err != nil {
+		return domain.TokenAdmin{}, err
+	}
+
+	if policy.TwoFactorRequired {
+		return ad.twoFactorPending(adminDetailsResponse, true)
+	}
+
+	return ad.tokenAdmin(adminDetailsResponse)
+
+}
+
+func (ad *adminUseCase) tokenAdmin(admin models.AdminDetailsResponse) (domain.TokenAdmin, error) {
+
+	access, refresh, err := ad.helper.GenerateTokenAdmin(admin)
 
 	if err != nil {
 		return domain.TokenAdmin{}, err
 	}
 
 	return domain.TokenAdmin{
-		Admin:        adminDetailsResponse,
+		Admin:        admin,
 		AccessToken:  access,
 		RefreshToken: refresh,
 	}, nil
+}
+
+func (ad *adminUseCase) twoFactorPending(admin models.AdminDetailsResponse, enroll bool) (domain.TokenAdmin, error) {
+
+	token, err := ad.helper.GenerateTokenAdminTwoFactor(admin)
+	if err != nil {
+		return domain.TokenAdmin{}, err
+	}
 
+	return domain.TokenAdmin{
+		Admin:              admin,
+		TwoFactorRequired:  !enroll,
+		EnrollmentRequired: enroll,
+		TwoFactorToken:     token,
+	}, nil
 }
 
 func (ad *adminUseCase) BlockUser(id string) error {
@@ -117,9 +152,9 @@ func (ad *adminUseCase) GetUsers(page int) ([]models.UserDetailsAtAdmin, error)
 
 }
 
-func (i *adminUseCase) NewPaymentMethod(id string) error {
+func (i *adminUseCase) NewPaymentMethod(method models.NewPaymentMethod) error {
 
-	exists, err := i.adminRepository.CheckIfPaymentMethodAlreadyExists(id)
+	exists, err := i.adminRepository.CheckIfPaymentMethodAlreadyExists(method.PaymentMethod)
 	if err != nil {
 		return err
 	}
@@ -128,7 +163,7 @@ func (i *adminUseCase) NewPaymentMethod(id string) error {
 		return errors.New("payment method already exists")
 	}
 
-	err = i.adminRepository.NewPaymentMethod(id)
+	err = i.adminRepository.NewPaymentMethod(method)
 	if err != nil {
 		return err
 	}
@@ -156,3 +191,212 @@ func (a *adminUseCase) DeletePaymentMethod(id int) error {
 	return nil
 
 }
+
+const recoveryCodeCount = 10
+
+func adminDetails(admin domain.Admin) models.AdminDetailsResponse {
+	return models.AdminDetailsResponse{
+		ID:    int(admin.ID),
+		Name:  admin.Name,
+		Email: admin.Username,
+	}
+}
+
+// checkSecondFactor accepts either a code from the authenticator app or one of the recovery codes
+func (ad *adminUseCase) checkSecondFactor(admin domain.Admin, code string) error {
+
+	code = strings.TrimSpace(code)
+
+	if step, ok := ad.helper.ValidateTOTP(admin.TotpSecret, code); ok {
+		updated, err := ad.adminRepository.UpdateTotpLastStep(int(admin.ID), step)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			return errors.New("code already used, wait for the next one")
+		}
+
+		return nil
+	}
+
+	used, err := ad.adminRepository.UseRecoveryCode(int(admin.ID), ad.helper.HashToken(strings.ToLower(code)))
+	if err != nil {
+		return err
+	}
+
+	if !used {
+		return errors.New("invalid two factor code")
+	}
+
+	return nil
+}
+
+func (ad *adminUseCase) hashRecoveryCodes() ([]string, []string, error) {
+
+	codes, err := ad.helper.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, ad.helper.HashToken(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func (ad *adminUseCase) VerifyTwoFactorLogin(adminID int, code string) (domain.TokenAdmin, error) {
+
+	admin, err := ad.adminRepository.GetAdminByID(adminID)
+	if err != nil {
+		return domain.TokenAdmin{}, err
+	}
+
+	if !admin.TotpEnabled {
+		return domain.TokenAdmin{}, errors.New("two factor authentication is not enabled")
+	}
+
+	if err := ad.checkSecondFactor(admin, code); err != nil {
+		return domain.TokenAdmin{}, err
+	}
+
+	return ad.tokenAdmin(adminDetails(admin))
+}
+
+func (ad *adminUseCase) EnrollTwoFactor(adminID int) (models.TwoFactorEnrollment, error) {
+
+	admin, err := ad.adminRepository.GetAdminByID(adminID)
+	if err != nil {
+		return models.TwoFactorEnrollment{}, err
+	}
+
+	// enrolling again would let someone holding only the password replace the authenticator
+	if admin.TotpEnabled {
+		return models.TwoFactorEnrollment{}, errors.New("two factor authentication already enabled")
+	}
+
+	secret, err := ad.helper.GenerateTOTPSecret()
+	if err != nil {
+		return models.TwoFactorEnrollment{}, err
+	}
+
+	if err := ad.adminRepository.SetTotpSecret(adminID, secret); err != nil {
+		return models.TwoFactorEnrollment{}, err
+	}
+
+	return models.TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: ad.helper.TOTPProvisioningURI(secret, admin.Username),
+	}, nil
+}
+
+func (ad *adminUseCase) ConfirmTwoFactor(adminID int, code string) (domain.TwoFactorEnabled, error) {
+
+	admin, err := ad.adminRepository.GetAdminByID(adminID)
+	if err != nil {
+		return domain.TwoFactorEnabled{}, err
+	}
+
+	if admin.TotpEnabled {
+		return domain.TwoFactorEnabled{}, errors.New("two factor authentication already enabled")
+	}
+
+	if admin.TotpSecret == "" {
+		return domain.TwoFactorEnabled{}, errors.New("start the enrollment first")
+	}
+
+	step, ok := ad.helper.ValidateTOTP(admin.TotpSecret, strings.TrimSpace(code))
+	if !ok {
+		return domain.TwoFactorEnabled{}, errors.New("invalid two factor code")
+	}
+
+	codes, hashes, err := ad.hashRecoveryCodes()
+	if err != nil {
+		return domain.TwoFactorEnabled{}, err
+	}
+
+	if err := ad.adminRepository.EnableTwoFactor(adminID, step, hashes); err != nil {
+		return domain.TwoFactorEnabled{}, err
+	}
+
+	token, err := ad.tokenAdmin(adminDetails(admin))
+	if err != nil {
+		return domain.TwoFactorEnabled{}, err
+	}
+
+	return domain.TwoFactorEnabled{
+		RecoveryCodes: codes,
+		Token:         token,
+	}, nil
+}
+
+func (ad *adminUseCase) RegenerateRecoveryCodes(adminID int, code string) ([]string, error) {
+
+	admin, err := ad.adminRepository.GetAdminByID(adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !admin.TotpEnabled {
+		return nil, errors.New("two factor authentication is not enabled")
+	}
+
+	if err := ad.checkSecondFactor(admin, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := ad.hashRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ad.adminRepository.ReplaceRecoveryCodes(adminID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (ad *adminUseCase) DisableTwoFactor(adminID int, code string) error {
+
+	admin, err := ad.adminRepository.GetAdminByID(adminID)
+	if err != nil {
+		return err
+	}
+
+	if !admin.TotpEnabled {
+		return errors.New("two factor authentication is not enabled")
+	}
+
+	policy, err := ad.adminRepository.GetAdminPolicy()
+	if err != nil {
+		return err
+	}
+
+	if policy.TwoFactorRequired {
+		return errors.New("two factor authentication is mandatory for admins")
+	}
+
+	if err := ad.checkSecondFactor(admin, code); err != nil {
+		return err
+	}
+
+	return ad.adminRepository.DisableTwoFactor(adminID)
+}
+
+func (ad *adminUseCase) GetTwoFactorPolicy() (models.TwoFactorPolicy, error) {
+
+	policy, err := ad.adminRepository.GetAdminPolicy()
+	if err != nil {
+		return models.TwoFactorPolicy{}, err
+	}
+
+	return models.TwoFactorPolicy{Required: policy.TwoFactorRequired}, nil
+}
+
+func (ad *adminUseCase) UpdateTwoFactorPolicy(policy models.TwoFactorPolicy) error {
+
+	return ad.adminRepository.UpdateTwoFactorPolicy(policy.Required)
+}
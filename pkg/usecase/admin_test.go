@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Test_LoginHandler_TwoFactor(t *testing.T) {
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("jersey1234"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	login := models.AdminLogin{Email: "admin@jerseyhub.com", Password: "jersey1234"}
+
+	testData := map[string]struct {
+		StubDetails    func(testMocks)
+		expectedOutput domain.TokenAdmin
+		expectedError  error
+	}{
+		"admin with two factor gets the pending token only": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().LoginHandler(login).Times(1).Return(domain.Admin{ID: 1, Name: "Admin", Password: string(hash), TotpEnabled: true}, nil),
+					mocks.helper.EXPECT().GenerateTokenAdminTwoFactor(gomock.Any()).Times(1).Return("pending", nil),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{Admin: models.AdminDetailsResponse{ID: 1, Name: "Admin"}, TwoFactorRequired: true, TwoFactorToken: "pending"},
+			expectedError:  nil,
+		},
+		"two factor is mandatory and the admin has not enrolled": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().LoginHandler(login).Times(1).Return(domain.Admin{ID: 1, Name: "Admin", Password: string(hash)}, nil),
+					mocks.adminRepo.EXPECT().GetAdminPolicy().Times(1).Return(domain.AdminPolicy{TwoFactorRequired: true}, nil),
+					mocks.helper.EXPECT().GenerateTokenAdminTwoFactor(gomock.Any()).Times(1).Return("pending", nil),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{Admin: models.AdminDetailsResponse{ID: 1, Name: "Admin"}, EnrollmentRequired: true, TwoFactorToken: "pending"},
+			expectedError:  nil,
+		},
+		"two factor is optional and the admin has not enrolled": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().LoginHandler(login).Times(1).Return(domain.Admin{ID: 1, Name: "Admin", Password: string(hash)}, nil),
+					mocks.adminRepo.EXPECT().GetAdminPolicy().Times(1).Return(domain.AdminPolicy{}, nil),
+					mocks.helper.EXPECT().GenerateTokenAdmin(gomock.Any()).Times(1).Return("access", "refresh", nil),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{Admin: models.AdminDetailsResponse{ID: 1, Name: "Admin"}, AccessToken: "access", RefreshToken: "refresh"},
+			expectedError:  nil,
+		},
+		"two factor is not set up": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().LoginHandler(login).Times(1).Return(domain.Admin{ID: 1, Name: "Admin", Password: string(hash), TotpEnabled: true}, nil),
+					mocks.helper.EXPECT().GenerateTokenAdminTwoFactor(gomock.Any()).Times(1).Return("", errors.New("two factor authentication is not set up")),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{},
+			expectedError:  errors.New("two factor authentication is not set up"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			adminUseCase := mocks.newAdminUseCase()
+			test.StubDetails(mocks)
+
+			token, err := adminUseCase.LoginHandler(login)
+			assert.Equal(t, test.expectedOutput, token)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_EnrollTwoFactor(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails    func(testMocks)
+		expectedOutput models.TwoFactorEnrollment
+		expectedError  error
+	}{
+		"new secret is kept until it is confirmed": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1, Username: "admin@jerseyhub.com"}, nil),
+					mocks.helper.EXPECT().GenerateTOTPSecret().Times(1).Return("JBSWY3DPEHPK3PXP", nil),
+					mocks.adminRepo.EXPECT().SetTotpSecret(1, "JBSWY3DPEHPK3PXP").Times(1).Return(nil),
+					mocks.helper.EXPECT().TOTPProvisioningURI("JBSWY3DPEHPK3PXP", "admin@jerseyhub.com").Times(1).Return("otpauth://totp/jerseyhub:admin"),
+				)
+			},
+			expectedOutput: models.TwoFactorEnrollment{Secret: "JBSWY3DPEHPK3PXP", ProvisioningURI: "otpauth://totp/jerseyhub:admin"},
+			expectedError:  nil,
+		},
+		"already enabled": {
+			StubDetails: func(mocks testMocks) {
+				mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1, TotpEnabled: true}, nil)
+			},
+			expectedOutput: models.TwoFactorEnrollment{},
+			expectedError:  errors.New("two factor authentication already enabled"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			adminUseCase := mocks.newAdminUseCase()
+			test.StubDetails(mocks)
+
+			enrollment, err := adminUseCase.EnrollTwoFactor(1)
+			assert.Equal(t, test.expectedOutput, enrollment)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ConfirmTwoFactor(t *testing.T) {
+
+	enrolling := domain.Admin{ID: 1, Name: "Admin", Username: "admin@jerseyhub.com", TotpSecret: "JBSWY3DPEHPK3PXP"}
+
+	testData := map[string]struct {
+		StubDetails    func(testMocks)
+		expectedOutput domain.TwoFactorEnabled
+		expectedError  error
+	}{
+		"right code enables it with recovery codes": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(enrolling, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(58000000), true),
+					mocks.helper.EXPECT().GenerateRecoveryCodes(10).Times(1).Return([]string{"aaaaa-11111", "bbbbb-22222"}, nil),
+					mocks.helper.EXPECT().HashToken("aaaaa-11111").Times(1).Return("hash-a"),
+					mocks.helper.EXPECT().HashToken("bbbbb-22222").Times(1).Return("hash-b"),
+					mocks.adminRepo.EXPECT().EnableTwoFactor(1, int64(58000000), []string{"hash-a", "hash-b"}).Times(1).Return(nil),
+					mocks.helper.EXPECT().GenerateTokenAdmin(models.AdminDetailsResponse{ID: 1, Name: "Admin", Email: "admin@jerseyhub.com"}).Times(1).Return("access", "refresh", nil),
+				)
+			},
+			expectedOutput: domain.TwoFactorEnabled{
+				RecoveryCodes: []string{"aaaaa-11111", "bbbbb-22222"},
+				Token:         domain.TokenAdmin{Admin: models.AdminDetailsResponse{ID: 1, Name: "Admin", Email: "admin@jerseyhub.com"}, AccessToken: "access", RefreshToken: "refresh"},
+			},
+			expectedError: nil,
+		},
+		"wrong code": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(enrolling, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(0), false),
+				)
+			},
+			expectedOutput: domain.TwoFactorEnabled{},
+			expectedError:  errors.New("invalid two factor code"),
+		},
+		"enrollment not started": {
+			StubDetails: func(mocks testMocks) {
+				mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1}, nil)
+			},
+			expectedOutput: domain.TwoFactorEnabled{},
+			expectedError:  errors.New("start the enrollment first"),
+		},
+		"already enabled": {
+			StubDetails: func(mocks testMocks) {
+				mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1, TotpSecret: "JBSWY3DPEHPK3PXP", TotpEnabled: true}, nil)
+			},
+			expectedOutput: domain.TwoFactorEnabled{},
+			expectedError:  errors.New("two factor authentication already enabled"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			adminUseCase := mocks.newAdminUseCase()
+			test.StubDetails(mocks)
+
+			enabled, err := adminUseCase.ConfirmTwoFactor(1, " 123456 ")
+			assert.Equal(t, test.expectedOutput, enabled)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_VerifyTwoFactorLogin(t *testing.T) {
+
+	admin := domain.Admin{ID: 1, Name: "Admin", Username: "admin@jerseyhub.com", TotpSecret: "JBSWY3DPEHPK3PXP", TotpEnabled: true}
+	token := domain.TokenAdmin{Admin: models.AdminDetailsResponse{ID: 1, Name: "Admin", Email: "admin@jerseyhub.com"}, AccessToken: "access", RefreshToken: "refresh"}
+
+	testData := map[string]struct {
+		code           string
+		StubDetails    func(testMocks)
+		expectedOutput domain.TokenAdmin
+		expectedError  error
+	}{
+		"code from the authenticator": {
+			code: "123456",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(58000001), true),
+					mocks.adminRepo.EXPECT().UpdateTotpLastStep(1, int64(58000001)).Times(1).Return(true, nil),
+					mocks.helper.EXPECT().GenerateTokenAdmin(token.Admin).Times(1).Return("access", "refresh", nil),
+				)
+			},
+			expectedOutput: token,
+			expectedError:  nil,
+		},
+		"code already used in its time step": {
+			code: "123456",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(58000001), true),
+					mocks.adminRepo.EXPECT().UpdateTotpLastStep(1, int64(58000001)).Times(1).Return(false, nil),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{},
+			expectedError:  errors.New("code already used, wait for the next one"),
+		},
+		"recovery code": {
+			code: "AAAAA-11111",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "AAAAA-11111").Times(1).Return(int64(0), false),
+					mocks.helper.EXPECT().HashToken("aaaaa-11111").Times(1).Return("hash-a"),
+					mocks.adminRepo.EXPECT().UseRecoveryCode(1, "hash-a").Times(1).Return(true, nil),
+					mocks.helper.EXPECT().GenerateTokenAdmin(token.Admin).Times(1).Return("access", "refresh", nil),
+				)
+			},
+			expectedOutput: token,
+			expectedError:  nil,
+		},
+		"wrong code": {
+			code: "654321",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "654321").Times(1).Return(int64(0), false),
+					mocks.helper.EXPECT().HashToken("654321").Times(1).Return("hash-x"),
+					mocks.adminRepo.EXPECT().UseRecoveryCode(1, "hash-x").Times(1).Return(false, nil),
+				)
+			},
+			expectedOutput: domain.TokenAdmin{},
+			expectedError:  errors.New("invalid two factor code"),
+		},
+		"two factor not enabled": {
+			code: "123456",
+			StubDetails: func(mocks testMocks) {
+				mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1}, nil)
+			},
+			expectedOutput: domain.TokenAdmin{},
+			expectedError:  errors.New("two factor authentication is not enabled"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			adminUseCase := mocks.newAdminUseCase()
+			test.StubDetails(mocks)
+
+			token, err := adminUseCase.VerifyTwoFactorLogin(1, test.code)
+			assert.Equal(t, test.expectedOutput, token)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_DisableTwoFactor(t *testing.T) {
+
+	admin := domain.Admin{ID: 1, TotpSecret: "JBSWY3DPEHPK3PXP", TotpEnabled: true}
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"disabled with a valid code": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.adminRepo.EXPECT().GetAdminPolicy().Times(1).Return(domain.AdminPolicy{}, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(58000002), true),
+					mocks.adminRepo.EXPECT().UpdateTotpLastStep(1, int64(58000002)).Times(1).Return(true, nil),
+					mocks.adminRepo.EXPECT().DisableTwoFactor(1).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"mandatory for admins": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.adminRepo.EXPECT().GetAdminPolicy().Times(1).Return(domain.AdminPolicy{TwoFactorRequired: true}, nil),
+				)
+			},
+			expectedError: errors.New("two factor authentication is mandatory for admins"),
+		},
+		"wrong code": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(admin, nil),
+					mocks.adminRepo.EXPECT().GetAdminPolicy().Times(1).Return(domain.AdminPolicy{}, nil),
+					mocks.helper.EXPECT().ValidateTOTP("JBSWY3DPEHPK3PXP", "123456").Times(1).Return(int64(0), false),
+					mocks.helper.EXPECT().HashToken("123456").Times(1).Return("hash-x"),
+					mocks.adminRepo.EXPECT().UseRecoveryCode(1, "hash-x").Times(1).Return(false, nil),
+				)
+			},
+			expectedError: errors.New("invalid two factor code"),
+		},
+		"not enabled": {
+			StubDetails: func(mocks testMocks) {
+				mocks.adminRepo.EXPECT().GetAdminByID(1).Times(1).Return(domain.Admin{ID: 1}, nil)
+			},
+			expectedError: errors.New("two factor authentication is not enabled"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			adminUseCase := mocks.newAdminUseCase()
+			test.StubDetails(mocks)
+
+			err := adminUseCase.DisableTwoFactor(1, "123456")
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
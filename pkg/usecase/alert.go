@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type alertUseCase struct {
+	repository          interfaces.AlertRepository
+	notificationUseCase services.NotificationUseCase
+}
+
+func NewAlertUseCase(repo interfaces.AlertRepository, notification services.NotificationUseCase, events services.EventUseCase) *alertUseCase {
+	a := &alertUseCase{
+		repository:          repo,
+		notificationUseCase: notification,
+	}
+
+	events.Subscribe(models.EventStockChanged, "alert.back_in_stock", a.stockChanged)
+
+	return a
+}
+
+func (a *alertUseCase) stockChanged(event models.Event) error {
+
+	var stock models.StockChangedEvent
+	if err := json.Unmarshal([]byte(event.Payload), &stock); err != nil {
+		return err
+	}
+
+	if stock.Change > 0 && stock.Stock > 0 {
+		a.ProductRestocked(stock.InventoryID)
+	}
+
+	return nil
+}
+
+func productLink(inventoryID int) string {
+	return fmt.Sprintf("/users/home/products/details?id=%d", inventoryID)
+}
+
+func (a *alertUseCase) Subscribe(userID int, alert models.AddProductAlert) (int, error) {
+
+	product, err := a.repository.GetAlertProduct(alert.InventoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.ID == 0 {
+		return 0, errors.New("product does not exist")
+	}
+
+	if alert.Kind == "BACK_IN_STOCK" && product.Stock > 0 {
+		return 0, errors.New("product is in stock")
+	}
+
+	return a.repository.AddAlert(userID, alert.InventoryID, alert.Kind, product.Price)
+}
+
+func (a *alertUseCase) Unsubscribe(userID, id int) error {
+
+	removed, err := a.repository.RemoveAlert(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return errors.New("alert does not exist")
+	}
+
+	return nil
+}
+
+func (a *alertUseCase) GetAlerts(userID int) ([]models.ProductAlertDetails, error) {
+	return a.repository.GetAlerts(userID)
+}
+
+// WatchWishlistItem subscribes to price drops on a wishlisted product, and to restocks when it is sold out
+func (a *alertUseCase) WatchWishlistItem(userID, inventoryID int) error {
+
+	product, err := a.repository.GetAlertProduct(inventoryID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.repository.AddAlert(userID, inventoryID, "PRICE_DROP", product.Price); err != nil {
+		return err
+	}
+
+	if product.Stock > 0 {
+		return nil
+	}
+
+	if _, err := a.repository.AddAlert(userID, inventoryID, "BACK_IN_STOCK", product.Price); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *alertUseCase) UnwatchWishlistItem(userID, inventoryID int) error {
+	return a.repository.RemoveProductAlerts(userID, inventoryID)
+}
+
+// ProductRestocked tells the users waiting on the product, a back in stock alert goes out only once
+func (a *alertUseCase) ProductRestocked(inventoryID int) {
+
+	alerts, err := a.repository.GetStockAlerts(inventoryID)
+	if err != nil {
+		fmt.Println("could not get stock alerts of product", inventoryID, ":", err)
+		return
+	}
+
+	for _, alert := range alerts {
+		sent, err := a.notificationUseCase.Notify(alert.UserID, models.Notification{
+			Category:  "PRODUCT_ALERT",
+			Title:     alert.ProductName + " is back in stock",
+			Message:   fmt.Sprintf("%s from your alerts is back in stock at %.2f, grab it before it is gone.", alert.ProductName, alert.Price),
+			Link:      productLink(alert.InventoryID),
+			DedupeKey: fmt.Sprintf("BACK_IN_STOCK:%d", alert.InventoryID),
+		})
+		if err != nil {
+			fmt.Println("could not notify user", alert.UserID, ":", err)
+			continue
+		}
+
+		// a skipped alert stays open for the next restock
+		if !sent {
+			continue
+		}
+
+		if err := a.repository.CloseAlert(alert.ID); err != nil {
+			fmt.Println("could not close alert", alert.ID, ":", err)
+		}
+	}
+}
+
+// PricesChanged looks for price drops on a product or on a whole category when an offer starts
+func (a *alertUseCase) PricesChanged(inventoryID, categoryID int) {
+
+	alerts, err := a.repository.GetPriceDropAlerts(inventoryID, categoryID)
+	if err != nil {
+		fmt.Println("could not get price alerts :", err)
+		return
+	}
+
+	for _, alert := range alerts {
+		sent, err := a.notificationUseCase.Notify(alert.UserID, models.Notification{
+			Category:  "PRODUCT_ALERT",
+			Title:     "Price drop on " + alert.ProductName,
+			Message:   fmt.Sprintf("%s now costs %.2f, down from %.2f.", alert.ProductName, alert.Price, alert.OldPrice),
+			Link:      productLink(alert.InventoryID),
+			DedupeKey: fmt.Sprintf("PRICE_DROP:%d:%.2f", alert.InventoryID, alert.Price),
+		})
+		if err != nil {
+			fmt.Println("could not notify user", alert.UserID, ":", err)
+			continue
+		}
+
+		// the old price is kept for a skipped alert so the drop is still reported next time
+		if !sent {
+			continue
+		}
+
+		if err := a.repository.MarkAlertNotified(alert.ID, alert.Price); err != nil {
+			fmt.Println("could not update alert", alert.ID, ":", err)
+		}
+	}
+}
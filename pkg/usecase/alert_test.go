@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SubscribeAlert(t *testing.T) {
+
+	testData := map[string]struct {
+		alert          models.AddProductAlert
+		StubDetails    func(testMocks)
+		expectedOutput int
+		expectedError  error
+	}{
+		"back in stock on a sold out product": {
+			alert: models.AddProductAlert{InventoryID: 3, Kind: "BACK_IN_STOCK"},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{ID: 3, Stock: 0, Price: 999}, nil),
+					mocks.alertRepo.EXPECT().AddAlert(1, 3, "BACK_IN_STOCK", 999.0).Times(1).Return(4, nil),
+				)
+			},
+			expectedOutput: 4,
+			expectedError:  nil,
+		},
+		"price drop on a product in stock": {
+			alert: models.AddProductAlert{InventoryID: 3, Kind: "PRICE_DROP"},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{ID: 3, Stock: 10, Price: 999}, nil),
+					mocks.alertRepo.EXPECT().AddAlert(1, 3, "PRICE_DROP", 999.0).Times(1).Return(5, nil),
+				)
+			},
+			expectedOutput: 5,
+			expectedError:  nil,
+		},
+		"back in stock on a product in stock": {
+			alert: models.AddProductAlert{InventoryID: 3, Kind: "BACK_IN_STOCK"},
+			StubDetails: func(mocks testMocks) {
+				mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{ID: 3, Stock: 10, Price: 999}, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("product is in stock"),
+		},
+		"product does not exist": {
+			alert: models.AddProductAlert{InventoryID: 3, Kind: "PRICE_DROP"},
+			StubDetails: func(mocks testMocks) {
+				mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{}, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("product does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			alertUseCase := mocks.newAlertUseCase()
+			test.StubDetails(mocks)
+
+			id, err := alertUseCase.Subscribe(1, test.alert)
+			assert.Equal(t, test.expectedOutput, id)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_UnsubscribeAlert(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"success": {
+			StubDetails: func(mocks testMocks) {
+				mocks.alertRepo.EXPECT().RemoveAlert(1, 4).Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		"alert of another user": {
+			StubDetails: func(mocks testMocks) {
+				mocks.alertRepo.EXPECT().RemoveAlert(1, 4).Times(1).Return(false, nil)
+			},
+			expectedError: errors.New("alert does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			alertUseCase := mocks.newAlertUseCase()
+			test.StubDetails(mocks)
+
+			err := alertUseCase.Unsubscribe(1, 4)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_WatchWishlistItem(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"in stock watches the price only": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{ID: 3, Stock: 10, Price: 999}, nil),
+					mocks.alertRepo.EXPECT().AddAlert(1, 3, "PRICE_DROP", 999.0).Times(1).Return(4, nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"sold out watches the restock too": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.alertRepo.EXPECT().GetAlertProduct(3).Times(1).Return(models.AlertProduct{ID: 3, Stock: 0, Price: 999}, nil),
+					mocks.alertRepo.EXPECT().AddAlert(1, 3, "PRICE_DROP", 999.0).Times(1).Return(4, nil),
+					mocks.alertRepo.EXPECT().AddAlert(1, 3, "BACK_IN_STOCK", 999.0).Times(1).Return(5, nil),
+				)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			alertUseCase := mocks.newAlertUseCase()
+			test.StubDetails(mocks)
+
+			err := alertUseCase.WatchWishlistItem(1, 3)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ProductRestocked(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	alertUseCase := mocks.newAlertUseCase()
+
+	alerts := []models.TriggeredAlert{
+		{ID: 4, UserID: 1, InventoryID: 3, ProductName: "Home Kit", Price: 999},
+		{ID: 5, UserID: 2, InventoryID: 3, ProductName: "Home Kit", Price: 999},
+		{ID: 6, UserID: 3, InventoryID: 3, ProductName: "Home Kit", Price: 999},
+	}
+
+	// the first alert goes out and is closed, the throttled one stays open and a failed one is skipped
+	gomock.InOrder(
+		mocks.alertRepo.EXPECT().GetStockAlerts(3).Times(1).Return(alerts, nil),
+		mocks.notification.EXPECT().Notify(1, gomock.Any()).Times(1).Return(true, nil),
+		mocks.alertRepo.EXPECT().CloseAlert(4).Times(1).Return(nil),
+		mocks.notification.EXPECT().Notify(2, gomock.Any()).Times(1).Return(false, nil),
+		mocks.notification.EXPECT().Notify(3, gomock.Any()).Times(1).Return(false, errors.New("error")),
+	)
+
+	alertUseCase.ProductRestocked(3)
+}
+
+func Test_PricesChanged(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	alertUseCase := mocks.newAlertUseCase()
+
+	alerts := []models.TriggeredAlert{
+		{ID: 4, UserID: 1, InventoryID: 3, ProductName: "Home Kit", OldPrice: 999, Price: 799},
+		{ID: 5, UserID: 2, InventoryID: 3, ProductName: "Home Kit", OldPrice: 899, Price: 799},
+	}
+
+	// a skipped alert keeps its old price so the drop is reported the next time
+	gomock.InOrder(
+		mocks.alertRepo.EXPECT().GetPriceDropAlerts(3, 0).Times(1).Return(alerts, nil),
+		mocks.notification.EXPECT().Notify(1, models.Notification{
+			Category:  "PRODUCT_ALERT",
+			Title:     "Price drop on Home Kit",
+			Message:   "Home Kit now costs 799.00, down from 999.00.",
+			Link:      "/users/home/products/details?id=3",
+			DedupeKey: "PRICE_DROP:3:799.00",
+		}).Times(1).Return(true, nil),
+		mocks.alertRepo.EXPECT().MarkAlertNotified(4, 799.0).Times(1).Return(nil),
+		mocks.notification.EXPECT().Notify(2, gomock.Any()).Times(1).Return(false, nil),
+	)
+
+	alertUseCase.PricesChanged(3, 0)
+}
+
+func Test_stockChanged(t *testing.T) {
+
+	testData := map[string]struct {
+		payload     string
+		StubDetails func(testMocks)
+	}{
+		"restock tells the waiting users": {
+			payload: `{"inventory_id":3,"change":5,"stock":5}`,
+			StubDetails: func(mocks testMocks) {
+				mocks.alertRepo.EXPECT().GetStockAlerts(3).Times(1).Return([]models.TriggeredAlert{}, nil)
+			},
+		},
+		"sale is ignored": {
+			payload:     `{"inventory_id":3,"change":-1,"stock":4}`,
+			StubDetails: func(mocks testMocks) {},
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			alertUseCase := mocks.newAlertUseCase()
+			test.StubDetails(mocks)
+
+			err := alertUseCase.stockChanged(models.Event{Name: models.EventStockChanged, Payload: test.payload})
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -1,24 +1,229 @@
 package usecase
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"jerseyhub/pkg/config"
+	helper_interface "jerseyhub/pkg/helper/interface"
 	interfaces "jerseyhub/pkg/repository/interface"
 	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultCartReminderHours = "4,24,72"
+	defaultMaxOrderQuantity  = 10
+	// an order placed this long after a reminder is still put down to it
+	cartAttributionWindow  = time.Hour * 24 * 7
+	recoveryCouponValidity = time.Hour * 72
+	// a guest cart nobody touched for this long is deleted, its token runs out with it
+	guestCartLifetime = time.Hour * 24 * 30
 )
 
 type cartUseCase struct {
 	repo                interfaces.CartRepository
 	inventoryRepository interfaces.InventoryRepository
 	userUseCase         services.UserUseCase
+	shippingUseCase     services.ShippingUseCase
+	notification        services.NotificationUseCase
+	couponRepository    interfaces.CouponRepository
+	helper              helper_interface.Helper
+	reminders           []time.Duration
+	recoveryDiscount    int
+	maxQuantity         int
 }
 
-func NewCartUseCase(repo interfaces.CartRepository, inventoryRepo interfaces.InventoryRepository, userUseCase services.UserUseCase) *cartUseCase {
-	return &cartUseCase{
+func NewCartUseCase(repo interfaces.CartRepository, inventoryRepo interfaces.InventoryRepository, userUseCase services.UserUseCase, shipping services.ShippingUseCase, notification services.NotificationUseCase, coupon interfaces.CouponRepository, h helper_interface.Helper, jobs services.JobUseCase, events services.EventUseCase, cfg config.Config) *cartUseCase {
+
+	reminders, err := parseReminderHours(cfg.CART_REMINDER_HOURS)
+	if err != nil {
+		fmt.Println("CART_REMINDER_HOURS is not right, using", defaultCartReminderHours, ":", err)
+		reminders, _ = parseReminderHours(defaultCartReminderHours)
+	}
+
+	c := &cartUseCase{
 		repo:                repo,
 		inventoryRepository: inventoryRepo,
 		userUseCase:         userUseCase,
+		shippingUseCase:     shipping,
+		notification:        notification,
+		couponRepository:    coupon,
+		helper:              h,
+		reminders:           reminders,
+		recoveryDiscount:    cfg.CART_RECOVERY_DISCOUNT,
+		maxQuantity:         maxOrderQuantity(cfg),
+	}
+
+	jobs.Register("carts.remind_abandoned", c.remindAbandonedCarts)
+	jobs.Schedule("*/15 * * * *", "carts.remind_abandoned")
+	jobs.Register("carts.expire_guest", c.expireGuestCarts)
+	jobs.Schedule("0 4 * * *", "carts.expire_guest")
+
+	events.Subscribe(models.EventOrderPlaced, "cart.recovery", c.orderPlaced)
+
+	return c
+}
+
+// maxOrderQuantity is the most pieces of a product a cart can hold and an order can have
+func maxOrderQuantity(cfg config.Config) int {
+
+	if cfg.MAX_ORDER_QUANTITY <= 0 {
+		return defaultMaxOrderQuantity
+	}
+
+	return cfg.MAX_ORDER_QUANTITY
+}
+
+// parseReminderHours reads a list like 4,24,72, the hours have to go up
+func parseReminderHours(value string) ([]time.Duration, error) {
+
+	if strings.TrimSpace(value) == "" {
+		value = defaultCartReminderHours
+	}
+
+	var reminders []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		hours, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+
+		reminder := time.Duration(hours) * time.Hour
+		if hours <= 0 || (len(reminders) > 0 && reminder <= reminders[len(reminders)-1]) {
+			return nil, errors.New("hours have to be above 0 and go up")
+		}
+
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, nil
+}
+
+func recoveryCouponCode() (string, error) {
+
+	code := make([]byte, 4)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+
+	return "BACK-" + strings.ToUpper(hex.EncodeToString(code)), nil
+}
+
+// remindAbandonedCarts sends each cart the next reminder that is due, touching the cart starts them over
+func (i *cartUseCase) remindAbandonedCarts(job models.Job) error {
+
+	now := time.Now()
+	carts, err := i.repo.GetAbandonedCarts(now.Add(-i.reminders[0]), 0)
+	if err != nil {
+		return err
+	}
+
+	for _, cart := range carts {
+		if cart.Reminders >= len(i.reminders) || now.Sub(cart.LastActivity) < i.reminders[cart.Reminders] {
+			continue
+		}
+
+		if err := i.remind(cart, cart.Reminders+1); err != nil {
+			fmt.Println("could not remind user", cart.UserID, "of their cart:", err)
+		}
+	}
+
+	return nil
+}
+
+func (i *cartUseCase) remind(cart models.AbandonedCart, step int) error {
+
+	reminder := models.CartReminder{CartID: cart.CartID, UserID: cart.UserID, Step: step, CartValue: cart.Value}
+	message := fmt.Sprintf("%d items worth %.2f are still waiting in your cart, check out before they sell out.", cart.Items, cart.Value)
+
+	// the coupon goes with the last reminder
+	if i.recoveryDiscount > 0 && step == len(i.reminders) {
+		code, err := recoveryCouponCode()
+		if err != nil {
+			return err
+		}
+
+		expiresAt := time.Now().Add(recoveryCouponValidity)
+		couponID, err := i.couponRepository.AddUserCoupon(code, i.recoveryDiscount, cart.UserID, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		reminder.CouponID = &couponID
+		reminder.Coupon = code
+		message += fmt.Sprintf(" Use coupon %s (coupon id %d) for %d%% off before %s.", code, couponID, i.recoveryDiscount, expiresAt.Format("02 Jan 15:04"))
+	}
+
+	sent, err := i.notification.Notify(cart.UserID, models.Notification{
+		Category:  "CART",
+		Title:     "You left something in your cart",
+		Message:   message,
+		Link:      "/users/cart/",
+		DedupeKey: fmt.Sprintf("CART_REMINDER:%d:%d:%d", cart.CartID, step, cart.LastActivity.Unix()),
+	})
+	if err != nil {
+		return err
+	}
+
+	// a user who switched cart reminders off still moves on a step, so they are not asked every run
+	reminder.Sent = sent
+	if !sent && reminder.CouponID != nil {
+		if err := i.couponRepository.MakeCouponInvalid(*reminder.CouponID); err != nil {
+			fmt.Println("could not withdraw coupon", *reminder.CouponID, ":", err)
+		}
+	}
+
+	return i.repo.AddCartReminder(reminder)
+}
+
+func (i *cartUseCase) orderPlaced(event models.Event) error {
+
+	var order models.OrderPlacedEvent
+	if err := json.Unmarshal([]byte(event.Payload), &order); err != nil {
+		return err
 	}
+
+	return i.repo.AttributeOrder(order.OrderID, cartAttributionWindow)
+}
+
+// GetAbandonedCarts gives the carts nobody touched for the hours along with how the reminders did over the last days
+func (i *cartUseCase) GetAbandonedCarts(idleHours, days, page int) (models.AbandonedCartReport, error) {
+
+	if idleHours <= 0 {
+		idleHours = int(i.reminders[0] / time.Hour)
+	}
+	if days <= 0 {
+		days = 30
+	}
+	if page == 0 {
+		page = 1
+	}
+
+	now := time.Now()
+	idleSince := now.Add(-time.Duration(idleHours) * time.Hour)
+
+	summary, err := i.repo.GetCartRecoverySummary(idleSince, now.AddDate(0, 0, -days))
+	if err != nil {
+		return models.AbandonedCartReport{}, err
+	}
+
+	if summary.RemindersSent > 0 {
+		summary.ConversionRate = math.Round(float64(summary.Recovered)*10000/float64(summary.RemindersSent)) / 100
+	}
+
+	carts, err := i.repo.GetAbandonedCarts(idleSince, page)
+	if err != nil {
+		return models.AbandonedCartReport{}, err
+	}
+
+	return models.AbandonedCartReport{Summary: summary, Carts: carts}, nil
 }
 
 func (i *cartUseCase) AddToCart(userID, inventoryID int) error {
@@ -65,7 +270,277 @@ func (i *cartUseCase) AddToCart(userID, inventoryID int) error {
 	return nil
 }
 
-func (i *cartUseCase) CheckOut(id int) (models.CheckOut, error) {
+func (i *cartUseCase) expireGuestCarts(job models.Job) error {
+
+	deleted, err := i.repo.DeleteStaleGuestCarts(time.Now().Add(-guestCartLifetime))
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		fmt.Println("deleted", deleted, "stale guest carts")
+	}
+
+	return nil
+}
+
+// AddToGuestCart adds to the cart in the token, a visitor without a usable token gets a new cart.
+// A fresh token comes back every time so the cart lives on as long as it is used
+func (i *cartUseCase) AddToGuestCart(token string, item models.AddToGuestCart) (models.GuestCart, error) {
+
+	quantity := item.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	stock, err := i.inventoryRepository.CheckStock(item.InventoryID)
+	if err != nil {
+		return models.GuestCart{}, err
+	}
+
+	if stock <= 0 {
+		return models.GuestCart{}, errors.New("out of stock")
+	}
+
+	var cartID int
+	if token != "" {
+		if id, err := i.helper.ParseTokenGuestCart(token); err == nil {
+			touched, err := i.repo.TouchGuestCart(id)
+			if err != nil {
+				return models.GuestCart{}, err
+			}
+			if touched {
+				cartID = id
+			}
+		}
+	}
+
+	var current int
+	if cartID != 0 {
+		current, err = i.repo.GetGuestCartQuantity(cartID, item.InventoryID)
+		if err != nil {
+			return models.GuestCart{}, err
+		}
+	}
+
+	if current+quantity > stock {
+		return models.GuestCart{}, fmt.Errorf("only %d left in stock", stock)
+	}
+
+	if current+quantity > i.maxQuantity {
+		return models.GuestCart{}, fmt.Errorf("at most %d of a product can be ordered", i.maxQuantity)
+	}
+
+	// a new cart is made along with its first item, so a rejected request leaves no empty cart behind
+	if cartID == 0 {
+		cartID, err = i.repo.CreateGuestCart(item.InventoryID, quantity)
+		if err != nil {
+			return models.GuestCart{}, errors.New("cannot create cart")
+		}
+	} else if err := i.repo.SetGuestCartItem(cartID, item.InventoryID, current+quantity); err != nil {
+		return models.GuestCart{}, errors.New("error in adding products")
+	}
+
+	return i.guestCart(cartID, "")
+}
+
+func (i *cartUseCase) GetGuestCart(token string) (models.GuestCart, error) {
+
+	cartID, err := i.guestCartID(token)
+	if err != nil {
+		return models.GuestCart{}, err
+	}
+
+	return i.guestCart(cartID, token)
+}
+
+func (i *cartUseCase) RemoveFromGuestCart(token string, inventoryID int) (models.GuestCart, error) {
+
+	cartID, err := i.guestCartID(token)
+	if err != nil {
+		return models.GuestCart{}, err
+	}
+
+	if err := i.repo.RemoveGuestCartItem(cartID, inventoryID); err != nil {
+		return models.GuestCart{}, err
+	}
+
+	if _, err := i.repo.TouchGuestCart(cartID); err != nil {
+		return models.GuestCart{}, err
+	}
+
+	return i.guestCart(cartID, "")
+}
+
+// MergeGuestCart takes the guest cart in the token into the cart of the user, the guest cart is gone after it
+func (i *cartUseCase) MergeGuestCart(userID int, token string) error {
+
+	guestCartID, err := i.guestCartID(token)
+	if err != nil {
+		return err
+	}
+
+	cartID, err := i.repo.GetCartId(userID)
+	if err != nil {
+		return errors.New("some error in geting user cart")
+	}
+
+	if cartID == 0 {
+		cartID, err = i.repo.CreateNewCart(userID)
+		if err != nil {
+			return errors.New("cannot create cart fro user")
+		}
+	}
+
+	return i.repo.MergeGuestCart(guestCartID, cartID, i.maxQuantity)
+}
+
+func (i *cartUseCase) guestCartID(token string) (int, error) {
+
+	if token == "" {
+		return 0, errors.New("cart token is missing")
+	}
+
+	return i.helper.ParseTokenGuestCart(token)
+}
+
+// guestCart is the cart with its totals, an empty token gets a freshly signed one
+func (i *cartUseCase) guestCart(cartID int, token string) (models.GuestCart, error) {
+
+	if token == "" {
+		var err error
+		token, err = i.helper.GenerateTokenGuestCart(cartID, time.Now().Add(guestCartLifetime))
+		if err != nil {
+			return models.GuestCart{}, err
+		}
+	}
+
+	items, err := i.repo.GetGuestCart(cartID)
+	if err != nil {
+		return models.GuestCart{}, err
+	}
+
+	cart := models.GuestCart{Token: token, Items: items}
+	for _, v := range items {
+		cart.TotalPrice += v.Total
+		cart.DiscountedPrice += v.DiscountedPrice
+	}
+
+	return cart, nil
+}
+
+// GetCart is the cart with the lines flagged whose price, offer or stock changed since they were added
+func (i *cartUseCase) GetCart(userID int) (models.GetCartResponse, error) {
+
+	cart, err := i.userUseCase.GetCart(userID)
+	if err != nil {
+		return models.GetCartResponse{}, err
+	}
+
+	if cart.ID == 0 {
+		return cart, nil
+	}
+
+	items, err := i.repo.GetCartItemStatus(cart.ID)
+	if err != nil {
+		return models.GetCartResponse{}, err
+	}
+
+	status := make(map[int]models.CartItemStatus, len(items))
+	for _, v := range items {
+		status[v.InventoryID] = v
+	}
+
+	for j, v := range cart.Data {
+		item, ok := status[v.ID]
+		if !ok {
+			continue
+		}
+
+		cart.Data[j].PriceChanged = item.AddedPrice != nil && *item.AddedPrice != item.Price
+		cart.Data[j].OfferChanged = item.AddedDiscount != nil && *item.AddedDiscount != item.Discount
+		cart.Data[j].OutOfStock = item.Quantity > item.Stock
+		cart.Data[j].OverLimit = item.Quantity > i.maxQuantity
+	}
+
+	return cart, nil
+}
+
+// SetQuantity sets how many of a product in the cart the user wants, 0 takes it out
+func (i *cartUseCase) SetQuantity(userID, inventoryID, quantity int) (models.GetCartResponse, error) {
+
+	if quantity < 0 {
+		return models.GetCartResponse{}, errors.New("quantity cannot be negative")
+	}
+
+	cartID, err := i.repo.GetCartId(userID)
+	if err != nil {
+		return models.GetCartResponse{}, errors.New("some error in geting user cart")
+	}
+
+	if cartID == 0 {
+		return models.GetCartResponse{}, errors.New("product is not in the cart")
+	}
+
+	if quantity == 0 {
+		if err := i.userUseCase.RemoveFromCart(cartID, inventoryID); err != nil {
+			return models.GetCartResponse{}, err
+		}
+
+		return i.GetCart(userID)
+	}
+
+	if quantity > i.maxQuantity {
+		return models.GetCartResponse{}, fmt.Errorf("at most %d of a product can be ordered", i.maxQuantity)
+	}
+
+	stock, err := i.inventoryRepository.CheckStock(inventoryID)
+	if err != nil {
+		return models.GetCartResponse{}, err
+	}
+
+	if stock <= 0 {
+		return models.GetCartResponse{}, errors.New("out of stock")
+	}
+
+	if quantity > stock {
+		return models.GetCartResponse{}, fmt.Errorf("only %d left in stock", stock)
+	}
+
+	found, err := i.repo.SetCartQuantity(cartID, inventoryID, quantity)
+	if err != nil {
+		return models.GetCartResponse{}, err
+	}
+
+	if !found {
+		return models.GetCartResponse{}, errors.New("product is not in the cart")
+	}
+
+	return i.GetCart(userID)
+}
+
+// ChangeQuantity moves the quantity of a product in the cart up or down by change, the limits of SetQuantity
+// hold and going down to 0 takes it out
+func (i *cartUseCase) ChangeQuantity(userID, inventoryID, change int) (models.GetCartResponse, error) {
+
+	cartID, err := i.repo.GetCartId(userID)
+	if err != nil {
+		return models.GetCartResponse{}, errors.New("some error in geting user cart")
+	}
+
+	current, err := i.repo.GetCartQuantity(cartID, inventoryID)
+	if err != nil {
+		return models.GetCartResponse{}, err
+	}
+
+	if current == 0 {
+		return models.GetCartResponse{}, errors.New("product is not in the cart")
+	}
+
+	return i.SetQuantity(userID, inventoryID, current+change)
+}
+
+func (i *cartUseCase) CheckOut(id int, addressID int) (models.CheckOut, error) {
 
 	address, err := i.repo.GetAddresses(id)
 	if err != nil {
@@ -77,15 +552,25 @@ func (i *cartUseCase) CheckOut(id int) (models.CheckOut, error) {
 		return models.CheckOut{}, err
 	}
 
-	products, err := i.userUseCase.GetCart(id)
+	products, err := i.GetCart(id)
 	if err != nil {
 		return models.CheckOut{}, err
 	}
 
 	var discountedPrice, totalPrice float64
+	var items int
 	for _, v := range products.Data {
 		discountedPrice += v.DiscountedPrice
 		totalPrice += v.Total
+		items += v.Quantity
+	}
+
+	for j := range address {
+		delivery, err := i.shippingUseCase.CheckServiceability(address[j].Pin)
+		if err != nil {
+			return models.CheckOut{}, err
+		}
+		address[j].Serviceable = delivery.Serviceable
 	}
 
 	var checkout models.CheckOut
@@ -96,6 +581,71 @@ func (i *cartUseCase) CheckOut(id int) (models.CheckOut, error) {
 	checkout.PaymentMethods = payment
 	checkout.TotalPrice = totalPrice
 	checkout.DiscountedPrice = discountedPrice
+	checkout.GrandTotal = discountedPrice
+
+	selected, ok, err := checkoutAddress(address, addressID)
+	if err != nil {
+		return models.CheckOut{}, err
+	}
+
+	if !ok {
+		return checkout, nil
+	}
+
+	delivery, err := i.shippingUseCase.CheckServiceability(selected.Pin)
+	if err != nil {
+		return models.CheckOut{}, err
+	}
+
+	// only the payment methods the PIN allows are offered, none at all when we do not deliver there
+	var allowed []models.PaymentMethod
+	for _, v := range payment {
+		if (v.CashOnDelivery && delivery.CodAllowed) || (!v.CashOnDelivery && delivery.PrepaidAllowed) {
+			allowed = append(allowed, v)
+		}
+	}
+	checkout.PaymentMethods = allowed
+	checkout.Delivery = delivery
+	checkout.AddressID = int(selected.Id)
+
+	if !delivery.Serviceable {
+		return checkout, nil
+	}
+
+	shipping, err := i.shippingUseCase.CalculateShipping(selected, items, discountedPrice, true)
+	if err != nil {
+		return models.CheckOut{}, err
+	}
+
+	checkout.Shipping = shipping
+	checkout.GrandTotal = discountedPrice + shipping.Shipping
+
+	return checkout, nil
+}
+
+// checkoutAddress is the address asked for, without one it is the default address or the first one added.
+// An address the user does not have is an error, the order must not go to an address they did not pick
+func checkoutAddress(addresses []models.Address, addressID int) (models.Address, bool, error) {
+
+	if addressID != 0 {
+		for _, v := range addresses {
+			if int(v.Id) == addressID {
+				return v, true, nil
+			}
+		}
+		return models.Address{}, false, errors.New("address does not exist")
+	}
+
+	if len(addresses) == 0 {
+		return models.Address{}, false, nil
+	}
+
+	selected := addresses[0]
+	for _, v := range addresses {
+		if v.Default {
+			selected = v
+		}
+	}
 
-	return checkout, err
+	return selected, true, nil
 }
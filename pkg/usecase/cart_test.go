@@ -0,0 +1,605 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseReminderHours(t *testing.T) {
+
+	testData := map[string]struct {
+		input          string
+		expectedOutput []time.Duration
+		expectedError  error
+	}{
+		"default steps": {
+			input:          "",
+			expectedOutput: []time.Duration{4 * time.Hour, 24 * time.Hour, 72 * time.Hour},
+			expectedError:  nil,
+		},
+		"steps with spaces": {
+			input:          "2, 12",
+			expectedOutput: []time.Duration{2 * time.Hour, 12 * time.Hour},
+			expectedError:  nil,
+		},
+		"steps going down": {
+			input:          "24,4",
+			expectedOutput: nil,
+			expectedError:  errors.New("hours have to be above 0 and go up"),
+		},
+		"step of zero hours": {
+			input:          "0,4",
+			expectedOutput: nil,
+			expectedError:  errors.New("hours have to be above 0 and go up"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			reminders, err := parseReminderHours(test.input)
+			assert.Equal(t, test.expectedOutput, reminders)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_checkoutAddress(t *testing.T) {
+
+	addresses := []models.Address{{Id: 4, Pin: "688541"}, {Id: 5, Pin: "682001", Default: true}, {Id: 6, Pin: "560001"}}
+
+	testData := map[string]struct {
+		addresses      []models.Address
+		addressID      int
+		expectedOutput models.Address
+		expectedOk     bool
+		expectedError  error
+	}{
+		"address picked by the user": {
+			addresses:      addresses,
+			addressID:      6,
+			expectedOutput: addresses[2],
+			expectedOk:     true,
+			expectedError:  nil,
+		},
+		"default address without a pick": {
+			addresses:      addresses,
+			addressID:      0,
+			expectedOutput: addresses[1],
+			expectedOk:     true,
+			expectedError:  nil,
+		},
+		"first address without a default": {
+			addresses:      []models.Address{{Id: 4}, {Id: 6}},
+			addressID:      0,
+			expectedOutput: models.Address{Id: 4},
+			expectedOk:     true,
+			expectedError:  nil,
+		},
+		"address of another user": {
+			addresses:      addresses,
+			addressID:      9,
+			expectedOutput: models.Address{},
+			expectedOk:     false,
+			expectedError:  errors.New("address does not exist"),
+		},
+		"address picked with none added": {
+			addresses:      []models.Address{},
+			addressID:      9,
+			expectedOutput: models.Address{},
+			expectedOk:     false,
+			expectedError:  errors.New("address does not exist"),
+		},
+		"no address added": {
+			addresses:      []models.Address{},
+			addressID:      0,
+			expectedOutput: models.Address{},
+			expectedOk:     false,
+			expectedError:  nil,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			address, ok, err := checkoutAddress(test.addresses, test.addressID)
+			assert.Equal(t, test.expectedOutput, address)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_remindAbandonedCarts(t *testing.T) {
+
+	couponID := 44
+	idle := func(hours int) time.Time { return time.Now().Add(-time.Duration(hours) * time.Hour) }
+
+	testData := map[string]struct {
+		cart        models.AbandonedCart
+		StubDetails func(testMocks)
+	}{
+		"first reminder is due": {
+			cart: models.AbandonedCart{CartID: 3, UserID: 5, Items: 2, Value: 1800, LastActivity: idle(5)},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notification.EXPECT().Notify(5, gomock.Any()).Times(1).Return(true, nil),
+					mocks.cartRepo.EXPECT().AddCartReminder(models.CartReminder{CartID: 3, UserID: 5, Step: 1, CartValue: 1800, Sent: true}).Times(1).Return(nil),
+				)
+			},
+		},
+		"second reminder is not due yet": {
+			cart:        models.AbandonedCart{CartID: 3, UserID: 5, Items: 2, Value: 1800, LastActivity: idle(10), Reminders: 1},
+			StubDetails: func(mocks testMocks) {},
+		},
+		"last reminder carries the coupon": {
+			cart: models.AbandonedCart{CartID: 3, UserID: 5, Items: 2, Value: 1800, LastActivity: idle(80), Reminders: 2},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.couponRepo.EXPECT().AddUserCoupon(gomock.Any(), 10, 5, gomock.Any()).Times(1).Return(couponID, nil),
+					mocks.notification.EXPECT().Notify(5, gomock.Any()).Times(1).Return(true, nil),
+					mocks.cartRepo.EXPECT().AddCartReminder(gomock.Any()).Times(1).DoAndReturn(func(reminder models.CartReminder) error {
+						assert.Equal(t, 3, reminder.Step)
+						assert.True(t, reminder.Sent)
+						assert.Equal(t, &couponID, reminder.CouponID)
+						assert.Regexp(t, "^BACK-[0-9A-F]{8}$", reminder.Coupon)
+						return nil
+					}),
+				)
+			},
+		},
+		"coupon is withdrawn when the reminder is not sent": {
+			cart: models.AbandonedCart{CartID: 3, UserID: 5, Items: 2, Value: 1800, LastActivity: idle(80), Reminders: 2},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.couponRepo.EXPECT().AddUserCoupon(gomock.Any(), 10, 5, gomock.Any()).Times(1).Return(couponID, nil),
+					mocks.notification.EXPECT().Notify(5, gomock.Any()).Times(1).Return(false, nil),
+					mocks.couponRepo.EXPECT().MakeCouponInvalid(couponID).Times(1).Return(nil),
+					mocks.cartRepo.EXPECT().AddCartReminder(gomock.Any()).Times(1).DoAndReturn(func(reminder models.CartReminder) error {
+						assert.Equal(t, 3, reminder.Step)
+						assert.False(t, reminder.Sent)
+						return nil
+					}),
+				)
+			},
+		},
+		"all reminders sent": {
+			cart:        models.AbandonedCart{CartID: 3, UserID: 5, Items: 2, Value: 1800, LastActivity: idle(200), Reminders: 3},
+			StubDetails: func(mocks testMocks) {},
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{CART_RECOVERY_DISCOUNT: 10})
+			mocks.cartRepo.EXPECT().GetAbandonedCarts(gomock.Any(), 0).Times(1).Return([]models.AbandonedCart{test.cart}, nil)
+			test.StubDetails(mocks)
+
+			err := cartUseCase.remindAbandonedCarts(models.Job{})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_orderPlaced(t *testing.T) {
+
+	testData := map[string]struct {
+		payload       string
+		StubDetails   func(*mockrepo.MockCartRepository)
+		expectedError bool
+	}{
+		"order is put down to the reminders sent within the window": {
+			payload: `{"order_id":12,"user_id":5,"final_price":1620}`,
+			StubDetails: func(cartRepo *mockrepo.MockCartRepository) {
+				cartRepo.EXPECT().AttributeOrder(12, 7*24*time.Hour).Times(1).Return(nil)
+			},
+			expectedError: false,
+		},
+		"payload that can not be read": {
+			payload:       `{"order_id":`,
+			StubDetails:   func(cartRepo *mockrepo.MockCartRepository) {},
+			expectedError: true,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{})
+			test.StubDetails(mocks.cartRepo)
+
+			err := cartUseCase.orderPlaced(models.Event{Name: models.EventOrderPlaced, Payload: test.payload})
+			assert.Equal(t, test.expectedError, err != nil)
+		})
+	}
+}
+
+func Test_AddToGuestCart(t *testing.T) {
+
+	items := []models.GetCart{{ID: 3, ProductName: "Home Jersey", Quantity: 4, Total: 4000, DiscountedPrice: 3600}}
+
+	testData := map[string]struct {
+		token          string
+		item           models.AddToGuestCart
+		StubDetails    func(testMocks)
+		expectedOutput models.GuestCart
+		expectedError  error
+	}{
+		"quantity is added on top of the cart": {
+			token: "guest-token",
+			item:  models.AddToGuestCart{InventoryID: 3, Quantity: 2},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(6, nil),
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().TouchGuestCart(8).Times(1).Return(true, nil),
+					mocks.cartRepo.EXPECT().GetGuestCartQuantity(8, 3).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().SetGuestCartItem(8, 3, 4).Times(1).Return(nil),
+					mocks.helper.EXPECT().GenerateTokenGuestCart(8, gomock.Any()).Times(1).Return("fresh-token", nil),
+					mocks.cartRepo.EXPECT().GetGuestCart(8).Times(1).Return(items, nil),
+				)
+			},
+			expectedOutput: models.GuestCart{Token: "fresh-token", Items: items, TotalPrice: 4000, DiscountedPrice: 3600},
+			expectedError:  nil,
+		},
+		"expired cart starts a new one": {
+			token: "guest-token",
+			item:  models.AddToGuestCart{InventoryID: 3},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(6, nil),
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().TouchGuestCart(8).Times(1).Return(false, nil),
+					mocks.cartRepo.EXPECT().CreateGuestCart(3, 1).Times(1).Return(9, nil),
+					mocks.helper.EXPECT().GenerateTokenGuestCart(9, gomock.Any()).Times(1).Return("fresh-token", nil),
+					mocks.cartRepo.EXPECT().GetGuestCart(9).Times(1).Return(nil, nil),
+				)
+			},
+			expectedOutput: models.GuestCart{Token: "fresh-token"},
+			expectedError:  nil,
+		},
+		"more than the stock": {
+			token: "guest-token",
+			item:  models.AddToGuestCart{InventoryID: 3, Quantity: 3},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(4, nil),
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().TouchGuestCart(8).Times(1).Return(true, nil),
+					mocks.cartRepo.EXPECT().GetGuestCartQuantity(8, 3).Times(1).Return(2, nil),
+				)
+			},
+			expectedOutput: models.GuestCart{},
+			expectedError:  errors.New("only 4 left in stock"),
+		},
+		"more than can be ordered": {
+			token: "guest-token",
+			item:  models.AddToGuestCart{InventoryID: 3, Quantity: 2},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(50, nil),
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().TouchGuestCart(8).Times(1).Return(true, nil),
+					mocks.cartRepo.EXPECT().GetGuestCartQuantity(8, 3).Times(1).Return(4, nil),
+				)
+			},
+			expectedOutput: models.GuestCart{},
+			expectedError:  errors.New("at most 5 of a product can be ordered"),
+		},
+		"no cart is made for a rejected first item": {
+			item: models.AddToGuestCart{InventoryID: 3, Quantity: 6},
+			StubDetails: func(mocks testMocks) {
+				mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(50, nil)
+			},
+			expectedOutput: models.GuestCart{},
+			expectedError:  errors.New("at most 5 of a product can be ordered"),
+		},
+		"out of stock": {
+			item: models.AddToGuestCart{InventoryID: 3, Quantity: 1},
+			StubDetails: func(mocks testMocks) {
+				mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(0, nil)
+			},
+			expectedOutput: models.GuestCart{},
+			expectedError:  errors.New("out of stock"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{MAX_ORDER_QUANTITY: 5})
+			test.StubDetails(mocks)
+
+			cart, err := cartUseCase.AddToGuestCart(test.token, test.item)
+			assert.Equal(t, test.expectedOutput, cart)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_MergeGuestCart(t *testing.T) {
+
+	testData := map[string]struct {
+		token         string
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"merged into the cart of the user with the order limit": {
+			token: "guest-token",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().MergeGuestCart(8, 2, 5).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"user without a cart gets one": {
+			token: "guest-token",
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(8, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(0, nil),
+					mocks.cartRepo.EXPECT().CreateNewCart(5).Times(1).Return(4, nil),
+					mocks.cartRepo.EXPECT().MergeGuestCart(8, 4, 5).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"token that can not be read": {
+			token: "guest-token",
+			StubDetails: func(mocks testMocks) {
+				mocks.helper.EXPECT().ParseTokenGuestCart("guest-token").Times(1).Return(0, errors.New("token is expired"))
+			},
+			expectedError: errors.New("token is expired"),
+		},
+		"no token": {
+			token:         "",
+			StubDetails:   func(mocks testMocks) {},
+			expectedError: errors.New("cart token is missing"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{MAX_ORDER_QUANTITY: 5})
+			test.StubDetails(mocks)
+
+			err := cartUseCase.MergeGuestCart(5, test.token)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_GetCartChanges(t *testing.T) {
+
+	addedPrice, addedDiscount := float64(1000), 10
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	cartUseCase := mocks.newCartUseCase(config.Config{MAX_ORDER_QUANTITY: 5})
+
+	gomock.InOrder(
+		mocks.userUseCase.EXPECT().GetCart(5).Times(1).Return(models.GetCartResponse{ID: 2, Data: []models.GetCart{{ID: 3}, {ID: 4}, {ID: 6}, {ID: 7}}}, nil),
+		mocks.cartRepo.EXPECT().GetCartItemStatus(2).Times(1).Return([]models.CartItemStatus{
+			// price went up and the offer went away since it was added
+			{InventoryID: 3, Quantity: 1, Stock: 10, Price: 1200, AddedPrice: &addedPrice, Discount: 0, AddedDiscount: &addedDiscount},
+			// fewer left than asked for and more than can be ordered
+			{InventoryID: 4, Quantity: 6, Stock: 2, Price: 1000, AddedPrice: &addedPrice, Discount: 10, AddedDiscount: &addedDiscount},
+			// added before prices were kept with the line
+			{InventoryID: 6, Quantity: 1, Stock: 10, Price: 900},
+		}, nil),
+	)
+
+	cart, err := cartUseCase.GetCart(5)
+	assert.NoError(t, err)
+	assert.Equal(t, models.GetCartResponse{ID: 2, Data: []models.GetCart{
+		{ID: 3, PriceChanged: true, OfferChanged: true},
+		{ID: 4, OutOfStock: true, OverLimit: true},
+		{ID: 6},
+		{ID: 7},
+	}}, cart)
+}
+
+func Test_SetQuantity(t *testing.T) {
+
+	testData := map[string]struct {
+		quantity       int
+		StubDetails    func(testMocks)
+		expectedOutput models.GetCartResponse
+		expectedError  error
+	}{
+		"quantity is set": {
+			quantity: 3,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(4, nil),
+					mocks.cartRepo.EXPECT().SetCartQuantity(2, 3, 3).Times(1).Return(true, nil),
+					mocks.userUseCase.EXPECT().GetCart(5).Times(1).Return(models.GetCartResponse{ID: 2, Data: []models.GetCart{{ID: 3, Quantity: 3}}}, nil),
+					mocks.cartRepo.EXPECT().GetCartItemStatus(2).Times(1).Return([]models.CartItemStatus{{InventoryID: 3, Quantity: 3, Stock: 4}}, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{ID: 2, Data: []models.GetCart{{ID: 3, Quantity: 3}}},
+			expectedError:  nil,
+		},
+		"zero takes the product out": {
+			quantity: 0,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.userUseCase.EXPECT().RemoveFromCart(2, 3).Times(1).Return(nil),
+					mocks.userUseCase.EXPECT().GetCart(5).Times(1).Return(models.GetCartResponse{ID: 2}, nil),
+					mocks.cartRepo.EXPECT().GetCartItemStatus(2).Times(1).Return(nil, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{ID: 2},
+			expectedError:  nil,
+		},
+		"more than can be ordered": {
+			quantity: 6,
+			StubDetails: func(mocks testMocks) {
+				mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("at most 5 of a product can be ordered"),
+		},
+		"more than the stock": {
+			quantity: 4,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(3, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("only 3 left in stock"),
+		},
+		"out of stock": {
+			quantity: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(0, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("out of stock"),
+		},
+		"product not in the cart": {
+			quantity: 2,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(4, nil),
+					mocks.cartRepo.EXPECT().SetCartQuantity(2, 3, 2).Times(1).Return(false, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("product is not in the cart"),
+		},
+		"negative quantity": {
+			quantity:       -1,
+			StubDetails:    func(mocks testMocks) {},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("quantity cannot be negative"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{MAX_ORDER_QUANTITY: 5})
+			test.StubDetails(mocks)
+
+			cart, err := cartUseCase.SetQuantity(5, 3, test.quantity)
+			assert.Equal(t, test.expectedOutput, cart)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ChangeQuantity(t *testing.T) {
+
+	testData := map[string]struct {
+		change         int
+		StubDetails    func(testMocks)
+		expectedOutput models.GetCartResponse
+		expectedError  error
+	}{
+		"one more": {
+			change: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartQuantity(2, 3).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(4, nil),
+					mocks.cartRepo.EXPECT().SetCartQuantity(2, 3, 3).Times(1).Return(true, nil),
+					mocks.userUseCase.EXPECT().GetCart(5).Times(1).Return(models.GetCartResponse{ID: 2, Data: []models.GetCart{{ID: 3, Quantity: 3}}}, nil),
+					mocks.cartRepo.EXPECT().GetCartItemStatus(2).Times(1).Return([]models.CartItemStatus{{InventoryID: 3, Quantity: 3, Stock: 4}}, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{ID: 2, Data: []models.GetCart{{ID: 3, Quantity: 3}}},
+			expectedError:  nil,
+		},
+		"one more than can be ordered": {
+			change: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartQuantity(2, 3).Times(1).Return(5, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("at most 5 of a product can be ordered"),
+		},
+		"one more than the stock": {
+			change: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartQuantity(2, 3).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.inventoryRepo.EXPECT().CheckStock(3).Times(1).Return(2, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("only 2 left in stock"),
+		},
+		"one less of the last takes the product out": {
+			change: -1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartQuantity(2, 3).Times(1).Return(1, nil),
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.userUseCase.EXPECT().RemoveFromCart(2, 3).Times(1).Return(nil),
+					mocks.userUseCase.EXPECT().GetCart(5).Times(1).Return(models.GetCartResponse{ID: 2}, nil),
+					mocks.cartRepo.EXPECT().GetCartItemStatus(2).Times(1).Return(nil, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{ID: 2},
+			expectedError:  nil,
+		},
+		"product not in the cart": {
+			change: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.cartRepo.EXPECT().GetCartId(5).Times(1).Return(2, nil),
+					mocks.cartRepo.EXPECT().GetCartQuantity(2, 3).Times(1).Return(0, nil),
+				)
+			},
+			expectedOutput: models.GetCartResponse{},
+			expectedError:  errors.New("product is not in the cart"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			cartUseCase := mocks.newCartUseCase(config.Config{MAX_ORDER_QUANTITY: 5})
+			test.StubDetails(mocks)
+
+			cart, err := cartUseCase.ChangeQuantity(5, 3, test.change)
+			assert.Equal(t, test.expectedOutput, cart)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package usecase
 
 import (
+	"errors"
+	"time"
+
 	"jerseyhub/pkg/domain"
 	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
 )
 
@@ -10,13 +14,27 @@ type couponUseCase struct {
 	repository interfaces.CouponRepository
 }
 
-func NewCouponUseCase(repo interfaces.CouponRepository) *couponUseCase {
-	return &couponUseCase{
+func NewCouponUseCase(repo interfaces.CouponRepository, jobs services.JobUseCase) *couponUseCase {
+	c := &couponUseCase{
 		repository: repo,
 	}
+
+	jobs.Register("coupons.expire", c.expireCoupons)
+	jobs.Schedule("*/5 * * * *", "coupons.expire")
+
+	return c
+}
+
+func (coup *couponUseCase) expireCoupons(job models.Job) error {
+
+	return coup.repository.ExpireCoupons(time.Now())
 }
 
 func (coup *couponUseCase) AddCoupon(coupon models.Coupons) error {
+	if coupon.ExpiresAt != nil && !coupon.ExpiresAt.After(time.Now()) {
+		return errors.New("coupon has to expire in the future")
+	}
+
 	if err := coup.repository.AddCoupon(coupon); err != nil {
 		return err
 	}
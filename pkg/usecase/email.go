@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"jerseyhub/pkg/config"
+	helper_interface "jerseyhub/pkg/helper/interface"
+	mailer_interface "jerseyhub/pkg/mailer/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type emailUseCase struct {
+	repository interfaces.EmailRepository
+	mailer     mailer_interface.Mailer
+	helper     helper_interface.Helper
+	cfg        config.Config
+}
+
+func NewEmailUseCase(repo interfaces.EmailRepository, m mailer_interface.Mailer, h helper_interface.Helper, cfg config.Config) *emailUseCase {
+	return &emailUseCase{
+		repository: repo,
+		mailer:     m,
+		helper:     h,
+		cfg:        cfg,
+	}
+}
+
+const emailVerificationValidity = time.Hour * 24
+
+type orderEmail struct {
+	subject  string
+	template string
+}
+
+// order statuses for which the user gets a mail, PENDING is the status of a freshly placed order
+var orderEmails = map[string]orderEmail{
+	"PENDING":   {subject: "Your jerseyhub order has been placed", template: "order_placed.html"},
+	"SHIPPED":   {subject: "Your jerseyhub order has been shipped", template: "order_shipped.html"},
+	"DELIVERED": {subject: "Your jerseyhub order has been delivered", template: "order_delivered.html"},
+	"CANCELED":  {subject: "Your jerseyhub order has been canceled", template: "order_canceled.html"},
+}
+
+func (e *emailUseCase) SendVerificationEmail(userID int) error {
+
+	verified, err := e.repository.CheckIfEmailVerified(userID)
+	if err != nil {
+		return err
+	}
+
+	if verified {
+		return errors.New("email already verified")
+	}
+
+	user, err := e.repository.GetEmailRecipient(userID)
+	if err != nil {
+		return err
+	}
+
+	token, err := e.helper.GenerateSecureToken()
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	// only the hash of the token is stored, the token itself goes out in the mail
+	if err := e.repository.CreateEmailVerification(userID, user.Email, e.helper.HashToken(token), time.Now().Add(emailVerificationValidity)); err != nil {
+		return errors.New("could not create email verification")
+	}
+
+	data := models.EmailLinkData{
+		Name: user.Name,
+		Link: fmt.Sprintf("http://%s/users/verify-email?token=%s", e.cfg.BASE_URL, token),
+	}
+
+	return e.mailer.Send(user.Email, "Verify your jerseyhub account", "email_verification.html", data)
+}
+
+func (e *emailUseCase) VerifyEmail(token string) error {
+
+	if token == "" {
+		return errors.New("token missing")
+	}
+
+	verification, err := e.repository.FindEmailVerification(e.helper.HashToken(token))
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if verification.ID == 0 {
+		return errors.New("invalid verification link")
+	}
+
+	if verification.Used {
+		return errors.New("verification link already used")
+	}
+
+	if time.Now().After(verification.ExpiresAt) {
+		return errors.New("verification link expired")
+	}
+
+	return e.repository.MarkEmailAsVerified(int(verification.ID), int(verification.UserID), verification.Email)
+}
+
+func (e *emailUseCase) SendOrderStatusEmail(orderID int, status string) error {
+
+	mail, ok := orderEmails[status]
+	if !ok {
+		return nil
+	}
+
+	details, err := e.repository.GetOrderEmailDetails(orderID)
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(details.Email, mail.subject, mail.template, details)
+}
+
+func (e *emailUseCase) SendRefundProcessedEmail(orderID int, amount float64) error {
+
+	details, err := e.repository.GetOrderEmailDetails(orderID)
+	if err != nil {
+		return err
+	}
+
+	details.Amount = amount
+
+	return e.mailer.Send(details.Email, "Your jerseyhub refund has been processed", "refund_processed.html", details)
+}
+
+func (e *emailUseCase) SendPasswordChangedEmail(userID int) error {
+
+	user, err := e.repository.GetEmailRecipient(userID)
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(user.Email, "Your jerseyhub password was changed", "password_changed.html", user)
+}
+
+func (e *emailUseCase) SendPasswordResetEmail(userID int, token string) error {
+
+	user, err := e.repository.GetEmailRecipient(userID)
+	if err != nil {
+		return err
+	}
+
+	data := models.EmailLinkData{
+		Name: user.Name,
+		Link: fmt.Sprintf("http://%s/users/reset-password?token=%s", e.cfg.BASE_URL, token),
+	}
+
+	return e.mailer.Send(user.Email, "Reset your jerseyhub password", "password_reset.html", data)
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+const (
+	eventPollInterval = time.Second * 5
+	eventBatchSize    = 50
+	// how long a claimed event is kept from other dispatchers
+	eventLease = time.Minute
+	// after this many attempts the event is marked FAILED
+	eventMaxAttempts = 8
+)
+
+type eventSubscriber struct {
+	name    string
+	handler services.EventHandler
+}
+
+type eventUseCase struct {
+	repository  interfaces.EventRepository
+	mu          sync.RWMutex
+	subscribers map[string][]eventSubscriber
+}
+
+func NewEventUseCase(repo interfaces.EventRepository) *eventUseCase {
+	return &eventUseCase{
+		repository:  repo,
+		subscribers: map[string][]eventSubscriber{},
+	}
+}
+
+// Subscribe adds an in-process handler for an event, the subscriber name is what the delivery is
+// recorded under so it has to stay the same between releases
+func (e *eventUseCase) Subscribe(event, subscriber string, handler services.EventHandler) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.subscribers[event] = append(e.subscribers[event], eventSubscriber{name: subscriber, handler: handler})
+}
+
+// Start runs the dispatcher in the background for as long as the server is up
+func (e *eventUseCase) Start() {
+
+	go func() {
+		for {
+			if err := e.DispatchPending(); err != nil {
+				fmt.Println("could not dispatch events:", err)
+			}
+			time.Sleep(eventPollInterval)
+		}
+	}()
+}
+
+// DispatchPending delivers one batch of due events, an event is done once every subscriber took it
+func (e *eventUseCase) DispatchPending() error {
+
+	events, err := e.repository.ClaimDueEvents(eventBatchSize, eventLease)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := e.dispatch(event); err != nil {
+			e.retry(event, err)
+			continue
+		}
+
+		if err := e.repository.MarkEventDone(event.ID); err != nil {
+			fmt.Println("could not mark event", event.ID, "as done:", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *eventUseCase) dispatch(event models.Event) error {
+
+	delivered, err := e.repository.GetDeliveredSubscribers(event.ID)
+	if err != nil {
+		return err
+	}
+
+	done := map[string]bool{}
+	for _, name := range delivered {
+		done[name] = true
+	}
+
+	e.mu.RLock()
+	subscribers := e.subscribers[event.Name]
+	e.mu.RUnlock()
+
+	var failed []string
+	for _, subscriber := range subscribers {
+		if done[subscriber.name] {
+			continue
+		}
+
+		if err := subscriber.handler(event); err != nil {
+			failed = append(failed, subscriber.name+": "+err.Error())
+			continue
+		}
+
+		if err := e.repository.AddDelivery(event.ID, subscriber.name); err != nil {
+			failed = append(failed, subscriber.name+": "+err.Error())
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+// retry backs off exponentially, 10s, 20s, 40s and so on
+func (e *eventUseCase) retry(event models.Event, cause error) {
+
+	if event.Attempts+1 >= eventMaxAttempts {
+		if err := e.repository.FailEvent(event.ID, cause.Error()); err != nil {
+			fmt.Println("could not fail event", event.ID, ":", err)
+		}
+		return
+	}
+
+	next := time.Now().Add(time.Second * 10 << event.Attempts)
+	if err := e.repository.RetryEvent(event.ID, next, cause.Error()); err != nil {
+		fmt.Println("could not retry event", event.ID, ":", err)
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DispatchPending(t *testing.T) {
+
+	event := models.Event{ID: 9, Name: models.EventOrderPlaced, Payload: `{"order_id":4,"user_id":1}`, Attempts: 1}
+
+	testData := map[string]struct {
+		mailErr       error
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"every subscriber takes the event": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return([]models.Event{event}, nil),
+					mocks.eventRepo.EXPECT().GetDeliveredSubscribers(9).Times(1).Return([]string{}, nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.mail").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.notification").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().MarkEventDone(9).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"a retry skips the subscribers that already took it": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return([]models.Event{event}, nil),
+					mocks.eventRepo.EXPECT().GetDeliveredSubscribers(9).Times(1).Return([]string{"test.mail"}, nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.notification").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().MarkEventDone(9).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"failed subscriber backs off": {
+			mailErr: errors.New("smtp down"),
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return([]models.Event{event}, nil),
+					mocks.eventRepo.EXPECT().GetDeliveredSubscribers(9).Times(1).Return([]string{}, nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.notification").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().RetryEvent(9, gomock.Any(), "test.mail: smtp down").Times(1).DoAndReturn(func(id int, next time.Time, lastError string) error {
+						// second attempt waits 20 seconds
+						assert.WithinDuration(t, time.Now().Add(time.Second*20), next, time.Second)
+						return nil
+					}),
+				)
+			},
+			expectedError: nil,
+		},
+		"failed on the last attempt": {
+			mailErr: errors.New("smtp down"),
+			StubDetails: func(mocks testMocks) {
+				last := event
+				last.Attempts = 7
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return([]models.Event{last}, nil),
+					mocks.eventRepo.EXPECT().GetDeliveredSubscribers(9).Times(1).Return([]string{}, nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.notification").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().FailEvent(9, "test.mail: smtp down").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"delivery could not be recorded": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return([]models.Event{event}, nil),
+					mocks.eventRepo.EXPECT().GetDeliveredSubscribers(9).Times(1).Return([]string{}, nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.mail").Times(1).Return(nil),
+					mocks.eventRepo.EXPECT().AddDelivery(9, "test.notification").Times(1).Return(errors.New("error")),
+					mocks.eventRepo.EXPECT().RetryEvent(9, gomock.Any(), "test.notification: error").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"events could not be claimed": {
+			StubDetails: func(mocks testMocks) {
+				mocks.eventRepo.EXPECT().ClaimDueEvents(50, time.Minute).Times(1).Return(nil, errors.New("error"))
+			},
+			expectedError: errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			eventUseCase := mocks.newEventUseCase()
+			eventUseCase.Subscribe(models.EventOrderPlaced, "test.mail", func(models.Event) error { return test.mailErr })
+			eventUseCase.Subscribe(models.EventOrderPlaced, "test.notification", func(models.Event) error { return nil })
+			test.StubDetails(mocks)
+
+			err := eventUseCase.DispatchPending()
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"jerseyhub/pkg/domain"
+	helper_interface "jerseyhub/pkg/helper/interface"
+	oidc_interface "jerseyhub/pkg/oidc/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type identityUseCase struct {
+	repo            interfaces.IdentityRepository
+	userRepo        interfaces.UserRepository
+	orderRepository interfaces.OrderRepository
+	google          oidc_interface.Provider
+	helper          helper_interface.Helper
+}
+
+func NewIdentityUseCase(repo interfaces.IdentityRepository, userRepo interfaces.UserRepository, order interfaces.OrderRepository, google oidc_interface.Provider, h helper_interface.Helper) *identityUseCase {
+	return &identityUseCase{
+		repo:            repo,
+		userRepo:        userRepo,
+		orderRepository: order,
+		google:          google,
+		helper:          h,
+	}
+}
+
+const loginStateValidity = time.Minute * 10
+
+func (i *identityUseCase) GoogleLoginURL() (models.SocialLoginURL, error) {
+
+	state, err := i.helper.GenerateSecureToken()
+	if err != nil {
+		return models.SocialLoginURL{}, errors.New(InternalError)
+	}
+	nonce, err := i.helper.GenerateSecureToken()
+	if err != nil {
+		return models.SocialLoginURL{}, errors.New(InternalError)
+	}
+	codeVerifier, err := i.helper.GenerateSecureToken()
+	if err != nil {
+		return models.SocialLoginURL{}, errors.New(InternalError)
+	}
+
+	err = i.repo.CreateLoginState(domain.OidcLoginState{
+		State:        state,
+		Provider:     i.google.Name(),
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(loginStateValidity),
+	})
+	if err != nil {
+		return models.SocialLoginURL{}, errors.New("could not start google login")
+	}
+
+	url, err := i.google.AuthCodeURL(state, nonce, codeVerifier)
+	if err != nil {
+		return models.SocialLoginURL{}, errors.New("could not reach google")
+	}
+
+	return models.SocialLoginURL{URL: url}, nil
+}
+
+func (i *identityUseCase) GoogleCallback(code string, state string) (models.TokenUsers, error) {
+
+	if code == "" || state == "" {
+		return models.TokenUsers{}, errors.New("invalid login request")
+	}
+
+	loginState, err := i.repo.ConsumeLoginState(state)
+	if err != nil {
+		return models.TokenUsers{}, errors.New(InternalError)
+	}
+
+	if loginState.ID == 0 || loginState.Provider != i.google.Name() || time.Now().After(loginState.ExpiresAt) {
+		return models.TokenUsers{}, errors.New("invalid or expired login request")
+	}
+
+	claims, err := i.google.Exchange(code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return models.TokenUsers{}, errors.New("could not verify google login")
+	}
+
+	userID, err := i.repo.FindUserIDByIdentity(i.google.Name(), claims.Subject)
+	if err != nil {
+		return models.TokenUsers{}, errors.New(InternalError)
+	}
+
+	if userID == 0 {
+		userID, err = i.linkGoogleAccount(claims)
+		if err != nil {
+			return models.TokenUsers{}, err
+		}
+	}
+
+	userDetails, err := i.userRepo.GetUserDetails(userID)
+	if err != nil {
+		return models.TokenUsers{}, errors.New(InternalError)
+	}
+
+	isBlocked, err := i.userRepo.UserBlockStatus(userDetails.Email)
+	if err != nil {
+		return models.TokenUsers{}, errors.New(InternalError)
+	}
+
+	if isBlocked {
+		return models.TokenUsers{}, errors.New("user is blocked by admin")
+	}
+
+	tokenString, err := i.helper.GenerateTokenClients(userDetails)
+	if err != nil {
+		return models.TokenUsers{}, errors.New("could not create token")
+	}
+
+	return models.TokenUsers{
+		Users: userDetails,
+		Token: tokenString,
+	}, nil
+}
+
+// linkGoogleAccount attaches a first time google login to the user with the same email, or signs them up
+func (i *identityUseCase) linkGoogleAccount(claims models.OIDCClaims) (int, error) {
+
+	// an unverified address could be used to take over someone else's account
+	if claims.Email == "" || !claims.EmailVerified {
+		return 0, errors.New("google account email is not verified")
+	}
+
+	var userID int
+	if i.userRepo.CheckUserAvailability(claims.Email) {
+		isBlocked, err := i.userRepo.UserBlockStatus(claims.Email)
+		if err != nil {
+			return 0, errors.New(InternalError)
+		}
+
+		if isBlocked {
+			return 0, errors.New("user is blocked by admin")
+		}
+
+		user, err := i.userRepo.FindUserByEmail(models.UserLogin{Email: claims.Email})
+		if err != nil {
+			return 0, errors.New(InternalError)
+		}
+
+		// whoever signed up with an address they do not own would keep their password on the linked account
+		verified, err := i.repo.CheckEmailVerified(int(user.Id))
+		if err != nil {
+			return 0, errors.New(InternalError)
+		}
+
+		if !verified {
+			return 0, errors.New("an account with this email already exists, login with your password and verify the email before signing in with google")
+		}
+		userID = int(user.Id)
+	} else {
+		referral, err := i.helper.GenerateRefferalCode()
+		if err != nil {
+			return 0, errors.New(InternalError)
+		}
+
+		userData, err := i.repo.CreateSocialUser(claims.Name, claims.Email, referral)
+		if err != nil {
+			return 0, errors.New("could not add the user")
+		}
+
+		if _, err := i.orderRepository.CreateNewWallet(userData.Id); err != nil {
+			return 0, errors.New("errror in creating new wallet")
+		}
+		userID = userData.Id
+	}
+
+	if err := i.repo.LinkIdentity(userID, i.google.Name(), claims.Subject, claims.Email); err != nil {
+		return 0, errors.New("could not link google account")
+	}
+
+	return userID, nil
+}
@@ -10,7 +10,15 @@ type AdminUseCase interface {
 	BlockUser(id string) error
 	UnBlockUser(id string) error
 	GetUsers(page int) ([]models.UserDetailsAtAdmin, error)
-	NewPaymentMethod(string) error
+	NewPaymentMethod(method models.NewPaymentMethod) error
 	ListPaymentMethods() ([]domain.PaymentMethod, error)
 	DeletePaymentMethod(id int) error
+
+	VerifyTwoFactorLogin(adminID int, code string) (domain.TokenAdmin, error)
+	EnrollTwoFactor(adminID int) (models.TwoFactorEnrollment, error)
+	ConfirmTwoFactor(adminID int, code string) (domain.TwoFactorEnabled, error)
+	RegenerateRecoveryCodes(adminID int, code string) ([]string, error)
+	DisableTwoFactor(adminID int, code string) error
+	GetTwoFactorPolicy() (models.TwoFactorPolicy, error)
+	UpdateTwoFactorPolicy(policy models.TwoFactorPolicy) error
 }
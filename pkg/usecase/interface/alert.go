@@ -0,0 +1,13 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type AlertUseCase interface {
+	Subscribe(userID int, alert models.AddProductAlert) (int, error)
+	Unsubscribe(userID, id int) error
+	GetAlerts(userID int) ([]models.ProductAlertDetails, error)
+	WatchWishlistItem(userID, inventoryID int) error
+	UnwatchWishlistItem(userID, inventoryID int) error
+	ProductRestocked(inventoryID int)
+	PricesChanged(inventoryID, categoryID int)
+}
@@ -4,5 +4,14 @@ import "jerseyhub/pkg/utils/models"
 
 type CartUseCase interface {
 	AddToCart(user_id, inventory_id int) error
-	CheckOut(id int) (models.CheckOut, error)
+	GetCart(userID int) (models.GetCartResponse, error)
+	SetQuantity(userID, inventoryID, quantity int) (models.GetCartResponse, error)
+	ChangeQuantity(userID, inventoryID, change int) (models.GetCartResponse, error)
+	CheckOut(id int, addressID int) (models.CheckOut, error)
+	GetAbandonedCarts(idleHours, days, page int) (models.AbandonedCartReport, error)
+
+	AddToGuestCart(token string, item models.AddToGuestCart) (models.GuestCart, error)
+	GetGuestCart(token string) (models.GuestCart, error)
+	RemoveFromGuestCart(token string, inventoryID int) (models.GuestCart, error)
+	MergeGuestCart(userID int, token string) error
 }
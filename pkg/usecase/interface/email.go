@@ -0,0 +1,11 @@
+package interfaces
+
+type EmailUseCase interface {
+	SendVerificationEmail(userID int) error
+	VerifyEmail(token string) error
+
+	SendOrderStatusEmail(orderID int, status string) error
+	SendRefundProcessedEmail(orderID int, amount float64) error
+	SendPasswordChangedEmail(userID int) error
+	SendPasswordResetEmail(userID int, token string) error
+}
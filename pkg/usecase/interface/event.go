@@ -0,0 +1,12 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+// EventHandler gets an event with its json payload, returning an error has the event retried
+type EventHandler func(event models.Event) error
+
+type EventUseCase interface {
+	Subscribe(event, subscriber string, handler EventHandler)
+	Start()
+	DispatchPending() error
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type IdentityUseCase interface {
+	GoogleLoginURL() (models.SocialLoginURL, error)
+	GoogleCallback(code string, state string) (models.TokenUsers, error)
+}
@@ -10,8 +10,8 @@ type InventoryUseCase interface {
 	UpdateInventory(ProductID int, Stock int) (models.InventoryResponse, error)
 	DeleteInventory(id string) error
 
-	ShowIndividualProducts(sku string) (models.Inventories, error)
-	ListProductsForUser(page, userID int) ([]models.Inventories, error)
+	ShowIndividualProducts(sku string, questionPage int) (models.Inventories, error)
+	ListProductsForUser(page, userID int, sort string) ([]models.Inventories, error)
 	ListProductsForAdmin(page int) ([]models.Inventories, error)
 
 	SearchProducts(key string) ([]models.Inventories, error)
@@ -0,0 +1,12 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type InvoiceUseCase interface {
+	SetCategoryTax(tax models.CategoryTax) error
+	GetCategoryTaxes() ([]models.CategoryTax, error)
+	ItemTaxes(addressID int, cart []models.GetCart, couponRate int) (map[int]models.ItemTax, error)
+
+	GetInvoice(orderID int, userID int) (models.Invoice, error)
+	GetInvoiceDocument(orderID int, userID int, format string) (models.InvoiceDocument, error)
+}
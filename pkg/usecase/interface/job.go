@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"time"
+)
+
+// JobHandler runs a job, returning an error has the job retried until it runs out of attempts
+type JobHandler func(job models.Job) error
+
+type JobUseCase interface {
+	Register(name string, handler JobHandler)
+	Schedule(spec, name string)
+	Enqueue(name string, payload interface{}, runAt time.Time) (int, error)
+	Start()
+	GetJobs(status string, page int) ([]models.JobDetails, error)
+	GetJobStatus() (models.JobStatus, error)
+	RetryJob(id int) error
+}
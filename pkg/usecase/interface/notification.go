@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+)
+
+type NotificationUseCase interface {
+	Notify(userID int, notification models.Notification) (bool, error)
+	PublishWalletCredit(orderID int, amount float64) error
+	GetNotifications(userID, page int, unreadOnly bool) ([]domain.Notification, error)
+	GetUnreadCount(userID int) (int, error)
+	MarkRead(userID, id int) error
+	MarkAllRead(userID int) error
+	GetPreferences(userID int) ([]models.NotificationPreference, error)
+	SetPreference(userID int, preference models.NotificationPreference) error
+}
@@ -8,10 +8,11 @@ import (
 type OrderUseCase interface {
 	GetOrders(id int) ([]domain.OrderDetailsWithImages, error)
 	OrderItemsFromCart(userid int, addressid int, paymentid int, couponID int) error
-	CancelOrder(id int) error
+	CancelOrder(userID, id int) error
 	EditOrderStatus(status string, id int) error
 	AdminOrders() (domain.AdminOrdersResponse, error)
-	ReturnOrder(id int) error
+	CancelOrderItems(userID, orderID int, request []models.OrderItemRequest) error
+	ReturnOrderItems(userID, orderID int, request []models.OrderItemRequest) (float64, error)
 	MakePaymentStatusAsPaid(id int) error
 	GetIndividualOrderDetails(id int) (models.IndividualOrderDetails, error)
 }
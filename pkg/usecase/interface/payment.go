@@ -7,4 +7,5 @@ type PaymentUseCase interface {
 	VerifyPayment(paymentID string, razorID string, orderID string) error
 
 	UseWallet(orderID string, userID string) (models.OrderPaymentDetails, error)
+	RetryPayment(userID, orderID int) (models.RetryPayment, error)
 }
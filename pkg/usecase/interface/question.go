@@ -0,0 +1,13 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type QuestionUseCase interface {
+	AskQuestion(userID int, question models.AddQuestion) (int, error)
+	AnswerQuestion(userID, questionID int, answer string) (int, error)
+	GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error)
+	GetUnansweredQuestions(page int) ([]models.QuestionDetails, error)
+	VoteAnswer(userID, id int, upvote bool) error
+	DeleteQuestion(id int) error
+	DeleteAnswer(id int) error
+}
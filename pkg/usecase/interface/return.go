@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"mime/multipart"
+	"time"
+)
+
+type ReturnUseCase interface {
+	RequestReturn(userID, orderID int, request models.ReturnRequest) (int, error)
+	RequestExchange(userID, orderID int, request models.ExchangeRequest) (int, error)
+	AddReturnImage(userID, id int, image *multipart.FileHeader) error
+	GetReturnRequests(userID int, status string, page int) ([]models.ReturnRequestDetails, error)
+	GetReturnRequest(userID, id int) (models.ReturnRequestDetails, error)
+	ApproveReturn(id int, note string) error
+	RejectReturn(id int, note string) error
+	SchedulePickup(id int, date time.Time) error
+	ReceiveReturn(id int) error
+	InspectReturn(id int, passed bool, note string) error
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"mime/multipart"
+)
+
+type ReviewUseCase interface {
+	AddReview(userID int, review models.AddReview) (int, error)
+	EditReview(userID, id int, review models.EditReview) error
+	DeleteReview(userID, id int) error
+	AddReviewImage(userID, id int, image *multipart.FileHeader) error
+	GetProductReviews(inventoryID, page int, sort string) (models.ProductReviews, error)
+	GetReviews(userID int, status string, page int) ([]models.ReviewDetails, error)
+	VoteHelpful(userID, id int, helpful bool) error
+	ModerateReview(id int, status string) error
+	ReplyToReview(id int, reply string) error
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type ShipmentUseCase interface {
+	CreateShipment(orderID int, shipment models.CreateShipment) error
+	GetShipments(orderID int) ([]models.ShipmentDetails, error)
+	AddTrackingEvent(shipmentID int, event models.TrackingEvent) error
+	RefreshTracking(shipmentID int) error
+	CompleteOrder(orderID int) error
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"jerseyhub/pkg/utils/models"
+	"mime/multipart"
+)
+
+type ShippingUseCase interface {
+	AddShippingZone(zone models.ShippingZone) error
+	UpdateShippingZone(zone models.ShippingZone) error
+	DeleteShippingZone(id int) error
+	GetShippingZones() ([]models.ShippingZone, error)
+
+	CalculateShipping(address models.Address, items int, subtotal float64, cod bool) (models.ShippingCharge, error)
+	ShippingForOrder(addressID int, paymentID int, items int, subtotal float64) (models.ShippingCharge, error)
+
+	CheckServiceability(pin string) (models.Serviceability, error)
+	ImportServiceablePins(file *multipart.FileHeader) (models.ServiceabilityImport, error)
+	GetServiceablePins(page int) ([]models.ServiceablePin, error)
+	DeleteServiceablePin(pin string) error
+}
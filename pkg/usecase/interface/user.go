@@ -3,6 +3,7 @@ package interfaces
 import (
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/utils/models"
+	"time"
 )
 
 type UserUseCase interface {
@@ -10,11 +11,17 @@ type UserUseCase interface {
 	LoginHandler(user models.UserLogin) (models.TokenUsers, error)
 	AddAddress(id int, address models.AddAddress) error
 	GetAddresses(id int) ([]domain.Address, error)
+	EditAddress(userID, addressID int, address models.AddAddress) error
+	DeleteAddress(userID, addressID int) error
+	SetDefaultAddress(userID, addressID int) error
 	GetUserDetails(id int) (models.UserDetailsResponse, error)
 
 	ChangePassword(id int, old string, password string, repassword string) error
 	ForgotPasswordSend(phone string) error
 	ForgotPasswordVerifyAndChange(model models.ForgotVerify) error
+	ForgotPasswordEmailSend(email string) error
+	ResetPasswordWithToken(model models.ResetPassword) error
+	GetSessionsRevokedAt(id int) (time.Time, error)
 	EditName(id int, name string) error
 	EditEmail(id int, email string) error
 	EditPhone(id int, phone string) error
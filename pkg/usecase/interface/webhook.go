@@ -0,0 +1,14 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type WebhookUseCase interface {
+	RegisterWebhook(webhook models.AddWebhook) (models.NewWebhook, error)
+	GetWebhooks() ([]models.WebhookDetails, error)
+	DeleteWebhook(id int) error
+	Ping(id int) (models.WebhookDeliveryDetails, error)
+	GetDeliveries(webhookID, page int) ([]models.WebhookDeliveryDetails, error)
+	ReplayDelivery(id int) (int, error)
+	Start()
+	DeliverPending() error
+}
@@ -5,5 +5,7 @@ import "jerseyhub/pkg/utils/models"
 type WishlistUseCase interface {
 	AddToWishlist(userID, InventoryID int) error
 	RemoveFromWishlist(invID, userID int) error
-	GetWishList(id int) ([]models.Inventories, error)
+	GetWishList(id int) ([]models.WishlistItem, error)
+	MoveToWishlist(userID, inventoryID int) error
+	MoveToCart(userID, inventoryID int) error
 }
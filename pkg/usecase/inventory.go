@@ -5,6 +5,7 @@ import (
 	"fmt"
 	helper_interface "jerseyhub/pkg/helper/interface"
 	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
 	"mime/multipart"
 )
@@ -14,15 +15,28 @@ type inventoryUseCase struct {
 	offerRepository    interfaces.OfferRepository
 	helper             helper_interface.Helper
 	wishlistRepository interfaces.WishlistRepository
+	questionUseCase    services.QuestionUseCase
+	alertUseCase       services.AlertUseCase
 }
 
-func NewInventoryUseCase(repo interfaces.InventoryRepository, offer interfaces.OfferRepository, h helper_interface.Helper, w interfaces.WishlistRepository) *inventoryUseCase {
-	return &inventoryUseCase{
+func NewInventoryUseCase(repo interfaces.InventoryRepository, offer interfaces.OfferRepository, h helper_interface.Helper, w interfaces.WishlistRepository, question services.QuestionUseCase, alert services.AlertUseCase, jobs services.JobUseCase) *inventoryUseCase {
+	i := &inventoryUseCase{
 		repository:         repo,
 		offerRepository:    offer,
 		helper:             h,
 		wishlistRepository: w,
+		questionUseCase:    question,
+		alertUseCase:       alert,
 	}
+
+	jobs.Register("search.rebuild_index", i.rebuildSearchIndex)
+	jobs.Schedule("30 3 * * *", "search.rebuild_index")
+
+	return i
+}
+
+func (i *inventoryUseCase) rebuildSearchIndex(job models.Job) error {
+	return i.repository.RebuildSearchIndex()
 }
 
 func (i *inventoryUseCase) AddInventory(inventory models.AddInventories, image *multipart.FileHeader) (models.InventoryResponse, error) {
@@ -72,7 +86,8 @@ func (i *inventoryUseCase) DeleteInventory(inventoryID string) error {
 
 }
 
-func (i *inventoryUseCase) ShowIndividualProducts(id string) (models.Inventories, error) {
+// ShowIndividualProducts gives the product along with a page of questions asked about it
+func (i *inventoryUseCase) ShowIndividualProducts(id string, questionPage int) (models.Inventories, error) {
 
 	product, err := i.repository.ShowIndividualProducts(id)
 	if err != nil {
@@ -92,13 +107,18 @@ func (i *inventoryUseCase) ShowIndividualProducts(id string) (models.Inventories
 
 	product.DiscountedPrice = product.Price - discount
 
+	product.Questions, err = i.questionUseCase.GetProductQuestions(int(product.ID), questionPage)
+	if err != nil {
+		return models.Inventories{}, err
+	}
+
 	return product, nil
 
 }
 
-func (i *inventoryUseCase) ListProductsForUser(page, userID int) ([]models.Inventories, error) {
+func (i *inventoryUseCase) ListProductsForUser(page, userID int, sort string) ([]models.Inventories, error) {
 
-	productDetails, err := i.repository.ListProducts(page)
+	productDetails, err := i.repository.ListProducts(page, sort)
 	if err != nil {
 		return []models.Inventories{}, err
 	}
@@ -137,7 +157,7 @@ func (i *inventoryUseCase) ListProductsForUser(page, userID int) ([]models.Inven
 
 func (i *inventoryUseCase) ListProductsForAdmin(page int) ([]models.Inventories, error) {
 
-	productDetails, err := i.repository.ListProducts(page)
+	productDetails, err := i.repository.ListProducts(page, "")
 	if err != nil {
 		return []models.Inventories{}, err
 	}
@@ -216,6 +236,8 @@ func (i *inventoryUseCase) EditInventoryDetails(id int, model models.EditInvento
 		return err
 	}
 
+	go i.alertUseCase.PricesChanged(id, 0)
+
 	return nil
 
 }
@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"jerseyhub/pkg/config"
+	invoice_interface "jerseyhub/pkg/invoice/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type invoiceUseCase struct {
+	repository      interfaces.InvoiceRepository
+	orderRepository interfaces.OrderRepository
+	renderer        invoice_interface.Renderer
+	cfg             config.Config
+}
+
+func NewInvoiceUseCase(repo interfaces.InvoiceRepository, orderRepo interfaces.OrderRepository, renderer invoice_interface.Renderer, cfg config.Config) *invoiceUseCase {
+	return &invoiceUseCase{
+		repository:      repo,
+		orderRepository: orderRepo,
+		renderer:        renderer,
+		cfg:             cfg,
+	}
+}
+
+func (i *invoiceUseCase) SetCategoryTax(tax models.CategoryTax) error {
+
+	exists, err := i.repository.CheckCategoryExists(tax.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("category does not exist")
+	}
+
+	if err := i.repository.SetCategoryTax(tax); err != nil {
+		return errors.New("could not update the tax of the category")
+	}
+
+	return nil
+}
+
+func (i *invoiceUseCase) GetCategoryTaxes() ([]models.CategoryTax, error) {
+
+	return i.repository.GetCategoryTaxes()
+}
+
+func roundToPaise(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// interstate supply is charged IGST, supply within the seller's state is split equally into CGST and SGST
+func (i *invoiceUseCase) isInterstate(state string) bool {
+	return !strings.EqualFold(strings.TrimSpace(state), strings.TrimSpace(i.cfg.SELLER_STATE))
+}
+
+// ItemTaxes works out the GST on what the customer pays for each cart line, that is the offer price
+// less its share of the coupon. Prices are inclusive of tax so the taxable value is backed out of it
+func (i *invoiceUseCase) ItemTaxes(addressID int, cart []models.GetCart, couponRate int) (map[int]models.ItemTax, error) {
+
+	state, err := i.repository.GetAddressState(addressID)
+	if err != nil {
+		return nil, err
+	}
+	interstate := i.isInterstate(state)
+
+	taxes := make(map[int]models.ItemTax)
+	for _, v := range cart {
+		category, err := i.repository.GetCategoryTax(v.Category_id)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := roundToPaise(v.DiscountedPrice - v.DiscountedPrice*float64(couponRate)/100)
+		taxable := roundToPaise(amount * 100 / (100 + category.GstRate))
+		tax := roundToPaise(amount - taxable)
+
+		item := models.ItemTax{
+			HsnCode:      category.HsnCode,
+			GstRate:      category.GstRate,
+			TaxableValue: taxable,
+		}
+		if interstate {
+			item.Igst = tax
+		} else {
+			item.Cgst = roundToPaise(tax / 2)
+			item.Sgst = roundToPaise(tax - item.Cgst)
+		}
+
+		taxes[v.ID] = item
+	}
+
+	return taxes, nil
+}
+
+// financial years run from april to march, 2026-27 for example
+func financialYear(t time.Time) string {
+	year := t.Year()
+	if t.Month() < time.April {
+		year--
+	}
+	return fmt.Sprintf("%d-%02d", year, (year+1)%100)
+}
+
+// GetInvoice builds the invoice from the same data as the order details page, the invoice number is given
+// out the first time it is asked for once the order is paid or delivered. Lines show what is left of them
+// after cancellations and returns. userID 0 skips the ownership check for admins
+func (i *invoiceUseCase) GetInvoice(orderID int, userID int) (models.Invoice, error) {
+
+	customer, err := i.repository.GetInvoiceCustomer(orderID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	if customer.UserID == 0 || (userID != 0 && customer.UserID != userID) {
+		return models.Invoice{}, errors.New("order does not exist")
+	}
+
+	details, err := i.orderRepository.GetIndividualOrderDetails(orderID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	if details.OrderStatus == "CANCELED" {
+		return models.Invoice{}, errors.New("no invoice is issued for a canceled order")
+	}
+
+	products, err := i.orderRepository.GetProductDetailsInOrder(orderID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	details.Products = products
+
+	itemsLeft := false
+	for _, p := range products {
+		if p.Quantity-p.CanceledQuantity-p.ReturnedQuantity > 0 {
+			itemsLeft = true
+		}
+	}
+
+	if !itemsLeft {
+		return models.Invoice{}, errors.New("nothing is left on the order to invoice")
+	}
+
+	invoice, err := i.repository.GetInvoiceByOrderID(orderID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	if invoice.ID == 0 {
+		// numbers run without gaps, so an order that may still be canceled unpaid does not get one
+		if details.PaymentStatus != "PAID" && details.OrderStatus != "DELIVERED" {
+			return models.Invoice{}, errors.New("the invoice is issued once the order is paid for or delivered")
+		}
+
+		invoice, err = i.repository.CreateInvoice(orderID, financialYear(time.Now()))
+		if err != nil {
+			// someone else downloading the same invoice at the same moment got the number first
+			invoice, err = i.repository.GetInvoiceByOrderID(orderID)
+			if err != nil || invoice.ID == 0 {
+				return models.Invoice{}, errors.New("could not generate the invoice number")
+			}
+		}
+	}
+
+	result := models.Invoice{
+		InvoiceNumber: invoice.InvoiceNumber,
+		InvoiceDate:   invoice.CreatedAt,
+		SellerName:    i.cfg.SELLER_NAME,
+		SellerAddress: i.cfg.SELLER_ADDRESS,
+		SellerGstin:   i.cfg.SELLER_GSTIN,
+		SellerState:   i.cfg.SELLER_STATE,
+		Customer:      customer,
+		Interstate:    i.isInterstate(customer.State),
+		Order:         details,
+	}
+
+	var itemsTotal, refunded float64
+	for _, p := range products {
+		refunded += p.RefundedAmount
+
+		quantity := p.Quantity - p.CanceledQuantity - p.ReturnedQuantity
+		if quantity <= 0 {
+			continue
+		}
+		left := float64(quantity) / float64(p.Quantity)
+
+		taxable := p.TaxableValue
+		// orders placed before tax was recorded only have the line amount
+		if taxable == 0 && p.Cgst == 0 && p.Sgst == 0 && p.Igst == 0 {
+			taxable = p.Amount
+		}
+
+		item := models.InvoiceItem{
+			ProductName:  p.ProductName,
+			HsnCode:      p.HsnCode,
+			Quantity:     quantity,
+			TaxableValue: roundToPaise(taxable * left),
+			GstRate:      p.GstRate,
+			Cgst:         roundToPaise(p.Cgst * left),
+			Sgst:         roundToPaise(p.Sgst * left),
+			Igst:         roundToPaise(p.Igst * left),
+		}
+		item.Total = roundToPaise(item.TaxableValue + item.Cgst + item.Sgst + item.Igst)
+
+		result.Items = append(result.Items, item)
+		result.TotalTaxable += item.TaxableValue
+		result.TotalCgst += item.Cgst
+		result.TotalSgst += item.Sgst
+		result.TotalIgst += item.Igst
+		itemsTotal += item.Total
+	}
+
+	result.TotalTaxable = roundToPaise(result.TotalTaxable)
+	result.TotalCgst = roundToPaise(result.TotalCgst)
+	result.TotalSgst = roundToPaise(result.TotalSgst)
+	result.TotalIgst = roundToPaise(result.TotalIgst)
+
+	// the grand total is what the customer kept paying for, refunds are taken off
+	result.Order.TotalAmount = roundToPaise(details.TotalAmount - refunded)
+
+	// anything the lines and charges do not explain, only older orders have this
+	discount := roundToPaise(itemsTotal + details.ShippingCharge + details.CodCharge - result.Order.TotalAmount)
+	if discount > 0.01 {
+		result.Discount = discount
+	}
+
+	return result, nil
+}
+
+func (i *invoiceUseCase) GetInvoiceDocument(orderID int, userID int, format string) (models.InvoiceDocument, error) {
+
+	invoice, err := i.GetInvoice(orderID, userID)
+	if err != nil {
+		return models.InvoiceDocument{}, err
+	}
+
+	fileName := strings.ReplaceAll(invoice.InvoiceNumber, "/", "-")
+
+	switch format {
+	case "", "pdf":
+		content, err := i.renderer.PDF(invoice)
+		if err != nil {
+			return models.InvoiceDocument{}, errors.New("could not generate the invoice")
+		}
+		return models.InvoiceDocument{FileName: fileName + ".pdf", ContentType: "application/pdf", Content: content}, nil
+	case "html":
+		content, err := i.renderer.HTML(invoice)
+		if err != nil {
+			return models.InvoiceDocument{}, errors.New("could not generate the invoice")
+		}
+		return models.InvoiceDocument{FileName: fileName + ".html", ContentType: "text/html; charset=utf-8", Content: content}, nil
+	}
+
+	return models.InvoiceDocument{}, errors.New("format should be pdf or html")
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetInvoice(t *testing.T) {
+
+	customer := models.InvoiceCustomer{UserID: 5, Name: "Arun K", State: "Kerala"}
+	products := []models.ProductDetails{
+		{OrderItemID: 11, ProductName: "Home Jersey", Quantity: 2, Amount: 200, GstRate: 12, TaxableValue: 178.58, Cgst: 10.71, Sgst: 10.71, CanceledQuantity: 1, RefundedAmount: 100},
+		{OrderItemID: 12, ProductName: "Away Jersey", Quantity: 1, Amount: 100, GstRate: 12, TaxableValue: 89.29, Cgst: 5.36, Sgst: 5.35},
+	}
+
+	testData := map[string]struct {
+		StubDetails    func(*mockrepo.MockInvoiceRepository, *mockrepo.MockOrderRepository)
+		expectedOutput []models.InvoiceItem
+		expectedTotal  float64
+		expectedError  error
+	}{
+		"lines show what is left after cancellations": {
+			StubDetails: func(invoiceRepo *mockrepo.MockInvoiceRepository, orderRepo *mockrepo.MockOrderRepository) {
+				gomock.InOrder(
+					invoiceRepo.EXPECT().GetInvoiceCustomer(1).Times(1).Return(customer, nil),
+					orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, TotalAmount: 340, ShippingCharge: 40, OrderStatus: "PENDING", PaymentStatus: "PAID"}, nil),
+					orderRepo.EXPECT().GetProductDetailsInOrder(1).Times(1).Return(products, nil),
+					invoiceRepo.EXPECT().GetInvoiceByOrderID(1).Times(1).Return(domain.Invoice{}, nil),
+					invoiceRepo.EXPECT().CreateInvoice(1, gomock.Any()).Times(1).Return(domain.Invoice{ID: 1, OrderID: 1, InvoiceNumber: "INV/2026-27/000001"}, nil),
+				)
+			},
+			expectedOutput: []models.InvoiceItem{
+				{ProductName: "Home Jersey", Quantity: 1, TaxableValue: 89.29, GstRate: 12, Cgst: 5.36, Sgst: 5.36, Total: 100.01},
+				{ProductName: "Away Jersey", Quantity: 1, TaxableValue: 89.29, GstRate: 12, Cgst: 5.36, Sgst: 5.35, Total: 100},
+			},
+			expectedTotal: 240,
+			expectedError: nil,
+		},
+		"unpaid order does not get a number": {
+			StubDetails: func(invoiceRepo *mockrepo.MockInvoiceRepository, orderRepo *mockrepo.MockOrderRepository) {
+				gomock.InOrder(
+					invoiceRepo.EXPECT().GetInvoiceCustomer(1).Times(1).Return(customer, nil),
+					orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, TotalAmount: 340, ShippingCharge: 40, OrderStatus: "SHIPPED", PaymentStatus: "NOT PAID"}, nil),
+					orderRepo.EXPECT().GetProductDetailsInOrder(1).Times(1).Return(products, nil),
+					invoiceRepo.EXPECT().GetInvoiceByOrderID(1).Times(1).Return(domain.Invoice{}, nil),
+				)
+			},
+			expectedOutput: nil,
+			expectedError:  errors.New("the invoice is issued once the order is paid for or delivered"),
+		},
+		"invoice already issued is shown for an unpaid order": {
+			StubDetails: func(invoiceRepo *mockrepo.MockInvoiceRepository, orderRepo *mockrepo.MockOrderRepository) {
+				gomock.InOrder(
+					invoiceRepo.EXPECT().GetInvoiceCustomer(1).Times(1).Return(customer, nil),
+					orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, TotalAmount: 340, ShippingCharge: 40, OrderStatus: "RETURNED", PaymentStatus: "NOT PAID"}, nil),
+					orderRepo.EXPECT().GetProductDetailsInOrder(1).Times(1).Return(products, nil),
+					invoiceRepo.EXPECT().GetInvoiceByOrderID(1).Times(1).Return(domain.Invoice{ID: 1, OrderID: 1, InvoiceNumber: "INV/2026-27/000001"}, nil),
+				)
+			},
+			expectedOutput: []models.InvoiceItem{
+				{ProductName: "Home Jersey", Quantity: 1, TaxableValue: 89.29, GstRate: 12, Cgst: 5.36, Sgst: 5.36, Total: 100.01},
+				{ProductName: "Away Jersey", Quantity: 1, TaxableValue: 89.29, GstRate: 12, Cgst: 5.36, Sgst: 5.35, Total: 100},
+			},
+			expectedTotal: 240,
+			expectedError: nil,
+		},
+		"fully returned order has nothing to invoice": {
+			StubDetails: func(invoiceRepo *mockrepo.MockInvoiceRepository, orderRepo *mockrepo.MockOrderRepository) {
+				gomock.InOrder(
+					invoiceRepo.EXPECT().GetInvoiceCustomer(1).Times(1).Return(customer, nil),
+					orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, OrderStatus: "RETURNED", PaymentStatus: "PAID"}, nil),
+					orderRepo.EXPECT().GetProductDetailsInOrder(1).Times(1).Return([]models.ProductDetails{{OrderItemID: 12, Quantity: 1, Amount: 100, ReturnedQuantity: 1, RefundedAmount: 100}}, nil),
+				)
+			},
+			expectedOutput: nil,
+			expectedError:  errors.New("nothing is left on the order to invoice"),
+		},
+		"order of another user": {
+			StubDetails: func(invoiceRepo *mockrepo.MockInvoiceRepository, orderRepo *mockrepo.MockOrderRepository) {
+				invoiceRepo.EXPECT().GetInvoiceCustomer(1).Times(1).Return(models.InvoiceCustomer{UserID: 6}, nil)
+			},
+			expectedOutput: nil,
+			expectedError:  errors.New("order does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			invoiceUseCase := mocks.newInvoiceUseCase(config.Config{SELLER_STATE: "Kerala"})
+			test.StubDetails(mocks.invoiceRepo, mocks.orderRepo)
+
+			invoice, err := invoiceUseCase.GetInvoice(1, 5)
+			assert.Equal(t, test.expectedOutput, invoice.Items)
+			assert.Equal(t, test.expectedError, err)
+			if err == nil {
+				assert.Equal(t, test.expectedTotal, invoice.Order.TotalAmount)
+			}
+		})
+	}
+}
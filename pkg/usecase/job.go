@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/cron"
+	"jerseyhub/pkg/utils/models"
+)
+
+const (
+	jobWorkers      = 4
+	jobPollInterval = time.Second * 5
+	// a job still running after this is taken to be lost with its worker and is run again
+	jobLease = time.Minute * 10
+	// 1m, 2m, 4m and 8m between the tries
+	jobMaxAttempts = 5
+	// how often the schedules are checked, a run is never late by more than this
+	jobScheduleTick = time.Second * 30
+)
+
+type jobSchedule struct {
+	name     string
+	schedule cron.Schedule
+	next     time.Time
+}
+
+type jobUseCase struct {
+	repository interfaces.JobRepository
+	mu         sync.RWMutex
+	handlers   map[string]services.JobHandler
+	schedules  []*jobSchedule
+}
+
+func NewJobUseCase(repo interfaces.JobRepository) *jobUseCase {
+	return &jobUseCase{
+		repository: repo,
+		handlers:   map[string]services.JobHandler{},
+	}
+}
+
+// Register adds the handler for a kind of job, the name is stored with every queued job so it has
+// to stay the same between releases
+func (j *jobUseCase) Register(name string, handler services.JobHandler) {
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.handlers[name] = handler
+}
+
+// Schedule queues the job on a cron spec. The specs are written in code, so a bad one stops the server
+// at start instead of the job silently never running
+func (j *jobUseCase) Schedule(spec, name string) {
+
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		panic(fmt.Sprintf("job %s: %v", name, err))
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.schedules = append(j.schedules, &jobSchedule{name: name, schedule: schedule, next: schedule.Next(time.Now())})
+}
+
+func (j *jobUseCase) Enqueue(name string, payload interface{}, runAt time.Time) (int, error) {
+	return j.enqueue(name, payload, runAt, nil)
+}
+
+func (j *jobUseCase) enqueue(name string, payload interface{}, runAt time.Time, uniqueKey *string) (int, error) {
+
+	if payload == nil {
+		payload = struct{}{}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	return j.repository.AddJob(name, string(data), runAt, jobMaxAttempts, uniqueKey)
+}
+
+// Start runs the workers and the scheduler in the background for as long as the server is up
+func (j *jobUseCase) Start() {
+
+	for i := 0; i < jobWorkers; i++ {
+		go func() {
+			for {
+				ran, err := j.RunNext()
+				if err != nil {
+					fmt.Println("could not run jobs:", err)
+				}
+				if !ran {
+					time.Sleep(jobPollInterval)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		for {
+			j.queueScheduled(time.Now())
+			time.Sleep(jobScheduleTick)
+		}
+	}()
+}
+
+// queueScheduled queues the runs that are due, every server does this but the unique key lets only one of them in
+func (j *jobUseCase) queueScheduled(now time.Time) {
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, s := range j.schedules {
+		if now.Before(s.next) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s@%d", s.name, s.next.Unix())
+		if _, err := j.enqueue(s.name, nil, s.next, &key); err != nil {
+			fmt.Println("could not queue scheduled job", s.name, ":", err)
+			continue
+		}
+
+		// runs missed while the server was down are not made up for
+		s.next = s.schedule.Next(now)
+	}
+}
+
+// RunNext claims and runs one due job, it reports whether there was one
+func (j *jobUseCase) RunNext() (bool, error) {
+
+	jobs, err := j.repository.ClaimDueJobs(1, jobLease)
+	if err != nil {
+		return false, err
+	}
+
+	if len(jobs) == 0 {
+		return false, nil
+	}
+
+	j.run(jobs[0])
+
+	return true, nil
+}
+
+func (j *jobUseCase) run(job models.Job) {
+
+	j.mu.RLock()
+	handler, ok := j.handlers[job.Name]
+	j.mu.RUnlock()
+
+	var err error
+	if !ok {
+		// nothing in this build knows the job, retrying will not help
+		err = j.repository.KillJob(job.ID, "no handler registered for "+job.Name)
+	} else if runErr := j.call(handler, job); runErr == nil {
+		err = j.repository.MarkJobDone(job.ID)
+	} else if job.Attempts >= job.MaxAttempts {
+		err = j.repository.KillJob(job.ID, runErr.Error())
+	} else {
+		next := time.Now().Add(time.Minute << (job.Attempts - 1))
+		err = j.repository.RetryJob(job.ID, next, runErr.Error())
+	}
+
+	if err != nil {
+		fmt.Println("could not update job", job.ID, ":", err)
+	}
+}
+
+// call keeps a panicking job from taking the worker down with it
+func (j *jobUseCase) call(handler services.JobHandler, job models.Job) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return handler(job)
+}
+
+func (j *jobUseCase) GetJobs(status string, page int) ([]models.JobDetails, error) {
+	return j.repository.GetJobs(status, page)
+}
+
+func (j *jobUseCase) GetJobStatus() (models.JobStatus, error) {
+
+	counts, err := j.repository.CountJobs()
+	if err != nil {
+		return models.JobStatus{}, err
+	}
+
+	j.mu.RLock()
+	schedules := make([]models.JobSchedule, 0, len(j.schedules))
+	for _, s := range j.schedules {
+		schedules = append(schedules, models.JobSchedule{Name: s.name, Spec: s.schedule.String(), NextRun: s.next})
+	}
+	j.mu.RUnlock()
+
+	sort.Slice(schedules, func(a, b int) bool { return schedules[a].NextRun.Before(schedules[b].NextRun) })
+
+	return models.JobStatus{Counts: counts, Schedules: schedules}, nil
+}
+
+// RetryJob gives a dead job a fresh set of attempts
+func (j *jobUseCase) RetryJob(id int) error {
+
+	revived, err := j.repository.ReviveJob(id)
+	if err != nil {
+		return err
+	}
+
+	if !revived {
+		return errors.New("only dead jobs can be retried")
+	}
+
+	return nil
+}
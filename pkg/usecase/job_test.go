@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Enqueue(t *testing.T) {
+
+	runAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+
+	testData := map[string]struct {
+		payload        interface{}
+		StubDetails    func(testMocks)
+		expectedOutput int
+		expectedError  error
+	}{
+		"payload is stored as json": {
+			payload: models.OrderPlacedEvent{OrderID: 4, UserID: 1},
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().AddJob("orders.remind", `{"order_id":4,"user_id":1,"final_price":0}`, runAt, 5, nil).Times(1).Return(3, nil)
+			},
+			expectedOutput: 3,
+			expectedError:  nil,
+		},
+		"no payload": {
+			payload: nil,
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().AddJob("orders.remind", `{}`, runAt, 5, nil).Times(1).Return(3, nil)
+			},
+			expectedOutput: 3,
+			expectedError:  nil,
+		},
+		"payload that is not json": {
+			payload:        make(chan int),
+			StubDetails:    func(mocks testMocks) {},
+			expectedOutput: 0,
+			expectedError:  errors.New("json: unsupported type: chan int"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			jobUseCase := mocks.newJobUseCase()
+			test.StubDetails(mocks)
+
+			id, err := jobUseCase.Enqueue("orders.remind", test.payload, runAt)
+			assert.Equal(t, test.expectedOutput, id)
+			if test.expectedError == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError.Error())
+			}
+		})
+	}
+}
+
+func Test_queueScheduled(t *testing.T) {
+
+	due := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	key := "coupons.expire@1792486800"
+
+	testData := map[string]struct {
+		now          time.Time
+		StubDetails  func(testMocks)
+		expectedNext time.Time
+	}{
+		"due run is queued once across servers": {
+			now: due.Add(time.Second * 10),
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().AddJob("coupons.expire", `{}`, due, 5, &key).Times(1).Return(3, nil)
+			},
+			expectedNext: due.Add(time.Minute * 5),
+		},
+		"missed runs are not made up for": {
+			now: due.Add(time.Minute * 22),
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().AddJob("coupons.expire", `{}`, due, 5, &key).Times(1).Return(3, nil)
+			},
+			expectedNext: due.Add(time.Minute * 25),
+		},
+		"run that could not be queued is tried again": {
+			now: due.Add(time.Second * 10),
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().AddJob("coupons.expire", `{}`, due, 5, &key).Times(1).Return(0, errors.New("error"))
+			},
+			expectedNext: due,
+		},
+		"not due yet": {
+			now:          due.Add(-time.Second),
+			StubDetails:  func(mocks testMocks) {},
+			expectedNext: due,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			jobUseCase := mocks.newJobUseCase()
+			jobUseCase.Schedule("*/5 * * * *", "coupons.expire")
+			jobUseCase.schedules[0].next = due
+			test.StubDetails(mocks)
+
+			jobUseCase.queueScheduled(test.now)
+			assert.True(t, test.expectedNext.Equal(jobUseCase.schedules[0].next), "next run is %v, want %v", jobUseCase.schedules[0].next, test.expectedNext)
+		})
+	}
+}
+
+func Test_RunNext(t *testing.T) {
+
+	job := models.Job{ID: 3, Name: "carts.remind_abandoned", Payload: `{}`, Attempts: 2, MaxAttempts: 5}
+
+	testData := map[string]struct {
+		handlerErr     error
+		handlerPanics  bool
+		StubDetails    func(testMocks)
+		expectedOutput bool
+		expectedError  error
+	}{
+		"job done": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{job}, nil),
+					mocks.jobRepo.EXPECT().MarkJobDone(3).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"failed job backs off": {
+			handlerErr: errors.New("smtp down"),
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{job}, nil),
+					mocks.jobRepo.EXPECT().RetryJob(3, gomock.Any(), "smtp down").Times(1).DoAndReturn(func(id int, next time.Time, lastError string) error {
+						// the second attempt failing waits 2 minutes
+						assert.WithinDuration(t, time.Now().Add(time.Minute*2), next, time.Second)
+						return nil
+					}),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"panicking job is retried": {
+			handlerPanics: true,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{job}, nil),
+					mocks.jobRepo.EXPECT().RetryJob(3, gomock.Any(), "job panicked: boom").Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"failed on the last attempt is dead lettered": {
+			handlerErr: errors.New("smtp down"),
+			StubDetails: func(mocks testMocks) {
+				last := job
+				last.Attempts = 5
+				gomock.InOrder(
+					mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{last}, nil),
+					mocks.jobRepo.EXPECT().KillJob(3, "smtp down").Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"job nothing knows of is dead lettered": {
+			StubDetails: func(mocks testMocks) {
+				unknown := job
+				unknown.Name = "carts.removed_job"
+				gomock.InOrder(
+					mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{unknown}, nil),
+					mocks.jobRepo.EXPECT().KillJob(3, "no handler registered for carts.removed_job").Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"nothing due": {
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return([]models.Job{}, nil)
+			},
+			expectedOutput: false,
+			expectedError:  nil,
+		},
+		"jobs could not be claimed": {
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().ClaimDueJobs(1, time.Minute*10).Times(1).Return(nil, errors.New("error"))
+			},
+			expectedOutput: false,
+			expectedError:  errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			jobUseCase := mocks.newJobUseCase()
+			jobUseCase.Register("carts.remind_abandoned", func(models.Job) error {
+				if test.handlerPanics {
+					panic("boom")
+				}
+				return test.handlerErr
+			})
+			test.StubDetails(mocks)
+
+			ran, err := jobUseCase.RunNext()
+			assert.Equal(t, test.expectedOutput, ran)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_RetryJob(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"dead job gets a fresh set of attempts": {
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().ReviveJob(3).Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		"job that is not dead": {
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().ReviveJob(3).Times(1).Return(false, nil)
+			},
+			expectedError: errors.New("only dead jobs can be retried"),
+		},
+		"error from the repository": {
+			StubDetails: func(mocks testMocks) {
+				mocks.jobRepo.EXPECT().ReviveJob(3).Times(1).Return(false, errors.New("error"))
+			},
+			expectedError: errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			jobUseCase := mocks.newJobUseCase()
+			test.StubDetails(mocks)
+
+			err := jobUseCase.RetryJob(3)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
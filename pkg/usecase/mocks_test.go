@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/mock/mockcourier"
+	"jerseyhub/pkg/mock/mockhelper"
+	"jerseyhub/pkg/mock/mockmailer"
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/mock/mockusecase"
+	services "jerseyhub/pkg/usecase/interface"
+
+	"github.com/golang/mock/gomock"
+)
+
+// testMocks holds a mock of everything the usecases depend on, the tests build the usecase they need from it.
+// Registering jobs and subscribing to events happens in the constructors so those are let through
+type testMocks struct {
+	adminRepo        *mockrepo.MockAdminRepository
+	alertRepo        *mockrepo.MockAlertRepository
+	cartRepo         *mockrepo.MockCartRepository
+	couponRepo       *mockrepo.MockCouponRepository
+	emailRepo        *mockrepo.MockEmailRepository
+	eventRepo        *mockrepo.MockEventRepository
+	inventoryRepo    *mockrepo.MockInventoryRepository
+	invoiceRepo      *mockrepo.MockInvoiceRepository
+	jobRepo          *mockrepo.MockJobRepository
+	notificationRepo *mockrepo.MockNotificationRepository
+	offerRepo        *mockrepo.MockOfferRepository
+	orderRepo        *mockrepo.MockOrderRepository
+	paymentRepo      *mockrepo.MockPaymentRepository
+	questionRepo     *mockrepo.MockQuestionRepository
+	returnRepo       *mockrepo.MockReturnRepository
+	reviewRepo       *mockrepo.MockReviewRepository
+	shipmentRepo     *mockrepo.MockShipmentRepository
+	shippingRepo     *mockrepo.MockShippingRepository
+	wishlistRepo     *mockrepo.MockWishlistRepository
+
+	alert        *mockusecase.MockAlertUseCase
+	email        *mockusecase.MockEmailUseCase
+	events       *mockusecase.MockEventUseCase
+	invoice      *mockusecase.MockInvoiceUseCase
+	jobs         *mockusecase.MockJobUseCase
+	notification *mockusecase.MockNotificationUseCase
+	orderUseCase *mockusecase.MockOrderUseCase
+	question     *mockusecase.MockQuestionUseCase
+	shipment     *mockusecase.MockShipmentUseCase
+	shipping     *mockusecase.MockShippingUseCase
+	userUseCase  *mockusecase.MockUserUseCase
+
+	courier *mockcourier.MockCourier
+	helper  *mockhelper.MockHelper
+	mailer  *mockmailer.MockMailer
+}
+
+func newTestMocks(ctrl *gomock.Controller) testMocks {
+
+	m := testMocks{
+		adminRepo:        mockrepo.NewMockAdminRepository(ctrl),
+		alertRepo:        mockrepo.NewMockAlertRepository(ctrl),
+		cartRepo:         mockrepo.NewMockCartRepository(ctrl),
+		couponRepo:       mockrepo.NewMockCouponRepository(ctrl),
+		emailRepo:        mockrepo.NewMockEmailRepository(ctrl),
+		eventRepo:        mockrepo.NewMockEventRepository(ctrl),
+		inventoryRepo:    mockrepo.NewMockInventoryRepository(ctrl),
+		invoiceRepo:      mockrepo.NewMockInvoiceRepository(ctrl),
+		jobRepo:          mockrepo.NewMockJobRepository(ctrl),
+		notificationRepo: mockrepo.NewMockNotificationRepository(ctrl),
+		offerRepo:        mockrepo.NewMockOfferRepository(ctrl),
+		orderRepo:        mockrepo.NewMockOrderRepository(ctrl),
+		paymentRepo:      mockrepo.NewMockPaymentRepository(ctrl),
+		questionRepo:     mockrepo.NewMockQuestionRepository(ctrl),
+		returnRepo:       mockrepo.NewMockReturnRepository(ctrl),
+		reviewRepo:       mockrepo.NewMockReviewRepository(ctrl),
+		shipmentRepo:     mockrepo.NewMockShipmentRepository(ctrl),
+		shippingRepo:     mockrepo.NewMockShippingRepository(ctrl),
+		wishlistRepo:     mockrepo.NewMockWishlistRepository(ctrl),
+
+		alert:        mockusecase.NewMockAlertUseCase(ctrl),
+		email:        mockusecase.NewMockEmailUseCase(ctrl),
+		events:       mockusecase.NewMockEventUseCase(ctrl),
+		invoice:      mockusecase.NewMockInvoiceUseCase(ctrl),
+		jobs:         mockusecase.NewMockJobUseCase(ctrl),
+		notification: mockusecase.NewMockNotificationUseCase(ctrl),
+		orderUseCase: mockusecase.NewMockOrderUseCase(ctrl),
+		question:     mockusecase.NewMockQuestionUseCase(ctrl),
+		shipment:     mockusecase.NewMockShipmentUseCase(ctrl),
+		shipping:     mockusecase.NewMockShippingUseCase(ctrl),
+		userUseCase:  mockusecase.NewMockUserUseCase(ctrl),
+
+		courier: mockcourier.NewMockCourier(ctrl),
+		helper:  mockhelper.NewMockHelper(ctrl),
+		mailer:  mockmailer.NewMockMailer(ctrl),
+	}
+
+	m.jobs.EXPECT().Register(gomock.Any(), gomock.Any()).AnyTimes()
+	m.jobs.EXPECT().Schedule(gomock.Any(), gomock.Any()).AnyTimes()
+	m.events.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return m
+}
+
+func (m testMocks) newAdminUseCase() services.AdminUseCase {
+	return NewAdminUseCase(m.adminRepo, m.helper)
+}
+
+func (m testMocks) newOrderUseCase(cfg config.Config) *orderUseCase {
+	return NewOrderUseCase(m.orderRepo, m.couponRepo, m.userUseCase, m.email, m.shipment, m.shipping, m.invoice, m.notification, m.jobs, cfg)
+}
+
+func (m testMocks) newReturnUseCase(cfg config.Config) *returnUseCase {
+	return NewReturnUseCase(m.returnRepo, m.orderRepo, m.offerRepo, m.orderUseCase, m.email, m.notification, m.helper, cfg)
+}
+
+func (m testMocks) newCartUseCase(cfg config.Config) *cartUseCase {
+	return NewCartUseCase(m.cartRepo, m.inventoryRepo, m.userUseCase, m.shipping, m.notification, m.couponRepo, m.helper, m.jobs, m.events, cfg)
+}
+
+func (m testMocks) newInventoryUseCase() *inventoryUseCase {
+	return NewInventoryUseCase(m.inventoryRepo, m.offerRepo, m.helper, m.wishlistRepo, m.question, m.alert, m.jobs)
+}
+
+func (m testMocks) newWishlistUseCase() *wishlistUseCase {
+	return NewWishlistUseCase(m.wishlistRepo, m.offerRepo, m.alert)
+}
+
+func (m testMocks) newReviewUseCase() *reviewUseCase {
+	return NewReviewUseCase(m.reviewRepo, m.helper)
+}
+
+func (m testMocks) newInvoiceUseCase(cfg config.Config) *invoiceUseCase {
+	return NewInvoiceUseCase(m.invoiceRepo, m.orderRepo, nil, cfg)
+}
+
+func (m testMocks) newPaymentUseCase(cfg config.Config) *paymentUsecase {
+	return NewPaymentUseCase(m.paymentRepo, cfg)
+}
+
+func (m testMocks) newShippingUseCase() *shippingUseCase {
+	return NewShippingUseCase(m.shippingRepo)
+}
+
+func (m testMocks) newShipmentUseCase() *shipmentUseCase {
+	return NewShipmentUseCase(m.shipmentRepo, m.orderRepo, m.courier, m.email)
+}
+
+func (m testMocks) newQuestionUseCase() *questionUseCase {
+	return NewQuestionUseCase(m.questionRepo, m.reviewRepo, m.inventoryRepo)
+}
+
+func (m testMocks) newAlertUseCase() *alertUseCase {
+	return NewAlertUseCase(m.alertRepo, m.notification, m.events)
+}
+
+func (m testMocks) newNotificationUseCase() *notificationUseCase {
+	return NewNotificationUseCase(m.notificationRepo, m.emailRepo, m.mailer, m.events)
+}
+
+func (m testMocks) newEventUseCase() *eventUseCase {
+	return NewEventUseCase(m.eventRepo)
+}
+
+func (m testMocks) newJobUseCase() *jobUseCase {
+	return NewJobUseCase(m.jobRepo)
+}
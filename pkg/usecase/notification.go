@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"jerseyhub/pkg/domain"
+	mailer_interface "jerseyhub/pkg/mailer/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+// notificationChannel is one way of reaching the user
+type notificationChannel interface {
+	Send(userID int, notification models.Notification) error
+}
+
+type inAppChannel struct {
+	repository interfaces.NotificationRepository
+}
+
+func (i inAppChannel) Send(userID int, notification models.Notification) error {
+	return i.repository.AddNotification(userID, notification)
+}
+
+type emailChannel struct {
+	repository interfaces.EmailRepository
+	mailer     mailer_interface.Mailer
+}
+
+func (e emailChannel) Send(userID int, notification models.Notification) error {
+
+	user, err := e.repository.GetEmailRecipient(userID)
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(user.Email, notification.Title, "notification.html", map[string]interface{}{
+		"Name":    user.Name,
+		"Title":   notification.Title,
+		"Message": notification.Message,
+	})
+}
+
+type notificationUseCase struct {
+	repository interfaces.NotificationRepository
+	inApp      notificationChannel
+	email      notificationChannel
+}
+
+func NewNotificationUseCase(repo interfaces.NotificationRepository, email interfaces.EmailRepository, m mailer_interface.Mailer, events services.EventUseCase) *notificationUseCase {
+	n := &notificationUseCase{
+		repository: repo,
+		inApp:      inAppChannel{repository: repo},
+		email:      emailChannel{repository: email, mailer: m},
+	}
+
+	events.Subscribe(models.EventOrderPlaced, "notification.order_placed", n.orderPlaced)
+	events.Subscribe(models.EventOrderStatusChanged, "notification.order_status", n.orderStatusChanged)
+	events.Subscribe(models.EventPaymentCaptured, "notification.payment_captured", n.paymentCaptured)
+
+	return n
+}
+
+// the same dedupe key is not sent again within this window
+const notificationDedupeWindow = time.Hour * 24
+
+// categories a user can switch off, everything is on until they do
+var notificationCategories = []string{"ORDER", "PAYMENT", "WALLET", "PRODUCT_ALERT", "CART"}
+
+// how many notifications of a category a user gets in a day, the rest are dropped
+var notificationLimits = map[string]int{
+	"PRODUCT_ALERT": 5,
+}
+
+var orderNotificationTitles = map[string]string{
+	"PENDING":   "Order #%d has been placed",
+	"SHIPPED":   "Order #%d has been shipped",
+	"DELIVERED": "Order #%d has been delivered",
+	"CANCELED":  "Order #%d has been canceled",
+}
+
+func (n *notificationUseCase) preference(userID int, category string) (models.NotificationPreference, error) {
+
+	preferences, err := n.GetPreferences(userID)
+	if err != nil {
+		return models.NotificationPreference{}, err
+	}
+
+	for _, preference := range preferences {
+		if preference.Category == category {
+			return preference, nil
+		}
+	}
+
+	return models.NotificationPreference{Category: category, Enabled: true, Email: true}, nil
+}
+
+// Notify is the one publisher every event goes through, it tells if the notification went out.
+// A switched off, duplicate or throttled notification is skipped without an error
+func (n *notificationUseCase) Notify(userID int, notification models.Notification) (bool, error) {
+
+	preference, err := n.preference(userID, notification.Category)
+	if err != nil {
+		return false, err
+	}
+
+	if !preference.Enabled {
+		return false, nil
+	}
+
+	if notification.DedupeKey != "" {
+		sent, err := n.repository.CheckNotificationSent(userID, notification.DedupeKey, time.Now().Add(-notificationDedupeWindow))
+		if err != nil {
+			return false, err
+		}
+		if sent {
+			return false, nil
+		}
+	}
+
+	if limit, ok := notificationLimits[notification.Category]; ok {
+		count, err := n.repository.CountNotifications(userID, notification.Category, time.Now().Add(-time.Hour*24))
+		if err != nil {
+			return false, err
+		}
+		if count >= limit {
+			return false, nil
+		}
+	}
+
+	// the in-app row is what dedupe and throttling look at, without it nothing was sent
+	if err := n.inApp.Send(userID, notification); err != nil {
+		return false, err
+	}
+
+	if notification.InAppOnly || !preference.Email {
+		return true, nil
+	}
+
+	if err := n.email.Send(userID, notification); err != nil {
+		fmt.Println("could not send notification mail to user", userID, ":", err)
+	}
+
+	return true, nil
+}
+
+func (n *notificationUseCase) orderPlaced(event models.Event) error {
+
+	var order models.OrderPlacedEvent
+	if err := json.Unmarshal([]byte(event.Payload), &order); err != nil {
+		return err
+	}
+
+	return n.publishOrderStatus(order.OrderID, order.UserID, "PENDING")
+}
+
+func (n *notificationUseCase) orderStatusChanged(event models.Event) error {
+
+	var order models.OrderStatusChangedEvent
+	if err := json.Unmarshal([]byte(event.Payload), &order); err != nil {
+		return err
+	}
+
+	return n.publishOrderStatus(order.OrderID, order.UserID, order.Status)
+}
+
+// publishOrderStatus goes along with the order status mails, so it is only shown in the app
+func (n *notificationUseCase) publishOrderStatus(orderID, userID int, status string) error {
+
+	title := fmt.Sprintf("Order #%d is now %s", orderID, status)
+	if format, ok := orderNotificationTitles[status]; ok {
+		title = fmt.Sprintf(format, orderID)
+	}
+
+	_, err := n.Notify(userID, models.Notification{
+		Category:  "ORDER",
+		Title:     title,
+		Link:      fmt.Sprintf("/users/profile/orders/%d", orderID),
+		DedupeKey: fmt.Sprintf("ORDER:%d:%s", orderID, status),
+		InAppOnly: true,
+	})
+
+	return err
+}
+
+func (n *notificationUseCase) paymentCaptured(event models.Event) error {
+
+	var payment models.PaymentCapturedEvent
+	if err := json.Unmarshal([]byte(event.Payload), &payment); err != nil {
+		return err
+	}
+
+	_, err := n.Notify(payment.UserID, models.Notification{
+		Category:  "PAYMENT",
+		Title:     fmt.Sprintf("Payment received for order #%d", payment.OrderID),
+		Message:   "We have received your payment, your order will be on its way soon.",
+		Link:      fmt.Sprintf("/users/profile/orders/%d", payment.OrderID),
+		DedupeKey: fmt.Sprintf("PAYMENT:%d", payment.OrderID),
+	})
+
+	return err
+}
+
+// PublishWalletCredit is sent for every refund, an order can be refunded in parts so there is no dedupe
+func (n *notificationUseCase) PublishWalletCredit(orderID int, amount float64) error {
+
+	userID, err := n.repository.GetOrderUser(orderID)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.Notify(userID, models.Notification{
+		Category:  "WALLET",
+		Title:     fmt.Sprintf("%.2f credited to your wallet", amount),
+		Message:   fmt.Sprintf("The refund for order #%d has been credited to your jerseyhub wallet.", orderID),
+		Link:      fmt.Sprintf("/users/profile/orders/%d", orderID),
+		InAppOnly: true,
+	})
+
+	return err
+}
+
+func (n *notificationUseCase) GetNotifications(userID, page int, unreadOnly bool) ([]domain.Notification, error) {
+	return n.repository.GetNotifications(userID, page, unreadOnly)
+}
+
+func (n *notificationUseCase) GetUnreadCount(userID int) (int, error) {
+	return n.repository.CountUnread(userID)
+}
+
+func (n *notificationUseCase) MarkRead(userID, id int) error {
+
+	marked, err := n.repository.MarkRead(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !marked {
+		return errors.New("notification does not exist")
+	}
+
+	return nil
+}
+
+func (n *notificationUseCase) MarkAllRead(userID int) error {
+	return n.repository.MarkAllRead(userID)
+}
+
+// GetPreferences fills in the defaults for the categories the user never changed
+func (n *notificationUseCase) GetPreferences(userID int) ([]models.NotificationPreference, error) {
+
+	saved, err := n.repository.GetPreferences(userID)
+	if err != nil {
+		return []models.NotificationPreference{}, err
+	}
+
+	preferences := make([]models.NotificationPreference, 0, len(notificationCategories))
+	for _, category := range notificationCategories {
+		preference := models.NotificationPreference{Category: category, Enabled: true, Email: true}
+		for _, s := range saved {
+			if s.Category == category {
+				preference = s
+			}
+		}
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+func (n *notificationUseCase) SetPreference(userID int, preference models.NotificationPreference) error {
+	return n.repository.SetPreference(userID, preference)
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Notify(t *testing.T) {
+
+	alert := models.Notification{Category: "PRODUCT_ALERT", Title: "Home Kit is back in stock", DedupeKey: "BACK_IN_STOCK:3"}
+	wallet := models.Notification{Category: "WALLET", Title: "100.00 credited to your wallet", InAppOnly: true}
+
+	testData := map[string]struct {
+		notification   models.Notification
+		StubDetails    func(testMocks)
+		expectedOutput bool
+		expectedError  error
+	}{
+		"sent in the app and by mail": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "BACK_IN_STOCK:3", gomock.Any()).Times(1).Return(false, nil),
+					mocks.notificationRepo.EXPECT().CountNotifications(1, "PRODUCT_ALERT", gomock.Any()).Times(1).Return(4, nil),
+					mocks.notificationRepo.EXPECT().AddNotification(1, alert).Times(1).Return(nil),
+					mocks.emailRepo.EXPECT().GetEmailRecipient(1).Times(1).Return(models.EmailRecipient{ID: 1, Name: "Arun", Email: "arun@gmail.com"}, nil),
+					mocks.mailer.EXPECT().Send("arun@gmail.com", "Home Kit is back in stock", "notification.html", gomock.Any()).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"mail failing still counts as sent": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "BACK_IN_STOCK:3", gomock.Any()).Times(1).Return(false, nil),
+					mocks.notificationRepo.EXPECT().CountNotifications(1, "PRODUCT_ALERT", gomock.Any()).Times(1).Return(0, nil),
+					mocks.notificationRepo.EXPECT().AddNotification(1, alert).Times(1).Return(nil),
+					mocks.emailRepo.EXPECT().GetEmailRecipient(1).Times(1).Return(models.EmailRecipient{}, errors.New("error")),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"in app only": {
+			notification: wallet,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().AddNotification(1, wallet).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"mails switched off": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{{Category: "PRODUCT_ALERT", Enabled: true, Email: false}}, nil),
+					mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "BACK_IN_STOCK:3", gomock.Any()).Times(1).Return(false, nil),
+					mocks.notificationRepo.EXPECT().CountNotifications(1, "PRODUCT_ALERT", gomock.Any()).Times(1).Return(0, nil),
+					mocks.notificationRepo.EXPECT().AddNotification(1, alert).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: true,
+			expectedError:  nil,
+		},
+		"category switched off": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{{Category: "PRODUCT_ALERT", Enabled: false}}, nil)
+			},
+			expectedOutput: false,
+			expectedError:  nil,
+		},
+		"already sent within a day": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "BACK_IN_STOCK:3", gomock.Any()).Times(1).Return(true, nil),
+				)
+			},
+			expectedOutput: false,
+			expectedError:  nil,
+		},
+		"daily limit reached": {
+			notification: alert,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "BACK_IN_STOCK:3", gomock.Any()).Times(1).Return(false, nil),
+					mocks.notificationRepo.EXPECT().CountNotifications(1, "PRODUCT_ALERT", gomock.Any()).Times(1).Return(5, nil),
+				)
+			},
+			expectedOutput: false,
+			expectedError:  nil,
+		},
+		"in app row could not be added": {
+			notification: wallet,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+					mocks.notificationRepo.EXPECT().AddNotification(1, wallet).Times(1).Return(errors.New("error")),
+				)
+			},
+			expectedOutput: false,
+			expectedError:  errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			notificationUseCase := mocks.newNotificationUseCase()
+			test.StubDetails(mocks)
+
+			sent, err := notificationUseCase.Notify(1, test.notification)
+			assert.Equal(t, test.expectedOutput, sent)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_orderStatusChanged(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	notificationUseCase := mocks.newNotificationUseCase()
+
+	gomock.InOrder(
+		mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+		mocks.notificationRepo.EXPECT().CheckNotificationSent(1, "ORDER:4:SHIPPED", gomock.Any()).Times(1).Return(false, nil),
+		mocks.notificationRepo.EXPECT().AddNotification(1, models.Notification{
+			Category:  "ORDER",
+			Title:     "Order #4 has been shipped",
+			Link:      "/users/profile/orders/4",
+			DedupeKey: "ORDER:4:SHIPPED",
+			InAppOnly: true,
+		}).Times(1).Return(nil),
+	)
+
+	err := notificationUseCase.orderStatusChanged(models.Event{Name: models.EventOrderStatusChanged, Payload: `{"order_id":4,"user_id":1,"status":"SHIPPED"}`})
+	assert.Nil(t, err)
+}
+
+func Test_PublishWalletCredit(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	notificationUseCase := mocks.newNotificationUseCase()
+
+	gomock.InOrder(
+		mocks.notificationRepo.EXPECT().GetOrderUser(4).Times(1).Return(1, nil),
+		mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{}, nil),
+		mocks.notificationRepo.EXPECT().AddNotification(1, models.Notification{
+			Category:  "WALLET",
+			Title:     "250.50 credited to your wallet",
+			Message:   "The refund for order #4 has been credited to your jerseyhub wallet.",
+			Link:      "/users/profile/orders/4",
+			InAppOnly: true,
+		}).Times(1).Return(nil),
+	)
+
+	err := notificationUseCase.PublishWalletCredit(4, 250.5)
+	assert.Nil(t, err)
+}
+
+func Test_MarkRead(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"success": {
+			StubDetails: func(mocks testMocks) {
+				mocks.notificationRepo.EXPECT().MarkRead(1, 9).Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		"notification of another user": {
+			StubDetails: func(mocks testMocks) {
+				mocks.notificationRepo.EXPECT().MarkRead(1, 9).Times(1).Return(false, nil)
+			},
+			expectedError: errors.New("notification does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			notificationUseCase := mocks.newNotificationUseCase()
+			test.StubDetails(mocks)
+
+			err := notificationUseCase.MarkRead(1, 9)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_GetPreferences(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	notificationUseCase := mocks.newNotificationUseCase()
+
+	mocks.notificationRepo.EXPECT().GetPreferences(1).Times(1).Return([]models.NotificationPreference{{Category: "CART", Enabled: false, Email: false}}, nil)
+
+	preferences, err := notificationUseCase.GetPreferences(1)
+	assert.Equal(t, []models.NotificationPreference{
+		{Category: "ORDER", Enabled: true, Email: true},
+		{Category: "PAYMENT", Enabled: true, Email: true},
+		{Category: "WALLET", Enabled: true, Email: true},
+		{Category: "PRODUCT_ALERT", Enabled: true, Email: true},
+		{Category: "CART", Enabled: false, Email: false},
+	}, preferences)
+	assert.Nil(t, err)
+}
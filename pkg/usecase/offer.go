@@ -1,26 +1,48 @@
 package usecase
 
 import (
+	"errors"
+	"time"
+
 	domain "jerseyhub/pkg/domain"
 	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
 )
 
 type offerUseCase struct {
-	repository interfaces.OfferRepository
+	repository   interfaces.OfferRepository
+	alertUseCase services.AlertUseCase
 }
 
-func NewOfferUseCase(repo interfaces.OfferRepository) *offerUseCase {
-	return &offerUseCase{
-		repository: repo,
+func NewOfferUseCase(repo interfaces.OfferRepository, alert services.AlertUseCase, jobs services.JobUseCase) *offerUseCase {
+	o := &offerUseCase{
+		repository:   repo,
+		alertUseCase: alert,
 	}
+
+	jobs.Register("offers.expire", o.expireOffers)
+	jobs.Schedule("*/5 * * * *", "offers.expire")
+
+	return o
+}
+
+func (off *offerUseCase) expireOffers(job models.Job) error {
+
+	return off.repository.ExpireOffers(time.Now())
 }
 
 func (off *offerUseCase) AddNewOffer(model models.OfferMaking) error {
+	if model.ExpiresAt != nil && !model.ExpiresAt.After(time.Now()) {
+		return errors.New("offer has to expire in the future")
+	}
+
 	if err := off.repository.AddNewOffer(model); err != nil {
 		return err
 	}
 
+	go off.alertUseCase.PricesChanged(0, model.CategoryID)
+
 	return nil
 }
 
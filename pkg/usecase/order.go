@@ -3,24 +3,76 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"jerseyhub/pkg/config"
 	domain "jerseyhub/pkg/domain"
 	interfaces "jerseyhub/pkg/repository/interface"
 	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
+	"math"
+	"time"
 )
 
 type orderUseCase struct {
 	orderRepository  interfaces.OrderRepository
 	couponRepository interfaces.CouponRepository
 	userUseCase      services.UserUseCase
+	emailUseCase     services.EmailUseCase
+	shipmentUseCase  services.ShipmentUseCase
+	shippingUseCase  services.ShippingUseCase
+	invoiceUseCase   services.InvoiceUseCase
+	notification     services.NotificationUseCase
+	paymentWindow    time.Duration
+	maxQuantity      int
 }
 
-func NewOrderUseCase(repo interfaces.OrderRepository, coup interfaces.CouponRepository, userUseCase services.UserUseCase) *orderUseCase {
-	return &orderUseCase{
+func NewOrderUseCase(repo interfaces.OrderRepository, coup interfaces.CouponRepository, userUseCase services.UserUseCase, email services.EmailUseCase, shipment services.ShipmentUseCase, shipping services.ShippingUseCase, invoice services.InvoiceUseCase, notification services.NotificationUseCase, jobs services.JobUseCase, cfg config.Config) *orderUseCase {
+	o := &orderUseCase{
 		orderRepository:  repo,
 		couponRepository: coup,
 		userUseCase:      userUseCase,
+		emailUseCase:     email,
+		shipmentUseCase:  shipment,
+		shippingUseCase:  shipping,
+		invoiceUseCase:   invoice,
+		notification:     notification,
+		paymentWindow:    paymentWindow(cfg),
+		maxQuantity:      maxOrderQuantity(cfg),
 	}
+
+	jobs.Register("orders.cancel_unpaid", o.cancelUnpaidOrders)
+	jobs.Schedule("*/5 * * * *", "orders.cancel_unpaid")
+
+	return o
+}
+
+// cancelUnpaidOrders cancels the orders paid online which were not paid within the payment window, the
+// stock they held goes back. It goes through all of them even when one fails, the job is retried for the ones left
+func (i *orderUseCase) cancelUnpaidOrders(job models.Job) error {
+
+	orders, err := i.orderRepository.GetUnpaidOrders(time.Now().Add(-i.paymentWindow))
+	if err != nil {
+		return err
+	}
+
+	var failed error
+	for _, id := range orders {
+		// the payment may have come in since the orders were looked up
+		details, err := i.orderRepository.GetIndividualOrderDetails(id)
+		if err != nil {
+			failed = err
+			continue
+		}
+		if details.PaymentStatus == "PAID" {
+			continue
+		}
+
+		if err := i.cancelOrder(details); err != nil {
+			fmt.Println("could not cancel unpaid order", id, ":", err)
+			failed = err
+		}
+	}
+
+	return failed
 }
 
 func (i *orderUseCase) GetOrders(id int) ([]domain.OrderDetailsWithImages, error) {
@@ -61,14 +113,48 @@ func (i *orderUseCase) GetOrders(id int) ([]domain.OrderDetailsWithImages, error
 
 func (i *orderUseCase) OrderItemsFromCart(userid int, addressid int, paymentid int, couponID int) error {
 
+	addresses, err := i.userUseCase.GetAddresses(userid)
+	if err != nil {
+		return err
+	}
+
+	var ownAddress bool
+	for _, v := range addresses {
+		if int(v.Id) == addressid {
+			ownAddress = true
+		}
+	}
+
+	if !ownAddress {
+		return errors.New("address does not exist")
+	}
+
 	cart, err := i.userUseCase.GetCart(userid)
 	if err != nil {
 		return err
 	}
 
+	// the cart flags these lines, they have to be fixed before ordering
+	for _, v := range cart.Data {
+		if v.Quantity > v.StockAvailable {
+			return fmt.Errorf("only %d of %s left in stock", v.StockAvailable, v.ProductName)
+		}
+		if v.Quantity > i.maxQuantity {
+			return fmt.Errorf("at most %d of %s can be ordered", i.maxQuantity, v.ProductName)
+		}
+	}
+
 	var total float64
+	var items int
 	for _, v := range cart.Data {
 		total = total + v.DiscountedPrice
+		items += v.Quantity
+	}
+
+	// free shipping is decided on the cart value before the coupon, same as shown in checkout
+	shipping, err := i.shippingUseCase.ShippingForOrder(addressid, paymentid, items, total)
+	if err != nil {
+		return err
 	}
 
 	//finding discount if any
@@ -77,47 +163,95 @@ func (i *orderUseCase) OrderItemsFromCart(userid int, addressid int, paymentid i
 		return err
 	}
 
-	totalDiscount := (total * float64(coupon.DiscountRate)) / 100
+	if couponID != 0 {
+		if err := checkCoupon(coupon, userid); err != nil {
+			return err
+		}
+	}
 
-	total = total - totalDiscount
+	totalDiscount := (total * float64(coupon.DiscountRate)) / 100
 
-	order_id, err := i.orderRepository.OrderItems(userid, addressid, paymentid, total, coupon.Coupon)
+	taxes, err := i.invoiceUseCase.ItemTaxes(addressid, cart.Data, coupon.DiscountRate)
 	if err != nil {
 		return err
 	}
 
-	if err := i.orderRepository.AddOrderProducts(order_id, cart.Data); err != nil {
+	total = total - totalDiscount + shipping.Shipping + shipping.CodSurcharge
+
+	order_id, err := i.orderRepository.OrderItems(userid, addressid, paymentid, total, coupon.Coupon, shipping, cart.Data, taxes)
+	if err != nil {
 		return err
 	}
 
+	// coupons given to a user can be used once
+	if coupon.UserID != nil {
+		if err := i.couponRepository.MakeCouponInvalid(int(coupon.ID)); err != nil {
+			fmt.Println("could not use up coupon", coupon.ID, ":", err)
+		}
+	}
+
 	for _, v := range cart.Data {
 		if err := i.userUseCase.RemoveFromCart(cart.ID, v.ID); err != nil {
 			return err
 		}
 	}
 
+	if err := i.emailUseCase.SendOrderStatusEmail(order_id, "PENDING"); err != nil {
+		fmt.Println("could not send order placed email:", err)
+	}
+
 	return nil
 
 }
 
-func (i *orderUseCase) CancelOrder(id int) error {
+func checkCoupon(coupon domain.Coupons, userID int) error {
 
-	//the order has to be less than status delivered (pending,shipped) to be canceled
-	status, err := i.orderRepository.CheckOrderStatusByID(id)
+	if coupon.ID == 0 || (coupon.UserID != nil && int(*coupon.UserID) != userID) {
+		return errors.New("coupon does not exist")
+	}
+
+	if !coupon.Valid || (coupon.ExpiresAt != nil && !coupon.ExpiresAt.After(time.Now())) {
+		return errors.New("coupon is not valid any more")
+	}
+
+	return nil
+}
+
+func (i *orderUseCase) CancelOrder(userID, id int) error {
+
+	details, err := i.checkOrderOwner(userID, id)
 	if err != nil {
 		return err
 	}
 
-	if status != "PENDING" {
+	return i.cancelOrder(details)
+}
+
+// cancelOrder cancels every unit not shipped yet, the caller has made sure of whose order it is
+func (i *orderUseCase) cancelOrder(details models.IndividualOrderDetails) error {
+
+	//the order has to be less than status delivered (pending,shipped) to be canceled
+	if details.OrderStatus != "PENDING" {
 		return errors.New("order cannot be canceled if you accidently booked kindly return the product")
 	}
 
-	err = i.orderRepository.CancelOrder(id)
+	items, err := i.orderRepository.GetOrderItemStates(details.OrderID)
 	if err != nil {
 		return err
 	}
-	return nil
 
+	var request []models.OrderItemRequest
+	for _, v := range items {
+		if left := v.Quantity - v.CanceledQuantity - v.ReturnedQuantity - v.Shipped; left > 0 {
+			request = append(request, models.OrderItemRequest{OrderItemID: v.ID, Quantity: left})
+		}
+	}
+
+	if len(request) == 0 {
+		return errors.New("nothing left in the order to cancel")
+	}
+
+	return i.cancelOrderItems(details, request)
 }
 
 func (i *orderUseCase) EditOrderStatus(status string, id int) error {
@@ -126,6 +260,11 @@ func (i *orderUseCase) EditOrderStatus(status string, id int) error {
 	if err != nil {
 		return err
 	}
+
+	if err := i.emailUseCase.SendOrderStatusEmail(id, status); err != nil {
+		fmt.Println("could not send order status email:", err)
+	}
+
 	return nil
 
 }
@@ -169,61 +308,190 @@ func (i *orderUseCase) AdminOrders() (domain.AdminOrdersResponse, error) {
 
 }
 
-func (i *orderUseCase) ReturnOrder(id int) error {
+// CancelOrderItems cancels units which are not shipped yet, prepaid orders get the prorated amount back in
+// the wallet
+func (i *orderUseCase) CancelOrderItems(userID, orderID int, request []models.OrderItemRequest) error {
 
-	//should check if the order is already returned peoples will misuse this security breach
-	// and will get  unlimited money into their wallet
-	status, err := i.orderRepository.CheckOrderStatusByID(id)
+	details, err := i.checkOrderOwner(userID, orderID)
 	if err != nil {
 		return err
 	}
 
-	if status == "RETURNED" {
-		return errors.New("order already returned")
-	}
+	return i.cancelOrderItems(details, request)
+}
 
-	//should also check if the order is already returned
-	//or users will also earn money by returning pending orders by opting COD
+func (i *orderUseCase) cancelOrderItems(details models.IndividualOrderDetails, request []models.OrderItemRequest) error {
 
-	if status != "DELIVERED" {
-		return errors.New("user is trying to return an order which is still not delivered")
+	orderID := details.OrderID
+	if details.OrderStatus != "PENDING" && details.OrderStatus != "SHIPPED" {
+		return errors.New("items can only be canceled before the order is delivered")
 	}
 
-	//make order as returned order
-	if err := i.orderRepository.ReturnOrder(id); err != nil {
+	refund, err := i.changeOrderItems(details, request, false)
+	if err != nil {
 		return err
 	}
 
-	//find amount to be credited to user
-	amount, err := i.orderRepository.FindAmountFromOrderID(id)
+	status, err := i.orderRepository.CheckOrderStatusByID(orderID)
 	if err != nil {
 		return err
 	}
 
-	//find the user
-	userID, err := i.orderRepository.FindUserIdFromOrderID(id)
+	if status == "CANCELED" {
+		if err := i.emailUseCase.SendOrderStatusEmail(orderID, "CANCELED"); err != nil {
+			fmt.Println("could not send order canceled email:", err)
+		}
+	} else if err := i.shipmentUseCase.CompleteOrder(orderID); err != nil {
+		// the units left might all be delivered already
+		fmt.Println("could not check if the order is complete:", err)
+	}
+
+	if refund > 0 {
+		if err := i.emailUseCase.SendRefundProcessedEmail(orderID, refund); err != nil {
+			fmt.Println("could not send refund processed email:", err)
+		}
+		if err := i.notification.PublishWalletCredit(orderID, refund); err != nil {
+			fmt.Println("could not publish wallet credit notification:", err)
+		}
+	}
+
+	return nil
+}
+
+// ReturnOrderItems returns delivered units and credits the prorated amount to the wallet, it is
+// called once a return request passes inspection
+func (i *orderUseCase) ReturnOrderItems(userID, orderID int, request []models.OrderItemRequest) (float64, error) {
+
+	details, err := i.checkOrderOwner(userID, orderID)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	//find if the user having a wallet
-	walletID, err := i.orderRepository.FindWalletIdFromUserID(userID)
+
+	if details.OrderStatus != "DELIVERED" {
+		return 0, errors.New("items can only be returned after the order is delivered")
+	}
+
+	refund, err := i.changeOrderItems(details, request, true)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	//if no wallet create new one
-	if walletID == 0 {
-		walletID, err = i.orderRepository.CreateNewWallet(userID)
-		if err != nil {
-			return err
+
+	if err := i.emailUseCase.SendRefundProcessedEmail(orderID, refund); err != nil {
+		fmt.Println("could not send refund processed email:", err)
+	}
+
+	if err := i.notification.PublishWalletCredit(orderID, refund); err != nil {
+		fmt.Println("could not publish wallet credit notification:", err)
+	}
+
+	return refund, nil
+}
+
+func (i *orderUseCase) checkOrderOwner(userID, orderID int) (models.IndividualOrderDetails, error) {
+
+	owner, err := i.orderRepository.FindUserIdFromOrderID(orderID)
+	if err != nil {
+		return models.IndividualOrderDetails{}, err
+	}
+
+	if owner == 0 || owner != userID {
+		return models.IndividualOrderDetails{}, errors.New("order does not exist")
+	}
+
+	return i.orderRepository.GetIndividualOrderDetails(orderID)
+}
+
+// changeOrderItems works out the refund of each line and applies it. A unit is refunded what was paid for
+// it, which is the offer price less its share of the coupon, and shipping goes back once nothing is left
+func (i *orderUseCase) changeOrderItems(details models.IndividualOrderDetails, request []models.OrderItemRequest, returned bool) (float64, error) {
+
+	orderID := details.OrderID
+	items, err := i.orderRepository.GetOrderItemStates(orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	// orders placed before tax was stored per item only have the order total to go by
+	var legacyTotal float64
+	for _, v := range items {
+		legacyTotal += v.TotalPrice
+	}
+	itemsPaid := details.TotalAmount - details.ShippingCharge - details.CodCharge
+
+	// a cash on delivery order which is not delivered yet has nothing to refund
+	refundable := returned || details.PaymentStatus == "PAID"
+
+	action := "canceled"
+	if returned {
+		action = "returned"
+	}
+
+	requested := make(map[int]int)
+	for _, v := range request {
+		requested[v.OrderItemID] += v.Quantity
+	}
+
+	var changes []models.OrderItemChange
+	var refund float64
+	itemsLeft := false
+	for _, v := range items {
+		left := v.Quantity - v.CanceledQuantity - v.ReturnedQuantity
+		quantity := requested[v.ID]
+		delete(requested, v.ID)
+
+		if quantity > 0 {
+			available := left
+			if !returned {
+				available = left - v.Shipped
+			}
+			if quantity > available {
+				return 0, fmt.Errorf("only %d of order item %d can be %s", available, v.ID, action)
+			}
+
+			change := models.OrderItemChange{OrderItemID: v.ID, Quantity: quantity}
+			if refundable {
+				linePaid := v.LinePaid
+				if linePaid == 0 && legacyTotal > 0 {
+					linePaid = itemsPaid * v.TotalPrice / legacyTotal
+				}
+
+				// the last units get exactly what is left of the line so the rounding of earlier refunds evens out
+				if quantity == left {
+					change.Refund = roundToPaise(linePaid - v.RefundedAmount)
+				} else {
+					change.Refund = math.Min(roundToPaise(linePaid*float64(quantity)/float64(v.Quantity)), roundToPaise(linePaid-v.RefundedAmount))
+				}
+			}
+
+			changes = append(changes, change)
+			refund += change.Refund
+			left -= quantity
+		}
+
+		if left > 0 {
+			itemsLeft = true
 		}
 	}
-	//credit the amount into users wallet
-	if err := i.orderRepository.CreditToUserWallet(amount, walletID); err != nil {
-		return err
+
+	if len(requested) > 0 {
+		return 0, errors.New("item does not belong to the order")
 	}
 
-	return nil
+	var extra float64
+	if !itemsLeft && refundable {
+		extra = details.ShippingCharge + details.CodCharge
+	}
+
+	userID, err := i.orderRepository.FindUserIdFromOrderID(orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := i.orderRepository.UpdateOrderItems(orderID, userID, changes, returned, extra); err != nil {
+		return 0, err
+	}
 
+	return roundToPaise(refund + extra), nil
 }
 
 func (i *orderUseCase) MakePaymentStatusAsPaid(id int) error {
@@ -250,5 +518,12 @@ func (i *orderUseCase) GetIndividualOrderDetails(id int) (models.IndividualOrder
 
 	details.Products = productDetail
 
+	shipments, err := i.shipmentUseCase.GetShipments(id)
+	if err != nil {
+		return models.IndividualOrderDetails{}, err
+	}
+
+	details.Shipments = shipments
+
 	return details, nil
 }
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CancelOrderItems(t *testing.T) {
+
+	paidOrder := models.IndividualOrderDetails{OrderID: 1, TotalAmount: 140, ShippingCharge: 40, OrderStatus: "PENDING", PaymentStatus: "PAID"}
+	codOrder := models.IndividualOrderDetails{OrderID: 1, TotalAmount: 140, ShippingCharge: 40, OrderStatus: "PENDING", PaymentStatus: "NOT PAID"}
+
+	testData := map[string]struct {
+		request       []models.OrderItemRequest
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"prorated refund for some of the units": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(paidOrder, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 3, TotalPrice: 100, LinePaid: 100}}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 1, Refund: 33.33}}, false, float64(0)).Times(1).Return(nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipment.EXPECT().CompleteOrder(1).Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, 33.33).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, 33.33).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"last units get what is left of the line along with shipping": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(paidOrder, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 3, CanceledQuantity: 2, TotalPrice: 100, LinePaid: 100, RefundedAmount: 66.66}}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 1, Refund: 33.34}}, false, float64(40)).Times(1).Return(nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("CANCELED", nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "CANCELED").Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, 73.34).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, 73.34).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"unpaid cash on delivery order refunds nothing": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 3}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(codOrder, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 3, TotalPrice: 100, LinePaid: 100}}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 3}}, false, float64(0)).Times(1).Return(nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("CANCELED", nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "CANCELED").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"shipped units can not be canceled": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 3}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(paidOrder, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 3, Shipped: 1, TotalPrice: 100, LinePaid: 100}}, nil),
+				)
+			},
+			expectedError: errors.New("only 2 of order item 11 can be canceled"),
+		},
+		"delivered order can not be canceled": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, OrderStatus: "DELIVERED", PaymentStatus: "PAID"}, nil)
+			},
+			expectedError: errors.New("items can only be canceled before the order is delivered"),
+		},
+		"order of another user": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(6, nil)
+			},
+			expectedError: errors.New("order does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			orderUseCase := mocks.newOrderUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			err := orderUseCase.CancelOrderItems(5, 1, test.request)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ReturnOrderItems(t *testing.T) {
+
+	delivered := models.IndividualOrderDetails{OrderID: 1, TotalAmount: 290, ShippingCharge: 40, OrderStatus: "DELIVERED", PaymentStatus: "PAID"}
+
+	testData := map[string]struct {
+		request        []models.OrderItemRequest
+		StubDetails    func(testMocks)
+		expectedOutput float64
+		expectedError  error
+	}{
+		"refund is credited to the wallet": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 2}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(delivered, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{
+						{ID: 11, Quantity: 2, TotalPrice: 200, LinePaid: 180},
+						{ID: 12, Quantity: 1, TotalPrice: 100, LinePaid: 70},
+					}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 2, Refund: 180}}, true, float64(0)).Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, float64(180)).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, float64(180)).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: 180,
+			expectedError:  nil,
+		},
+		"orders without tax per item share what was paid for the items": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(delivered, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{
+						{ID: 11, Quantity: 2, TotalPrice: 200},
+						{ID: 12, Quantity: 1, TotalPrice: 100},
+					}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 1, Refund: 83.33}}, true, float64(0)).Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, 83.33).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, 83.33).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: 83.33,
+			expectedError:  nil,
+		},
+		"returning everything gives back shipping": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 2}, {OrderItemID: 12, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(delivered, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{
+						{ID: 11, Quantity: 2, TotalPrice: 200, LinePaid: 180},
+						{ID: 12, Quantity: 1, TotalPrice: 100, LinePaid: 70},
+					}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 2, Refund: 180}, {OrderItemID: 12, Quantity: 1, Refund: 70}}, true, float64(40)).Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, float64(290)).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, float64(290)).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: 290,
+			expectedError:  nil,
+		},
+		"replacement from an exchange refunds what it carried over": {
+			request: []models.OrderItemRequest{{OrderItemID: 31, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				// the replacement is not charged, its total and line are the 450 carried from the original
+				replacement := models.IndividualOrderDetails{OrderID: 1, TotalAmount: 450, OrderStatus: "DELIVERED", PaymentStatus: "PAID"}
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(replacement, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{
+						{ID: 31, Quantity: 1, TotalPrice: 450, LinePaid: 450},
+					}, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 31, Quantity: 1, Refund: 450}}, true, float64(0)).Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, float64(450)).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, float64(450)).Times(1).Return(nil),
+				)
+			},
+			expectedOutput: 450,
+			expectedError:  nil,
+		},
+		"order is not delivered yet": {
+			request: []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, OrderStatus: "SHIPPED", PaymentStatus: "PAID"}, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("items can only be returned after the order is delivered"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			orderUseCase := mocks.newOrderUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			refund, err := orderUseCase.ReturnOrderItems(5, 1, test.request)
+			assert.Equal(t, test.expectedOutput, refund)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_CancelOrder(t *testing.T) {
+
+	order := models.IndividualOrderDetails{OrderID: 1, TotalAmount: 140, ShippingCharge: 40, OrderStatus: "PENDING", PaymentStatus: "PAID"}
+
+	testData := map[string]struct {
+		userID        int
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"everything not shipped is canceled and refunded": {
+			userID: 5,
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).AnyTimes().Return(5, nil)
+				items := []models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 100, LinePaid: 100}}
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(order, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return(items, nil),
+					mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return(items, nil),
+					mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 2, Refund: 100}}, false, float64(40)).Times(1).Return(nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("CANCELED", nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "CANCELED").Times(1).Return(nil),
+					mocks.email.EXPECT().SendRefundProcessedEmail(1, 140.0).Times(1).Return(nil),
+					mocks.notification.EXPECT().PublishWalletCredit(1, 140.0).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"order of another user": {
+			userID: 7,
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil)
+			},
+			expectedError: errors.New("order does not exist"),
+		},
+		"order that does not exist": {
+			userID: 5,
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(0, nil)
+			},
+			expectedError: errors.New("order does not exist"),
+		},
+		"shipped order": {
+			userID: 5,
+			StubDetails: func(mocks testMocks) {
+				shipped := order
+				shipped.OrderStatus = "SHIPPED"
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil),
+					mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(shipped, nil),
+				)
+			},
+			expectedError: errors.New("order cannot be canceled if you accidently booked kindly return the product"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			orderUseCase := mocks.newOrderUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			err := orderUseCase.CancelOrder(test.userID, 1)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_cancelUnpaidOrders(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	orderUseCase := mocks.newOrderUseCase(config.Config{})
+
+	mocks.orderRepo.EXPECT().FindUserIdFromOrderID(gomock.Any()).AnyTimes().Return(5, nil)
+	gomock.InOrder(
+		mocks.orderRepo.EXPECT().GetUnpaidOrders(gomock.Any()).Times(1).DoAndReturn(func(placedBefore time.Time) ([]int, error) {
+			// orders are looked up once the default window of 30 minutes is over
+			assert.WithinDuration(t, time.Now().Add(-30*time.Minute), placedBefore, time.Minute)
+			return []int{1, 2}, nil
+		}),
+		mocks.orderRepo.EXPECT().GetIndividualOrderDetails(1).Times(1).Return(models.IndividualOrderDetails{OrderID: 1, TotalAmount: 140, ShippingCharge: 40, OrderStatus: "PENDING", PaymentStatus: "NOT PAID"}, nil),
+		mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 100, LinePaid: 100}}, nil),
+		mocks.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 100, LinePaid: 100}}, nil),
+		mocks.orderRepo.EXPECT().UpdateOrderItems(1, 5, []models.OrderItemChange{{OrderItemID: 11, Quantity: 2}}, false, float64(0)).Times(1).Return(nil),
+		mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("CANCELED", nil),
+		mocks.email.EXPECT().SendOrderStatusEmail(1, "CANCELED").Times(1).Return(nil),
+		// the payment of the second order came in after the lookup so it is left alone
+		mocks.orderRepo.EXPECT().GetIndividualOrderDetails(2).Times(1).Return(models.IndividualOrderDetails{OrderID: 2, OrderStatus: "PENDING", PaymentStatus: "PAID"}, nil),
+	)
+
+	err := orderUseCase.cancelUnpaidOrders(models.Job{})
+	assert.NoError(t, err)
+}
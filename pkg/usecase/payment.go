@@ -1,23 +1,112 @@
 package usecase
 
 import (
+	"errors"
+	"jerseyhub/pkg/config"
 	interfaces "jerseyhub/pkg/repository/interface"
 	"jerseyhub/pkg/utils/models"
 	"strconv"
+	"time"
 
 	"github.com/razorpay/razorpay-go"
 )
 
+const defaultPaymentWindowMinutes = 30
+
+// paymentWindow is how long an order paid online waits for its payment before it is canceled
+func paymentWindow(cfg config.Config) time.Duration {
+
+	minutes := cfg.PAYMENT_WINDOW_MINUTES
+	if minutes <= 0 {
+		minutes = defaultPaymentWindowMinutes
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
 type paymentUsecase struct {
-	repository interfaces.PaymentRepository
+	repository    interfaces.PaymentRepository
+	paymentWindow time.Duration
 }
 
-func NewPaymentUseCase(repo interfaces.PaymentRepository) *paymentUsecase {
+func NewPaymentUseCase(repo interfaces.PaymentRepository, cfg config.Config) *paymentUsecase {
 	return &paymentUsecase{
-		repository: repo,
+		repository:    repo,
+		paymentWindow: paymentWindow(cfg),
 	}
 }
 
+func createGatewayOrder(amount float64) (string, error) {
+
+	client := razorpay.NewClient("rzp_test_pfmFeCViv6CU5K", "TWCh1tyyZZsIxjYSOmmRrLLg")
+
+	data := map[string]interface{}{
+		"amount":   int(amount) * 100,
+		"currency": "INR",
+		"receipt":  "some_receipt_id",
+	}
+
+	body, err := client.Order.Create(data, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return body["id"].(string), nil
+}
+
+// payableOrder gives the order if it can still be paid for online, along with when the window closes
+func (p *paymentUsecase) payableOrder(orderID int) (models.OrderPayment, time.Time, error) {
+
+	order, err := p.repository.GetOrderPayment(orderID)
+	if err != nil {
+		return models.OrderPayment{}, time.Time{}, err
+	}
+
+	if order.OrderID == 0 {
+		return models.OrderPayment{}, time.Time{}, errors.New("order does not exist")
+	}
+
+	if order.PaymentStatus == "PAID" {
+		return models.OrderPayment{}, time.Time{}, errors.New("order is already paid for")
+	}
+
+	if order.OrderStatus != "PENDING" {
+		return models.OrderPayment{}, time.Time{}, errors.New("order is " + order.OrderStatus + ", it can not be paid for")
+	}
+
+	if order.CashOnDelivery {
+		return models.OrderPayment{}, time.Time{}, errors.New("order is paid on delivery")
+	}
+
+	payBefore := order.CreatedAt.Add(p.paymentWindow)
+	if !time.Now().Before(payBefore) {
+		return models.OrderPayment{}, time.Time{}, errors.New("the payment window of the order is over")
+	}
+
+	return order, payBefore, nil
+}
+
+// RetryPayment makes a new gateway order for an order the user did not finish paying for, as long as the
+// payment window is open
+func (p *paymentUsecase) RetryPayment(userID, orderID int) (models.RetryPayment, error) {
+
+	order, payBefore, err := p.payableOrder(orderID)
+	if err != nil {
+		return models.RetryPayment{}, err
+	}
+
+	if order.UserID != userID {
+		return models.RetryPayment{}, errors.New("order does not exist")
+	}
+
+	razorID, err := createGatewayOrder(order.FinalPrice)
+	if err != nil {
+		return models.RetryPayment{}, err
+	}
+
+	return models.RetryPayment{OrderID: orderID, RazorID: razorID, FinalPrice: order.FinalPrice, PayBefore: payBefore}, nil
+}
+
 func (p *paymentUsecase) MakePaymentRazorPay(orderID string, userID string) (models.OrderPaymentDetails, error) {
 	var orderDetails models.OrderPaymentDetails
 	//get orderid
@@ -35,37 +124,20 @@ func (p *paymentUsecase) MakePaymentRazorPay(orderID string, userID string) (mod
 
 	orderDetails.UserID = newuserid
 
-	//get username
-	username, err := p.repository.FindUsername(newuserid)
+	// a canceled order or one whose window is over can not be paid for any more
+	order, _, err := p.payableOrder(newid)
 	if err != nil {
 		return models.OrderPaymentDetails{}, err
 	}
 
-	orderDetails.Username = username
+	orderDetails.Username = order.Username
+	orderDetails.FinalPrice = order.FinalPrice
 
-	//get total
-	newfinal, err := p.repository.FindPrice(newid)
+	razorPayOrderID, err := createGatewayOrder(orderDetails.FinalPrice)
 	if err != nil {
 		return models.OrderPaymentDetails{}, err
 	}
 
-	orderDetails.FinalPrice = newfinal
-
-	client := razorpay.NewClient("rzp_test_pfmFeCViv6CU5K", "TWCh1tyyZZsIxjYSOmmRrLLg")
-
-	data := map[string]interface{}{
-		"amount":   int(orderDetails.FinalPrice) * 100,
-		"currency": "INR",
-		"receipt":  "some_receipt_id",
-	}
-
-	body, err := client.Order.Create(data, nil)
-	if err != nil {
-		return models.OrderPaymentDetails{}, nil
-	}
-
-	razorPayOrderID := body["id"].(string)
-
 	orderDetails.Razor_id = razorPayOrderID
 
 	return orderDetails, nil
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RetryPayment(t *testing.T) {
+
+	testData := map[string]struct {
+		order         models.OrderPayment
+		expectedError error
+	}{
+		"payment window is over": {
+			order:         models.OrderPayment{OrderID: 1, UserID: 5, FinalPrice: 140, OrderStatus: "PENDING", PaymentStatus: "NOT PAID", CreatedAt: time.Now().Add(-16 * time.Minute)},
+			expectedError: errors.New("the payment window of the order is over"),
+		},
+		"order canceled for not being paid": {
+			order:         models.OrderPayment{OrderID: 1, UserID: 5, FinalPrice: 140, OrderStatus: "CANCELED", PaymentStatus: "NOT PAID", CreatedAt: time.Now().Add(-time.Hour)},
+			expectedError: errors.New("order is CANCELED, it can not be paid for"),
+		},
+		"order already paid for": {
+			order:         models.OrderPayment{OrderID: 1, UserID: 5, FinalPrice: 140, OrderStatus: "PENDING", PaymentStatus: "PAID", CreatedAt: time.Now()},
+			expectedError: errors.New("order is already paid for"),
+		},
+		"cash on delivery order": {
+			order:         models.OrderPayment{OrderID: 1, UserID: 5, FinalPrice: 140, OrderStatus: "PENDING", PaymentStatus: "NOT PAID", CashOnDelivery: true, CreatedAt: time.Now()},
+			expectedError: errors.New("order is paid on delivery"),
+		},
+		"order of another user": {
+			order:         models.OrderPayment{OrderID: 1, UserID: 6, FinalPrice: 140, OrderStatus: "PENDING", PaymentStatus: "NOT PAID", CreatedAt: time.Now()},
+			expectedError: errors.New("order does not exist"),
+		},
+		"order that does not exist": {
+			order:         models.OrderPayment{},
+			expectedError: errors.New("order does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			paymentUseCase := mocks.newPaymentUseCase(config.Config{PAYMENT_WINDOW_MINUTES: 15})
+			mocks.paymentRepo.EXPECT().GetOrderPayment(1).Times(1).Return(test.order, nil)
+
+			retry, err := paymentUseCase.RetryPayment(5, 1)
+			assert.Equal(t, models.RetryPayment{}, retry)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
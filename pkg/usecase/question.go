@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"errors"
+
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type questionUseCase struct {
+	repo                interfaces.QuestionRepository
+	reviewRepository    interfaces.ReviewRepository
+	inventoryRepository interfaces.InventoryRepository
+}
+
+func NewQuestionUseCase(repo interfaces.QuestionRepository, review interfaces.ReviewRepository, inventory interfaces.InventoryRepository) *questionUseCase {
+	return &questionUseCase{
+		repo:                repo,
+		reviewRepository:    review,
+		inventoryRepository: inventory,
+	}
+}
+
+func (q *questionUseCase) AskQuestion(userID int, question models.AddQuestion) (int, error) {
+
+	exists, err := q.inventoryRepository.CheckInventory(question.InventoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		return 0, errors.New("product does not exist")
+	}
+
+	return q.repo.AddQuestion(userID, question)
+}
+
+// AnswerQuestion takes answers from admins, userID 0, and from users who have received the product
+func (q *questionUseCase) AnswerQuestion(userID, questionID int, answer string) (int, error) {
+
+	question, err := q.repo.GetQuestion(questionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if question.ID == 0 {
+		return 0, errors.New("question does not exist")
+	}
+
+	if userID != 0 {
+		delivered, err := q.reviewRepository.CheckDeliveredPurchase(userID, question.InventoryID)
+		if err != nil {
+			return 0, err
+		}
+
+		if !delivered {
+			return 0, errors.New("only buyers who have received the product can answer")
+		}
+	}
+
+	return q.repo.AddAnswer(questionID, userID, answer)
+}
+
+// GetProductQuestions gives a page of questions on a product with their answers
+func (q *questionUseCase) GetProductQuestions(inventoryID, page int) ([]models.QuestionDetails, error) {
+
+	questions, err := q.repo.GetProductQuestions(inventoryID, page)
+	if err != nil {
+		return []models.QuestionDetails{}, err
+	}
+
+	for k := range questions {
+		answers, err := q.repo.GetAnswers(questions[k].ID)
+		if err != nil {
+			return []models.QuestionDetails{}, err
+		}
+		questions[k].Answers = answers
+	}
+
+	return questions, nil
+}
+
+func (q *questionUseCase) GetUnansweredQuestions(page int) ([]models.QuestionDetails, error) {
+	return q.repo.GetUnansweredQuestions(page)
+}
+
+// VoteAnswer upvotes an answer, or takes the upvote back
+func (q *questionUseCase) VoteAnswer(userID, id int, upvote bool) error {
+
+	answer, err := q.repo.GetAnswer(id)
+	if err != nil {
+		return err
+	}
+
+	if answer.ID == 0 {
+		return errors.New("answer does not exist")
+	}
+
+	if !upvote {
+		return q.repo.RemoveAnswerVote(id, userID)
+	}
+
+	if !answer.ByAdmin && answer.UserID == userID {
+		return errors.New("you cannot upvote your own answer")
+	}
+
+	return q.repo.AddAnswerVote(id, userID)
+}
+
+func (q *questionUseCase) DeleteQuestion(id int) error {
+
+	question, err := q.repo.GetQuestion(id)
+	if err != nil {
+		return err
+	}
+
+	if question.ID == 0 {
+		return errors.New("question does not exist")
+	}
+
+	return q.repo.DeleteQuestion(id)
+}
+
+func (q *questionUseCase) DeleteAnswer(id int) error {
+
+	answer, err := q.repo.GetAnswer(id)
+	if err != nil {
+		return err
+	}
+
+	if answer.ID == 0 {
+		return errors.New("answer does not exist")
+	}
+
+	return q.repo.DeleteAnswer(id)
+}
@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AskQuestion(t *testing.T) {
+
+	question := models.AddQuestion{InventoryID: 3, Question: "Is this the player version?"}
+
+	testData := map[string]struct {
+		StubDetails    func(testMocks)
+		expectedOutput int
+		expectedError  error
+	}{
+		"success": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.inventoryRepo.EXPECT().CheckInventory(3).Times(1).Return(true, nil),
+					mocks.questionRepo.EXPECT().AddQuestion(1, question).Times(1).Return(7, nil),
+				)
+			},
+			expectedOutput: 7,
+			expectedError:  nil,
+		},
+		"product does not exist": {
+			StubDetails: func(mocks testMocks) {
+				mocks.inventoryRepo.EXPECT().CheckInventory(3).Times(1).Return(false, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("product does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			questionUseCase := mocks.newQuestionUseCase()
+			test.StubDetails(mocks)
+
+			id, err := questionUseCase.AskQuestion(1, question)
+			assert.Equal(t, test.expectedOutput, id)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_AnswerQuestion(t *testing.T) {
+
+	question := models.QuestionDetails{ID: 7, InventoryID: 3}
+
+	testData := map[string]struct {
+		userID         int
+		StubDetails    func(testMocks)
+		expectedOutput int
+		expectedError  error
+	}{
+		"admin answers without a purchase": {
+			userID: 0,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(question, nil),
+					mocks.questionRepo.EXPECT().AddAnswer(7, 0, "yes").Times(1).Return(2, nil),
+				)
+			},
+			expectedOutput: 2,
+			expectedError:  nil,
+		},
+		"buyer who received the product": {
+			userID: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(question, nil),
+					mocks.reviewRepo.EXPECT().CheckDeliveredPurchase(1, 3).Times(1).Return(true, nil),
+					mocks.questionRepo.EXPECT().AddAnswer(7, 1, "yes").Times(1).Return(3, nil),
+				)
+			},
+			expectedOutput: 3,
+			expectedError:  nil,
+		},
+		"user who has not received the product": {
+			userID: 1,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(question, nil),
+					mocks.reviewRepo.EXPECT().CheckDeliveredPurchase(1, 3).Times(1).Return(false, nil),
+				)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("only buyers who have received the product can answer"),
+		},
+		"question does not exist": {
+			userID: 1,
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(models.QuestionDetails{}, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("question does not exist"),
+		},
+		"error fetching the question": {
+			userID: 1,
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(models.QuestionDetails{}, errors.New("error"))
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			questionUseCase := mocks.newQuestionUseCase()
+			test.StubDetails(mocks)
+
+			id, err := questionUseCase.AnswerQuestion(test.userID, 7, "yes")
+			assert.Equal(t, test.expectedOutput, id)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_GetProductQuestions(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	questionUseCase := mocks.newQuestionUseCase()
+
+	answers := []models.AnswerDetails{{ID: 2, QuestionID: 7, Answer: "yes", Upvotes: 4}}
+	gomock.InOrder(
+		mocks.questionRepo.EXPECT().GetProductQuestions(3, 1).Times(1).Return([]models.QuestionDetails{{ID: 7}, {ID: 8}}, nil),
+		mocks.questionRepo.EXPECT().GetAnswers(7).Times(1).Return(answers, nil),
+		mocks.questionRepo.EXPECT().GetAnswers(8).Times(1).Return([]models.AnswerDetails{}, nil),
+	)
+
+	questions, err := questionUseCase.GetProductQuestions(3, 1)
+	assert.Equal(t, []models.QuestionDetails{{ID: 7, Answers: answers}, {ID: 8, Answers: []models.AnswerDetails{}}}, questions)
+	assert.Nil(t, err)
+}
+
+func Test_VoteAnswer(t *testing.T) {
+
+	testData := map[string]struct {
+		upvote        bool
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"upvote": {
+			upvote: true,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{ID: 2, UserID: 5}, nil),
+					mocks.questionRepo.EXPECT().AddAnswerVote(2, 1).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"take the upvote back": {
+			upvote: false,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{ID: 2, UserID: 1}, nil),
+					mocks.questionRepo.EXPECT().RemoveAnswerVote(2, 1).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"own answer": {
+			upvote: true,
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{ID: 2, UserID: 1}, nil)
+			},
+			expectedError: errors.New("you cannot upvote your own answer"),
+		},
+		"admin answer is not anyone's own": {
+			upvote: true,
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{ID: 2, ByAdmin: true, UserID: 1}, nil),
+					mocks.questionRepo.EXPECT().AddAnswerVote(2, 1).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"answer does not exist": {
+			upvote: true,
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{}, nil)
+			},
+			expectedError: errors.New("answer does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			questionUseCase := mocks.newQuestionUseCase()
+			test.StubDetails(mocks)
+
+			err := questionUseCase.VoteAnswer(1, 2, test.upvote)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_DeleteQuestion(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"success": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(models.QuestionDetails{ID: 7}, nil),
+					mocks.questionRepo.EXPECT().DeleteQuestion(7).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"question does not exist": {
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetQuestion(7).Times(1).Return(models.QuestionDetails{}, nil)
+			},
+			expectedError: errors.New("question does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			questionUseCase := mocks.newQuestionUseCase()
+			test.StubDetails(mocks)
+
+			err := questionUseCase.DeleteQuestion(7)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_DeleteAnswer(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"success": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{ID: 2}, nil),
+					mocks.questionRepo.EXPECT().DeleteAnswer(2).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"answer does not exist": {
+			StubDetails: func(mocks testMocks) {
+				mocks.questionRepo.EXPECT().GetAnswer(2).Times(1).Return(models.AnswerDetails{}, nil)
+			},
+			expectedError: errors.New("answer does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			questionUseCase := mocks.newQuestionUseCase()
+			test.StubDetails(mocks)
+
+			err := questionUseCase.DeleteAnswer(2)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
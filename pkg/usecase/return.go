@@ -0,0 +1,543 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"mime/multipart"
+	"time"
+
+	"jerseyhub/pkg/config"
+	helper_interface "jerseyhub/pkg/helper/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+const (
+	defaultReturnWindowDays = 7
+	maxReturnImages         = 5
+)
+
+var returnReasons = map[string]bool{
+	"WRONG_SIZE":       true,
+	"DAMAGED":          true,
+	"DEFECTIVE":        true,
+	"WRONG_ITEM":       true,
+	"NOT_AS_DESCRIBED": true,
+	"CHANGED_MIND":     true,
+	"OTHER":            true,
+}
+
+type returnUseCase struct {
+	repo            interfaces.ReturnRepository
+	orderRepository interfaces.OrderRepository
+	offerRepository interfaces.OfferRepository
+	orderUseCase    services.OrderUseCase
+	emailUseCase    services.EmailUseCase
+	notification    services.NotificationUseCase
+	helper          helper_interface.Helper
+	windowDays      int
+}
+
+func NewReturnUseCase(repo interfaces.ReturnRepository, orderRepo interfaces.OrderRepository, offer interfaces.OfferRepository, order services.OrderUseCase, email services.EmailUseCase, notification services.NotificationUseCase, h helper_interface.Helper, cfg config.Config) *returnUseCase {
+
+	window := cfg.RETURN_WINDOW_DAYS
+	if window <= 0 {
+		window = defaultReturnWindowDays
+	}
+
+	return &returnUseCase{
+		repo:            repo,
+		orderRepository: orderRepo,
+		offerRepository: offer,
+		orderUseCase:    order,
+		emailUseCase:    email,
+		notification:    notification,
+		helper:          h,
+		windowDays:      window,
+	}
+}
+
+func (r *returnUseCase) RequestReturn(userID, orderID int, request models.ReturnRequest) (int, error) {
+
+	if !returnReasons[request.ReasonCode] {
+		return 0, errors.New("invalid reason code")
+	}
+
+	if err := r.checkReturnWindow(userID, orderID); err != nil {
+		return 0, err
+	}
+
+	items, err := r.returnableItems(orderID, request.Items)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.repo.CreateReturnRequest(orderID, userID, request, items)
+}
+
+// RequestExchange asks to swap an item for another size of the same product, the new size is held right
+// away. Only a size costing the same or less can be exchanged for, what it is cheaper by is refunded
+// once the original is back
+func (r *returnUseCase) RequestExchange(userID, orderID int, request models.ExchangeRequest) (int, error) {
+
+	if err := r.checkReturnWindow(userID, orderID); err != nil {
+		return 0, err
+	}
+
+	if _, err := r.returnableItems(orderID, []models.OrderItemRequest{{OrderItemID: request.OrderItemID, Quantity: request.Quantity}}); err != nil {
+		return 0, err
+	}
+
+	original, err := r.repo.GetOrderItemProduct(request.OrderItemID)
+	if err != nil {
+		return 0, err
+	}
+
+	product, err := r.repo.GetExchangeProduct(request.InventoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.InventoryID == 0 || product.ProductName != original.ProductName || product.CategoryID != original.CategoryID {
+		return 0, errors.New("items can only be exchanged for another size of the same product")
+	}
+
+	if product.InventoryID == original.InventoryID {
+		return 0, errors.New("choose a different size to exchange for")
+	}
+
+	if product.Stock < request.Quantity {
+		return 0, errors.New("the size asked for is out of stock")
+	}
+
+	difference, err := r.priceDifference(orderID, request, product)
+	if err != nil {
+		return 0, err
+	}
+
+	// there is no way to collect the difference on a replacement, the dearer size has to be ordered
+	if difference > 0 {
+		return 0, errors.New("the size asked for costs more, return the item and order it instead")
+	}
+
+	return r.repo.CreateExchangeRequest(orderID, userID, request, difference)
+}
+
+// priceDifference compares what the new size costs today with what was paid for the item
+func (r *returnUseCase) priceDifference(orderID int, request models.ExchangeRequest, product models.ExchangeProduct) (float64, error) {
+
+	items, err := r.orderRepository.GetOrderItemStates(orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	var paid float64
+	for _, v := range items {
+		if v.ID == request.OrderItemID {
+			paid = unitPaid(v)
+		}
+	}
+
+	offer, err := r.offerRepository.FindDiscountPercentage(product.CategoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	price := product.Price - product.Price*float64(offer)/100
+	return roundToPaise((price - paid) * float64(request.Quantity)), nil
+}
+
+// unitPaid is what one unit of an order item was paid for
+func unitPaid(item models.OrderItemState) float64 {
+
+	linePaid := item.LinePaid
+	if linePaid == 0 {
+		// orders placed before tax was stored per item
+		linePaid = item.TotalPrice
+	}
+
+	return linePaid / float64(item.Quantity)
+}
+
+func (r *returnUseCase) checkReturnWindow(userID, orderID int) error {
+
+	owner, err := r.orderRepository.FindUserIdFromOrderID(orderID)
+	if err != nil {
+		return err
+	}
+
+	if owner == 0 || owner != userID {
+		return errors.New("order does not exist")
+	}
+
+	status, err := r.orderRepository.CheckOrderStatusByID(orderID)
+	if err != nil {
+		return err
+	}
+
+	if status != "DELIVERED" {
+		return errors.New("only delivered orders can be returned")
+	}
+
+	delivered, err := r.repo.GetDeliveryDate(orderID)
+	if err != nil {
+		return err
+	}
+
+	if time.Since(delivered) > time.Duration(r.windowDays)*24*time.Hour {
+		return fmt.Errorf("orders can only be returned within %d days of delivery", r.windowDays)
+	}
+
+	return nil
+}
+
+// returnableItems checks the asked quantities against what is neither canceled, returned nor in another
+// open return. Nothing asked means everything left
+func (r *returnUseCase) returnableItems(orderID int, request []models.OrderItemRequest) ([]models.OrderItemRequest, error) {
+
+	states, err := r.orderRepository.GetOrderItemStates(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := r.repo.GetOpenReturnQuantities(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[int]int)
+	for _, v := range open {
+		pending[v.OrderItemID] = v.Quantity
+	}
+
+	available := make(map[int]int)
+	var all []models.OrderItemRequest
+	for _, v := range states {
+		left := v.Quantity - v.CanceledQuantity - v.ReturnedQuantity - pending[v.ID]
+		available[v.ID] = left
+		if left > 0 {
+			all = append(all, models.OrderItemRequest{OrderItemID: v.ID, Quantity: left})
+		}
+	}
+
+	if len(request) == 0 {
+		if len(all) == 0 {
+			return nil, errors.New("nothing left in the order to return")
+		}
+		return all, nil
+	}
+
+	requested := make(map[int]int)
+	var items []models.OrderItemRequest
+	for _, v := range request {
+		left, ok := available[v.OrderItemID]
+		if !ok {
+			return nil, errors.New("item does not belong to the order")
+		}
+
+		requested[v.OrderItemID] += v.Quantity
+		if requested[v.OrderItemID] > left {
+			return nil, fmt.Errorf("only %d of order item %d can be returned", left, v.OrderItemID)
+		}
+
+		items = append(items, v)
+	}
+
+	return items, nil
+}
+
+func (r *returnUseCase) AddReturnImage(userID, id int, image *multipart.FileHeader) error {
+
+	request, err := r.getReturnRequest(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if request.Status != "REQUESTED" {
+		return errors.New("photos can only be added before the return is reviewed")
+	}
+
+	count, err := r.repo.CountReturnImages(id)
+	if err != nil {
+		return err
+	}
+
+	if count >= maxReturnImages {
+		return fmt.Errorf("a return can have at most %d photos", maxReturnImages)
+	}
+
+	url, err := r.helper.AddImageToS3(image)
+	if err != nil {
+		return err
+	}
+
+	return r.repo.AddReturnImage(id, url)
+}
+
+// GetReturnRequests lists the returns of a user, userID 0 lists everyone's for the admin
+func (r *returnUseCase) GetReturnRequests(userID int, status string, page int) ([]models.ReturnRequestDetails, error) {
+
+	requests, err := r.repo.GetReturnRequests(userID, status, page)
+	if err != nil {
+		return []models.ReturnRequestDetails{}, err
+	}
+
+	for k := range requests {
+		if err := r.fillReturnRequest(&requests[k]); err != nil {
+			return []models.ReturnRequestDetails{}, err
+		}
+	}
+
+	return requests, nil
+}
+
+// GetReturnRequest gives a return along with its items and photos, userID 0 is the admin
+func (r *returnUseCase) GetReturnRequest(userID, id int) (models.ReturnRequestDetails, error) {
+
+	request, err := r.getReturnRequest(userID, id)
+	if err != nil {
+		return models.ReturnRequestDetails{}, err
+	}
+
+	if err := r.fillReturnRequest(&request); err != nil {
+		return models.ReturnRequestDetails{}, err
+	}
+
+	return request, nil
+}
+
+func (r *returnUseCase) getReturnRequest(userID, id int) (models.ReturnRequestDetails, error) {
+
+	request, err := r.repo.GetReturnRequest(id)
+	if err != nil {
+		return models.ReturnRequestDetails{}, err
+	}
+
+	if request.ID == 0 || (userID != 0 && request.UserID != userID) {
+		return models.ReturnRequestDetails{}, errors.New("return request does not exist")
+	}
+
+	return request, nil
+}
+
+func (r *returnUseCase) fillReturnRequest(request *models.ReturnRequestDetails) error {
+
+	items, err := r.repo.GetReturnItems(request.ID)
+	if err != nil {
+		return err
+	}
+
+	images, err := r.repo.GetReturnImages(request.ID)
+	if err != nil {
+		return err
+	}
+
+	request.Items = items
+	request.Images = images
+	return nil
+}
+
+// ApproveReturn lets a return go ahead, the replacement of an exchange is only placed once the original
+// is back and passes the inspection
+func (r *returnUseCase) ApproveReturn(id int, note string) error {
+	return r.moveReturn(id, "REQUESTED", "APPROVED", note)
+}
+
+func (r *returnUseCase) RejectReturn(id int, note string) error {
+
+	if note == "" {
+		return errors.New("a note telling the user why is needed to reject a return")
+	}
+
+	request, err := r.getReturnRequest(0, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.moveReturn(id, "REQUESTED", "REJECTED", note); err != nil {
+		return err
+	}
+
+	if request.Kind == "EXCHANGE" {
+		if err := r.repo.ReleaseExchangeStock(id); err != nil {
+			fmt.Println("could not release the stock held for exchange", id, ":", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *returnUseCase) SchedulePickup(id int, date time.Time) error {
+
+	if _, err := r.getReturnRequest(0, id); err != nil {
+		return err
+	}
+
+	ok, err := r.repo.SchedulePickup(id, date)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errors.New("pickup can only be scheduled for approved returns")
+	}
+
+	return nil
+}
+
+func (r *returnUseCase) ReceiveReturn(id int) error {
+	return r.moveReturn(id, "PICKUP_SCHEDULED", "RECEIVED", "")
+}
+
+// InspectReturn closes a received return, the refund is only issued when the items pass
+func (r *returnUseCase) InspectReturn(id int, passed bool, note string) error {
+
+	request, err := r.getReturnRequest(0, id)
+	if err != nil {
+		return err
+	}
+
+	if !passed {
+		if err := r.moveReturn(id, "RECEIVED", "INSPECTION_FAILED", note); err != nil {
+			return err
+		}
+
+		if request.Kind == "EXCHANGE" {
+			if err := r.repo.ReleaseExchangeStock(id); err != nil {
+				fmt.Println("could not release the stock held for exchange", id, ":", err)
+			}
+		}
+
+		return nil
+	}
+
+	if request.Kind == "EXCHANGE" {
+		return r.completeExchange(request, note)
+	}
+
+	// claim the return first so it is never refunded twice
+	if err := r.moveReturn(id, "RECEIVED", "REFUNDED", note); err != nil {
+		return err
+	}
+
+	items, err := r.repo.GetReturnItems(id)
+	if err != nil {
+		return r.undoRefund(id, err)
+	}
+
+	var returned []models.OrderItemRequest
+	for _, v := range items {
+		returned = append(returned, models.OrderItemRequest{OrderItemID: v.OrderItemID, Quantity: v.Quantity})
+	}
+
+	refund, err := r.orderUseCase.ReturnOrderItems(request.UserID, request.OrderID, returned)
+	if err != nil {
+		return r.undoRefund(id, err)
+	}
+
+	if err := r.repo.SetReturnRefund(id, refund); err != nil {
+		// the money is already in the wallet, only the amount shown on the return is missing
+		fmt.Println("could not save the refund of return", id, ":", err)
+	}
+
+	return nil
+}
+
+// completeExchange takes the original item back and places its replacement, it is only refunded what the
+// new size was cheaper by
+func (r *returnUseCase) completeExchange(request models.ReturnRequestDetails, note string) error {
+
+	if err := r.moveReturn(request.ID, "RECEIVED", "EXCHANGED", note); err != nil {
+		return err
+	}
+
+	items, err := r.repo.GetReturnItems(request.ID)
+	if err != nil {
+		return r.undoExchange(request.ID, err)
+	}
+
+	states, err := r.orderRepository.GetOrderItemStates(request.OrderID)
+	if err != nil {
+		return r.undoExchange(request.ID, err)
+	}
+
+	refund := math.Max(-request.PriceDifference, 0)
+
+	// the replacement stands for what was paid for the original, so a later return of it refunds no more
+	var paid float64
+	var changes []models.OrderItemChange
+	for _, v := range items {
+		for _, state := range states {
+			if state.ID == v.OrderItemID {
+				paid += unitPaid(state) * float64(v.Quantity)
+			}
+		}
+		changes = append(changes, models.OrderItemChange{OrderItemID: v.OrderItemID, Quantity: v.Quantity, Refund: refund})
+	}
+
+	orderID, err := r.repo.CompleteExchange(request.ID, changes, roundToPaise(paid-refund))
+	if err != nil {
+		return r.undoExchange(request.ID, err)
+	}
+
+	if err := r.emailUseCase.SendOrderStatusEmail(orderID, "PENDING"); err != nil {
+		fmt.Println("could not send replacement order email:", err)
+	}
+
+	if refund == 0 {
+		return nil
+	}
+
+	if err := r.repo.SetReturnRefund(request.ID, refund); err != nil {
+		fmt.Println("could not save the refund of return", request.ID, ":", err)
+	}
+
+	if err := r.emailUseCase.SendRefundProcessedEmail(request.OrderID, refund); err != nil {
+		fmt.Println("could not send refund processed email:", err)
+	}
+
+	if err := r.notification.PublishWalletCredit(request.OrderID, refund); err != nil {
+		fmt.Println("could not publish wallet credit notification:", err)
+	}
+
+	return nil
+}
+
+func (r *returnUseCase) undoExchange(id int, cause error) error {
+
+	if _, err := r.repo.UpdateReturnStatus(id, "EXCHANGED", "RECEIVED", ""); err != nil {
+		fmt.Println("could not put return", id, "back to received:", err)
+	}
+
+	return cause
+}
+
+func (r *returnUseCase) undoRefund(id int, cause error) error {
+
+	if _, err := r.repo.UpdateReturnStatus(id, "REFUNDED", "RECEIVED", ""); err != nil {
+		fmt.Println("could not put return", id, "back to received:", err)
+	}
+
+	return cause
+}
+
+func (r *returnUseCase) moveReturn(id int, from, to, note string) error {
+
+	request, err := r.getReturnRequest(0, id)
+	if err != nil {
+		return err
+	}
+
+	ok, err := r.repo.UpdateReturnStatus(id, from, to, note)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("return is %s, it has to be %s for this", request.Status, from)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InspectReturn(t *testing.T) {
+
+	exchangeInventory := 31
+	received := models.ReturnRequestDetails{ID: 7, OrderID: 1, UserID: 5, Status: "RECEIVED", Kind: "RETURN"}
+	receivedExchange := models.ReturnRequestDetails{ID: 7, OrderID: 1, UserID: 5, Status: "RECEIVED", Kind: "EXCHANGE", ExchangeInventoryID: &exchangeInventory, PriceDifference: -50}
+	items := []models.ReturnItem{{OrderItemID: 11, Quantity: 1}}
+
+	testData := map[string]struct {
+		passed        bool
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"passed return is refunded": {
+			passed: true,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(received, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "REFUNDED", "ok").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().GetReturnItems(7).Times(1).Return(items, nil),
+					m.orderUseCase.EXPECT().ReturnOrderItems(5, 1, []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}}).Times(1).Return(480.0, nil),
+					m.returnRepo.EXPECT().SetReturnRefund(7, 480.0).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"refund that fails puts the return back to received": {
+			passed: true,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(received, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "REFUNDED", "ok").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().GetReturnItems(7).Times(1).Return(items, nil),
+					m.orderUseCase.EXPECT().ReturnOrderItems(5, 1, []models.OrderItemRequest{{OrderItemID: 11, Quantity: 1}}).Times(1).Return(0.0, errors.New("error")),
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "REFUNDED", "RECEIVED", "").Times(1).Return(true, nil),
+				)
+			},
+			expectedError: errors.New("error"),
+		},
+		"failed return refunds nothing": {
+			passed: false,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(received, nil)
+				m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "INSPECTION_FAILED", "ok").Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		"failed exchange puts back the stock held for it": {
+			passed: false,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(receivedExchange, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "INSPECTION_FAILED", "ok").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().ReleaseExchangeStock(7).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"passed exchange places the replacement and refunds what it was cheaper by": {
+			passed: true,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(receivedExchange, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "EXCHANGED", "ok").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().GetReturnItems(7).Times(1).Return(items, nil),
+					m.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 1000, LinePaid: 1000}}, nil),
+					m.returnRepo.EXPECT().CompleteExchange(7, []models.OrderItemChange{{OrderItemID: 11, Quantity: 1, Refund: 50}}, 450.0).Times(1).Return(20, nil),
+					m.email.EXPECT().SendOrderStatusEmail(20, "PENDING").Times(1).Return(nil),
+					m.returnRepo.EXPECT().SetReturnRefund(7, 50.0).Times(1).Return(nil),
+					m.email.EXPECT().SendRefundProcessedEmail(1, 50.0).Times(1).Return(nil),
+					m.notification.EXPECT().PublishWalletCredit(1, 50.0).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"exchange that can not be completed goes back to received": {
+			passed: true,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(receivedExchange, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "EXCHANGED", "ok").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().GetReturnItems(7).Times(1).Return(items, nil),
+					m.orderRepo.EXPECT().GetOrderItemStates(1).Times(1).Return([]models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 1000, LinePaid: 1000}}, nil),
+					m.returnRepo.EXPECT().CompleteExchange(7, gomock.Any(), 450.0).Times(1).Return(0, errors.New("error")),
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "EXCHANGED", "RECEIVED", "").Times(1).Return(true, nil),
+				)
+			},
+			expectedError: errors.New("error"),
+		},
+		"return that is not received yet": {
+			passed: true,
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(models.ReturnRequestDetails{ID: 7, OrderID: 1, UserID: 5, Status: "APPROVED", Kind: "RETURN"}, nil)
+				m.returnRepo.EXPECT().UpdateReturnStatus(7, "RECEIVED", "REFUNDED", "ok").Times(1).Return(false, nil)
+			},
+			expectedError: errors.New("return is APPROVED, it has to be RECEIVED for this"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			returnUseCase := mocks.newReturnUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			err := returnUseCase.InspectReturn(7, test.passed, "ok")
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_RejectReturn(t *testing.T) {
+
+	testData := map[string]struct {
+		note          string
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"rejected exchange puts back the stock held for it": {
+			note: "item was worn",
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(models.ReturnRequestDetails{ID: 7, Status: "REQUESTED", Kind: "EXCHANGE"}, nil)
+				gomock.InOrder(
+					m.returnRepo.EXPECT().UpdateReturnStatus(7, "REQUESTED", "REJECTED", "item was worn").Times(1).Return(true, nil),
+					m.returnRepo.EXPECT().ReleaseExchangeStock(7).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"approved return can not be rejected": {
+			note: "item was worn",
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(models.ReturnRequestDetails{ID: 7, Status: "APPROVED", Kind: "RETURN"}, nil)
+				m.returnRepo.EXPECT().UpdateReturnStatus(7, "REQUESTED", "REJECTED", "item was worn").Times(1).Return(false, nil)
+			},
+			expectedError: errors.New("return is APPROVED, it has to be REQUESTED for this"),
+		},
+		"note is needed": {
+			note: "",
+			StubDetails: func(m testMocks) {
+			},
+			expectedError: errors.New("a note telling the user why is needed to reject a return"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			returnUseCase := mocks.newReturnUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			err := returnUseCase.RejectReturn(7, test.note)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ApproveReturn(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"requested exchange is approved without a replacement yet": {
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(models.ReturnRequestDetails{ID: 7, Status: "REQUESTED", Kind: "EXCHANGE"}, nil)
+				m.returnRepo.EXPECT().UpdateReturnStatus(7, "REQUESTED", "APPROVED", "").Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		"return of another user is not found": {
+			StubDetails: func(m testMocks) {
+				m.returnRepo.EXPECT().GetReturnRequest(7).AnyTimes().Return(models.ReturnRequestDetails{}, nil)
+			},
+			expectedError: errors.New("return request does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			returnUseCase := mocks.newReturnUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			err := returnUseCase.ApproveReturn(7, "")
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_RequestExchange(t *testing.T) {
+
+	request := models.ExchangeRequest{OrderItemID: 11, Quantity: 1, InventoryID: 31}
+	original := models.ExchangeProduct{InventoryID: 30, ProductName: "Home Jersey", CategoryID: 2, Size: "M", Stock: 4, Price: 1000}
+	states := []models.OrderItemState{{ID: 11, Quantity: 2, TotalPrice: 2000, LinePaid: 1800}}
+
+	testData := map[string]struct {
+		StubDetails    func(testMocks)
+		expectedOutput int
+		expectedError  error
+	}{
+		"cheaper size is held for the exchange": {
+			StubDetails: func(m testMocks) {
+				m.orderRepo.EXPECT().GetOrderItemStates(1).AnyTimes().Return(states, nil)
+				gomock.InOrder(
+					m.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil),
+					m.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("DELIVERED", nil),
+					m.returnRepo.EXPECT().GetDeliveryDate(1).Times(1).Return(time.Now(), nil),
+					m.returnRepo.EXPECT().GetOpenReturnQuantities(1).Times(1).Return([]models.ReturnItem{}, nil),
+					m.returnRepo.EXPECT().GetOrderItemProduct(11).Times(1).Return(original, nil),
+					m.returnRepo.EXPECT().GetExchangeProduct(31).Times(1).Return(models.ExchangeProduct{InventoryID: 31, ProductName: "Home Jersey", CategoryID: 2, Size: "L", Stock: 2, Price: 1000}, nil),
+					m.offerRepo.EXPECT().FindDiscountPercentage(2).Times(1).Return(20, nil),
+					m.returnRepo.EXPECT().CreateExchangeRequest(1, 5, request, -100.0).Times(1).Return(7, nil),
+				)
+			},
+			expectedOutput: 7,
+			expectedError:  nil,
+		},
+		"dearer size can not be exchanged for": {
+			StubDetails: func(m testMocks) {
+				m.orderRepo.EXPECT().GetOrderItemStates(1).AnyTimes().Return(states, nil)
+				gomock.InOrder(
+					m.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil),
+					m.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("DELIVERED", nil),
+					m.returnRepo.EXPECT().GetDeliveryDate(1).Times(1).Return(time.Now(), nil),
+					m.returnRepo.EXPECT().GetOpenReturnQuantities(1).Times(1).Return([]models.ReturnItem{}, nil),
+					m.returnRepo.EXPECT().GetOrderItemProduct(11).Times(1).Return(original, nil),
+					m.returnRepo.EXPECT().GetExchangeProduct(31).Times(1).Return(models.ExchangeProduct{InventoryID: 31, ProductName: "Home Jersey", CategoryID: 2, Size: "L", Stock: 2, Price: 1000}, nil),
+					m.offerRepo.EXPECT().FindDiscountPercentage(2).Times(1).Return(0, nil),
+				)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("the size asked for costs more, return the item and order it instead"),
+		},
+		"other products can not be exchanged for": {
+			StubDetails: func(m testMocks) {
+				m.orderRepo.EXPECT().GetOrderItemStates(1).AnyTimes().Return(states, nil)
+				gomock.InOrder(
+					m.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil),
+					m.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("DELIVERED", nil),
+					m.returnRepo.EXPECT().GetDeliveryDate(1).Times(1).Return(time.Now(), nil),
+					m.returnRepo.EXPECT().GetOpenReturnQuantities(1).Times(1).Return([]models.ReturnItem{}, nil),
+					m.returnRepo.EXPECT().GetOrderItemProduct(11).Times(1).Return(original, nil),
+					m.returnRepo.EXPECT().GetExchangeProduct(31).Times(1).Return(models.ExchangeProduct{InventoryID: 31, ProductName: "Away Jersey", CategoryID: 2, Size: "L", Stock: 2, Price: 900}, nil),
+				)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("items can only be exchanged for another size of the same product"),
+		},
+		"return window is over": {
+			StubDetails: func(m testMocks) {
+				gomock.InOrder(
+					m.orderRepo.EXPECT().FindUserIdFromOrderID(1).Times(1).Return(5, nil),
+					m.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("DELIVERED", nil),
+					m.returnRepo.EXPECT().GetDeliveryDate(1).Times(1).Return(time.Now().AddDate(0, 0, -8), nil),
+				)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("orders can only be returned within 7 days of delivery"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			returnUseCase := mocks.newReturnUseCase(config.Config{})
+			test.StubDetails(mocks)
+
+			id, err := returnUseCase.RequestExchange(5, 1, request)
+			assert.Equal(t, test.expectedOutput, id)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+
+	helper_interface "jerseyhub/pkg/helper/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+const maxReviewImages = 5
+
+type reviewUseCase struct {
+	repo   interfaces.ReviewRepository
+	helper helper_interface.Helper
+}
+
+func NewReviewUseCase(repo interfaces.ReviewRepository, h helper_interface.Helper) *reviewUseCase {
+	return &reviewUseCase{
+		repo:   repo,
+		helper: h,
+	}
+}
+
+// AddReview takes a review from a user who has received the product, one per product
+func (r *reviewUseCase) AddReview(userID int, review models.AddReview) (int, error) {
+
+	delivered, err := r.repo.CheckDeliveredPurchase(userID, review.InventoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !delivered {
+		return 0, errors.New("only products delivered to you can be reviewed")
+	}
+
+	exists, err := r.repo.CheckReviewExists(userID, review.InventoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if exists {
+		return 0, errors.New("you have already reviewed this product, edit that review instead")
+	}
+
+	return r.repo.AddReview(userID, review)
+}
+
+func (r *reviewUseCase) EditReview(userID, id int, review models.EditReview) error {
+
+	if _, err := r.getOwnReview(userID, id); err != nil {
+		return err
+	}
+
+	return r.repo.UpdateReview(id, review)
+}
+
+func (r *reviewUseCase) DeleteReview(userID, id int) error {
+
+	if _, err := r.getOwnReview(userID, id); err != nil {
+		return err
+	}
+
+	return r.repo.DeleteReview(id)
+}
+
+func (r *reviewUseCase) AddReviewImage(userID, id int, image *multipart.FileHeader) error {
+
+	if _, err := r.getOwnReview(userID, id); err != nil {
+		return err
+	}
+
+	count, err := r.repo.CountReviewImages(id)
+	if err != nil {
+		return err
+	}
+
+	if count >= maxReviewImages {
+		return fmt.Errorf("a review can have at most %d photos", maxReviewImages)
+	}
+
+	url, err := r.helper.AddImageToS3(image)
+	if err != nil {
+		return err
+	}
+
+	return r.repo.AddReviewImage(id, url)
+}
+
+func (r *reviewUseCase) getOwnReview(userID, id int) (models.ReviewDetails, error) {
+
+	review, err := r.repo.GetReview(id)
+	if err != nil {
+		return models.ReviewDetails{}, err
+	}
+
+	if review.ID == 0 || review.UserID != userID {
+		return models.ReviewDetails{}, errors.New("review does not exist")
+	}
+
+	return review, nil
+}
+
+// GetProductReviews gives the rating of a product along with a page of its approved reviews
+func (r *reviewUseCase) GetProductReviews(inventoryID, page int, sort string) (models.ProductReviews, error) {
+
+	rating, err := r.repo.GetProductRating(inventoryID)
+	if err != nil {
+		return models.ProductReviews{}, err
+	}
+
+	reviews, err := r.repo.GetProductReviews(inventoryID, page, sort)
+	if err != nil {
+		return models.ProductReviews{}, err
+	}
+
+	if err := r.addReviewImages(reviews); err != nil {
+		return models.ProductReviews{}, err
+	}
+
+	rating.Reviews = reviews
+	return rating, nil
+}
+
+// GetReviews lists the reviews of a user, userID 0 lists everyone's for moderation
+func (r *reviewUseCase) GetReviews(userID int, status string, page int) ([]models.ReviewDetails, error) {
+
+	reviews, err := r.repo.GetReviews(userID, status, page)
+	if err != nil {
+		return []models.ReviewDetails{}, err
+	}
+
+	if err := r.addReviewImages(reviews); err != nil {
+		return []models.ReviewDetails{}, err
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewUseCase) addReviewImages(reviews []models.ReviewDetails) error {
+
+	for k := range reviews {
+		images, err := r.repo.GetReviewImages(reviews[k].ID)
+		if err != nil {
+			return err
+		}
+		reviews[k].Images = images
+	}
+
+	return nil
+}
+
+// VoteHelpful marks an approved review as helpful, or takes the vote back
+func (r *reviewUseCase) VoteHelpful(userID, id int, helpful bool) error {
+
+	review, err := r.repo.GetReview(id)
+	if err != nil {
+		return err
+	}
+
+	if review.ID == 0 || review.Status != "APPROVED" {
+		return errors.New("review does not exist")
+	}
+
+	if !helpful {
+		return r.repo.RemoveReviewVote(id, userID)
+	}
+
+	if review.UserID == userID {
+		return errors.New("you cannot vote on your own review")
+	}
+
+	return r.repo.AddReviewVote(id, userID)
+}
+
+func (r *reviewUseCase) ModerateReview(id int, status string) error {
+
+	if status != "APPROVED" && status != "HIDDEN" {
+		return errors.New("reviews can only be approved or hidden")
+	}
+
+	if err := r.checkReviewExists(id); err != nil {
+		return err
+	}
+
+	return r.repo.SetReviewStatus(id, status)
+}
+
+func (r *reviewUseCase) ReplyToReview(id int, reply string) error {
+
+	if err := r.checkReviewExists(id); err != nil {
+		return err
+	}
+
+	return r.repo.ReplyToReview(id, reply)
+}
+
+func (r *reviewUseCase) checkReviewExists(id int) error {
+
+	review, err := r.repo.GetReview(id)
+	if err != nil {
+		return err
+	}
+
+	if review.ID == 0 {
+		return errors.New("review does not exist")
+	}
+
+	return nil
+}
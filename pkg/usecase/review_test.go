@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AddReview(t *testing.T) {
+
+	review := models.AddReview{InventoryID: 3, Rating: 4, Title: "Good fit", Comment: "Fits as expected"}
+
+	testData := map[string]struct {
+		StubDetails    func(*mockrepo.MockReviewRepository)
+		expectedOutput int
+		expectedError  error
+	}{
+		"delivered product can be reviewed": {
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				gomock.InOrder(
+					reviewRepo.EXPECT().CheckDeliveredPurchase(5, 3).Times(1).Return(true, nil),
+					reviewRepo.EXPECT().CheckReviewExists(5, 3).Times(1).Return(false, nil),
+					reviewRepo.EXPECT().AddReview(5, review).Times(1).Return(9, nil),
+				)
+			},
+			expectedOutput: 9,
+			expectedError:  nil,
+		},
+		"product that was not delivered": {
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				reviewRepo.EXPECT().CheckDeliveredPurchase(5, 3).Times(1).Return(false, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("only products delivered to you can be reviewed"),
+		},
+		"product already reviewed": {
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				gomock.InOrder(
+					reviewRepo.EXPECT().CheckDeliveredPurchase(5, 3).Times(1).Return(true, nil),
+					reviewRepo.EXPECT().CheckReviewExists(5, 3).Times(1).Return(true, nil),
+				)
+			},
+			expectedOutput: 0,
+			expectedError:  errors.New("you have already reviewed this product, edit that review instead"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			reviewUseCase := mocks.newReviewUseCase()
+			test.StubDetails(mocks.reviewRepo)
+
+			id, err := reviewUseCase.AddReview(5, review)
+			assert.Equal(t, test.expectedOutput, id)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_VoteHelpful(t *testing.T) {
+
+	testData := map[string]struct {
+		userID        int
+		helpful       bool
+		StubDetails   func(*mockrepo.MockReviewRepository)
+		expectedError error
+	}{
+		"vote on an approved review": {
+			userID:  5,
+			helpful: true,
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				gomock.InOrder(
+					reviewRepo.EXPECT().GetReview(9).Times(1).Return(models.ReviewDetails{ID: 9, UserID: 6, Status: "APPROVED"}, nil),
+					reviewRepo.EXPECT().AddReviewVote(9, 5).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"vote taken back": {
+			userID:  5,
+			helpful: false,
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				gomock.InOrder(
+					reviewRepo.EXPECT().GetReview(9).Times(1).Return(models.ReviewDetails{ID: 9, UserID: 6, Status: "APPROVED"}, nil),
+					reviewRepo.EXPECT().RemoveReviewVote(9, 5).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"own review": {
+			userID:  6,
+			helpful: true,
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				reviewRepo.EXPECT().GetReview(9).Times(1).Return(models.ReviewDetails{ID: 9, UserID: 6, Status: "APPROVED"}, nil)
+			},
+			expectedError: errors.New("you cannot vote on your own review"),
+		},
+		"hidden review": {
+			userID:  5,
+			helpful: true,
+			StubDetails: func(reviewRepo *mockrepo.MockReviewRepository) {
+				reviewRepo.EXPECT().GetReview(9).Times(1).Return(models.ReviewDetails{ID: 9, UserID: 6, Status: "HIDDEN"}, nil)
+			},
+			expectedError: errors.New("review does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			reviewUseCase := mocks.newReviewUseCase()
+			test.StubDetails(mocks.reviewRepo)
+
+			err := reviewUseCase.VoteHelpful(test.userID, 9, test.helpful)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_GetProductReviews(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	reviewRepo := mocks.reviewRepo
+	reviewUseCase := mocks.newReviewUseCase()
+
+	gomock.InOrder(
+		reviewRepo.EXPECT().GetProductRating(3).Times(1).Return(models.ProductReviews{AverageRating: 4.5, RatingCount: 2}, nil),
+		reviewRepo.EXPECT().GetProductReviews(3, 1, "helpful").Times(1).Return([]models.ReviewDetails{{ID: 9, Helpful: 4}, {ID: 8, Helpful: 1}}, nil),
+		reviewRepo.EXPECT().GetReviewImages(9).Times(1).Return([]string{"https://images/9.jpg"}, nil),
+		reviewRepo.EXPECT().GetReviewImages(8).Times(1).Return([]string{}, nil),
+	)
+
+	reviews, err := reviewUseCase.GetProductReviews(3, 1, "helpful")
+	assert.NoError(t, err)
+	assert.Equal(t, models.ProductReviews{
+		AverageRating: 4.5,
+		RatingCount:   2,
+		Reviews: []models.ReviewDetails{
+			{ID: 9, Helpful: 4, Images: []string{"https://images/9.jpg"}},
+			{ID: 8, Helpful: 1, Images: []string{}},
+		},
+	}, reviews)
+}
+
+func Test_ListProductsForUser(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	inventoryRepo, offerRepo, wishlistRepo := mocks.inventoryRepo, mocks.offerRepo, mocks.wishlistRepo
+	inventoryUseCase := mocks.newInventoryUseCase()
+
+	// the best rated come first from the repository and the order is kept
+	inventoryRepo.EXPECT().ListProducts(1, "rating").Times(1).Return([]models.Inventories{
+		{ID: 2, CategoryID: 1, Price: 1000, AverageRating: 4.8, RatingCount: 12},
+		{ID: 1, CategoryID: 1, Price: 800, AverageRating: 3.5, RatingCount: 4},
+	}, nil)
+	offerRepo.EXPECT().FindDiscountPercentage(1).Times(2).Return(10, nil)
+	wishlistRepo.EXPECT().CheckIfTheItemIsPresentAtWishlist(5, gomock.Any()).Times(2).Return(false, nil)
+	wishlistRepo.EXPECT().CheckIfTheItemIsPresentAtCart(5, gomock.Any()).Times(2).Return(false, nil)
+
+	products, err := inventoryUseCase.ListProductsForUser(1, 5, "rating")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Inventories{
+		{ID: 2, CategoryID: 1, Price: 1000, DiscountedPrice: 900, AverageRating: 4.8, RatingCount: 12},
+		{ID: 1, CategoryID: 1, Price: 800, DiscountedPrice: 720, AverageRating: 3.5, RatingCount: 4},
+	}, products)
+}
@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	courier_interface "jerseyhub/pkg/courier/interface"
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+)
+
+type shipmentUseCase struct {
+	repo            interfaces.ShipmentRepository
+	orderRepository interfaces.OrderRepository
+	courier         courier_interface.Courier
+	emailUseCase    services.EmailUseCase
+}
+
+func NewShipmentUseCase(repo interfaces.ShipmentRepository, order interfaces.OrderRepository, courier courier_interface.Courier, email services.EmailUseCase) *shipmentUseCase {
+	return &shipmentUseCase{
+		repo:            repo,
+		orderRepository: order,
+		courier:         courier,
+		emailUseCase:    email,
+	}
+}
+
+var trackingStatuses = map[string]bool{
+	"BOOKED":           true,
+	"PICKED_UP":        true,
+	"IN_TRANSIT":       true,
+	"OUT_FOR_DELIVERY": true,
+	"DELIVERY_FAILED":  true,
+	"DELIVERED":        true,
+}
+
+func (s *shipmentUseCase) CreateShipment(orderID int, shipment models.CreateShipment) error {
+
+	status, err := s.orderRepository.CheckOrderStatusByID(orderID)
+	if err != nil {
+		return err
+	}
+
+	if status != "PENDING" && status != "SHIPPED" {
+		return errors.New("shipment can only be created for pending or shipped orders")
+	}
+
+	quantities, err := s.repo.GetOrderItemQuantities(orderID)
+	if err != nil {
+		return err
+	}
+
+	remaining := make(map[int]int)
+	for _, v := range quantities {
+		remaining[v.ID] = v.Quantity - v.Shipped
+	}
+
+	items, err := shipmentItems(shipment.Items, quantities, remaining)
+	if err != nil {
+		return err
+	}
+
+	booking := models.CourierBooking{
+		Courier:          shipment.Courier,
+		TrackingNumber:   shipment.TrackingNumber,
+		ExpectedDelivery: shipment.ExpectedDelivery,
+	}
+
+	if booking.TrackingNumber == "" {
+		request, err := s.repo.GetShippingAddress(orderID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			request.Items += item.Quantity
+		}
+
+		booking, err = s.courier.Book(request)
+		if err != nil {
+			return errors.New("could not book the courier: " + err.Error())
+		}
+
+		if !shipment.ExpectedDelivery.IsZero() {
+			booking.ExpectedDelivery = shipment.ExpectedDelivery
+		}
+	} else if booking.Courier == "" {
+		return errors.New("courier is required along with the tracking number")
+	}
+
+	if _, err := s.repo.CreateShipment(orderID, booking, items); err != nil {
+		return errors.New("could not create the shipment")
+	}
+
+	if status == "PENDING" {
+		if err := s.orderRepository.EditOrderStatus("SHIPPED", orderID); err != nil {
+			return err
+		}
+
+		if err := s.emailUseCase.SendOrderStatusEmail(orderID, "SHIPPED"); err != nil {
+			fmt.Println("could not send order shipped email:", err)
+		}
+	}
+
+	return nil
+}
+
+// shipmentItems checks the requested quantities against what is still left to ship, no items means everything left
+func shipmentItems(requested []models.ShipmentItemRequest, quantities []models.OrderItemQuantity, remaining map[int]int) ([]models.ShipmentItemRequest, error) {
+
+	var items []models.ShipmentItemRequest
+
+	if len(requested) == 0 {
+		for _, v := range quantities {
+			if remaining[v.ID] > 0 {
+				items = append(items, models.ShipmentItemRequest{OrderItemID: v.ID, Quantity: remaining[v.ID]})
+			}
+		}
+
+		if len(items) == 0 {
+			return nil, errors.New("all items of the order are already shipped")
+		}
+
+		return items, nil
+	}
+
+	for _, item := range requested {
+		left, ok := remaining[item.OrderItemID]
+		if !ok {
+			return nil, fmt.Errorf("order item %d is not part of the order", item.OrderItemID)
+		}
+
+		if item.Quantity <= 0 {
+			return nil, errors.New("quantity should be greater than zero")
+		}
+
+		if item.Quantity > left {
+			return nil, fmt.Errorf("only %d of order item %d is left to ship", left, item.OrderItemID)
+		}
+
+		// the same item listed twice should not be able to go over the quantity
+		remaining[item.OrderItemID] = left - item.Quantity
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *shipmentUseCase) GetShipments(orderID int) ([]models.ShipmentDetails, error) {
+
+	shipments, err := s.repo.GetShipmentsByOrder(orderID)
+	if err != nil {
+		return []models.ShipmentDetails{}, err
+	}
+
+	for i := range shipments {
+		items, err := s.repo.GetShipmentItems(shipments[i].ID)
+		if err != nil {
+			return []models.ShipmentDetails{}, err
+		}
+
+		events, err := s.repo.GetTrackingEvents(shipments[i].ID)
+		if err != nil {
+			return []models.ShipmentDetails{}, err
+		}
+
+		shipments[i].Items = items
+		shipments[i].Events = events
+	}
+
+	return shipments, nil
+}
+
+func (s *shipmentUseCase) AddTrackingEvent(shipmentID int, event models.TrackingEvent) error {
+
+	if !trackingStatuses[event.Status] {
+		return errors.New("invalid tracking status")
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	shipment, err := s.repo.GetShipment(shipmentID)
+	if err != nil {
+		return err
+	}
+
+	if shipment.ID == 0 {
+		return errors.New("shipment does not exist")
+	}
+
+	if shipment.Status == "DELIVERED" {
+		return errors.New("shipment is already delivered")
+	}
+
+	if err := s.repo.AddTrackingEvent(shipmentID, event); err != nil {
+		return errors.New("could not add the tracking event")
+	}
+
+	if event.Status == "DELIVERED" {
+		return s.CompleteOrder(int(shipment.OrderID))
+	}
+
+	return nil
+}
+
+func (s *shipmentUseCase) RefreshTracking(shipmentID int) error {
+
+	shipment, err := s.repo.GetShipment(shipmentID)
+	if err != nil {
+		return err
+	}
+
+	if shipment.ID == 0 {
+		return errors.New("shipment does not exist")
+	}
+
+	if shipment.Courier != s.courier.Name() {
+		return errors.New("tracking is not available for this courier, add the events manually")
+	}
+
+	events, err := s.courier.Track(shipment.TrackingNumber)
+	if err != nil {
+		return errors.New("could not get tracking from the courier: " + err.Error())
+	}
+
+	delivered := false
+	for _, event := range events {
+		// the booking event is recorded when the shipment is created
+		if event.Status == "BOOKED" || !trackingStatuses[event.Status] {
+			continue
+		}
+
+		exists, err := s.repo.CheckTrackingEventExists(shipmentID, event.Status, event.OccurredAt)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		if err := s.repo.AddTrackingEvent(shipmentID, event); err != nil {
+			return errors.New("could not add the tracking event")
+		}
+
+		if event.Status == "DELIVERED" {
+			delivered = true
+		}
+	}
+
+	if delivered {
+		return s.CompleteOrder(int(shipment.OrderID))
+	}
+
+	return nil
+}
+
+// CompleteOrder marks the order delivered once every item is shipped and every shipment has arrived
+func (s *shipmentUseCase) CompleteOrder(orderID int) error {
+
+	quantities, err := s.repo.GetOrderItemQuantities(orderID)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range quantities {
+		if v.Shipped < v.Quantity {
+			return nil
+		}
+	}
+
+	undelivered, err := s.repo.CountUndeliveredShipments(orderID)
+	if err != nil {
+		return err
+	}
+
+	if undelivered > 0 {
+		return nil
+	}
+
+	status, err := s.orderRepository.CheckOrderStatusByID(orderID)
+	if err != nil {
+		return err
+	}
+
+	if status != "SHIPPED" {
+		return nil
+	}
+
+	if err := s.orderRepository.EditOrderStatus("DELIVERED", orderID); err != nil {
+		return err
+	}
+
+	if err := s.emailUseCase.SendOrderStatusEmail(orderID, "DELIVERED"); err != nil {
+		fmt.Println("could not send order delivered email:", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CreateShipment(t *testing.T) {
+
+	quantities := []models.OrderItemQuantity{{ID: 11, Quantity: 3, Shipped: 1}, {ID: 12, Quantity: 1}}
+	address := models.CourierShipmentRequest{OrderID: 1, Name: "Arun K", Pin: "688541"}
+	booking := models.CourierBooking{Courier: "fake", TrackingNumber: "FK123", ExpectedDelivery: time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)}
+
+	testData := map[string]struct {
+		shipment      models.CreateShipment
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"courier books everything left to ship": {
+			shipment: models.CreateShipment{},
+			StubDetails: func(mocks testMocks) {
+				booked := address
+				booked.Items = 3
+				items := []models.ShipmentItemRequest{{OrderItemID: 11, Quantity: 2}, {OrderItemID: 12, Quantity: 1}}
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+					mocks.shipmentRepo.EXPECT().GetShippingAddress(1).Times(1).Return(address, nil),
+					mocks.courier.EXPECT().Book(booked).Times(1).Return(booking, nil),
+					mocks.shipmentRepo.EXPECT().CreateShipment(1, booking, items).Times(1).Return(4, nil),
+					mocks.orderRepo.EXPECT().EditOrderStatus("SHIPPED", 1).Times(1).Return(nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "SHIPPED").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"parcel booked outside the app on a shipped order": {
+			shipment: models.CreateShipment{Courier: "India Post", TrackingNumber: "EE123456789IN", Items: []models.ShipmentItemRequest{{OrderItemID: 12, Quantity: 1}}},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("SHIPPED", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+					mocks.shipmentRepo.EXPECT().CreateShipment(1, models.CourierBooking{Courier: "India Post", TrackingNumber: "EE123456789IN"}, []models.ShipmentItemRequest{{OrderItemID: 12, Quantity: 1}}).Times(1).Return(5, nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"tracking number without the courier": {
+			shipment: models.CreateShipment{TrackingNumber: "EE123456789IN"},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+				)
+			},
+			expectedError: errors.New("courier is required along with the tracking number"),
+		},
+		"more than is left to ship": {
+			shipment: models.CreateShipment{Items: []models.ShipmentItemRequest{{OrderItemID: 11, Quantity: 3}}},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+				)
+			},
+			expectedError: errors.New("only 2 of order item 11 is left to ship"),
+		},
+		"same item listed twice going over": {
+			shipment: models.CreateShipment{Items: []models.ShipmentItemRequest{{OrderItemID: 11, Quantity: 2}, {OrderItemID: 11, Quantity: 1}}},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+				)
+			},
+			expectedError: errors.New("only 0 of order item 11 is left to ship"),
+		},
+		"item of another order": {
+			shipment: models.CreateShipment{Items: []models.ShipmentItemRequest{{OrderItemID: 21, Quantity: 1}}},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+				)
+			},
+			expectedError: errors.New("order item 21 is not part of the order"),
+		},
+		"everything already shipped": {
+			shipment: models.CreateShipment{},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("SHIPPED", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return([]models.OrderItemQuantity{{ID: 11, Quantity: 3, Shipped: 3}}, nil),
+				)
+			},
+			expectedError: errors.New("all items of the order are already shipped"),
+		},
+		"courier could not book": {
+			shipment: models.CreateShipment{},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("PENDING", nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return(quantities, nil),
+					mocks.shipmentRepo.EXPECT().GetShippingAddress(1).Times(1).Return(address, nil),
+					mocks.courier.EXPECT().Book(gomock.Any()).Times(1).Return(models.CourierBooking{}, errors.New("pin not serviced")),
+				)
+			},
+			expectedError: errors.New("could not book the courier: pin not serviced"),
+		},
+		"delivered order": {
+			shipment: models.CreateShipment{},
+			StubDetails: func(mocks testMocks) {
+				mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("DELIVERED", nil)
+			},
+			expectedError: errors.New("shipment can only be created for pending or shipped orders"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shipmentUseCase := mocks.newShipmentUseCase()
+			test.StubDetails(mocks)
+
+			err := shipmentUseCase.CreateShipment(1, test.shipment)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_AddTrackingEvent(t *testing.T) {
+
+	occurredAt := time.Date(2026, 10, 22, 14, 0, 0, 0, time.UTC)
+
+	testData := map[string]struct {
+		event         models.TrackingEvent
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"last shipment delivered completes the order": {
+			event: models.TrackingEvent{Status: "DELIVERED", OccurredAt: occurredAt},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{ID: 4, OrderID: 1, Status: "OUT_FOR_DELIVERY"}, nil),
+					mocks.shipmentRepo.EXPECT().AddTrackingEvent(4, models.TrackingEvent{Status: "DELIVERED", OccurredAt: occurredAt}).Times(1).Return(nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return([]models.OrderItemQuantity{{ID: 11, Quantity: 3, Shipped: 3}}, nil),
+					mocks.shipmentRepo.EXPECT().CountUndeliveredShipments(1).Times(1).Return(0, nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("SHIPPED", nil),
+					mocks.orderRepo.EXPECT().EditOrderStatus("DELIVERED", 1).Times(1).Return(nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "DELIVERED").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"delivered while another shipment is on the way": {
+			event: models.TrackingEvent{Status: "DELIVERED", OccurredAt: occurredAt},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{ID: 4, OrderID: 1, Status: "IN_TRANSIT"}, nil),
+					mocks.shipmentRepo.EXPECT().AddTrackingEvent(4, gomock.Any()).Times(1).Return(nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return([]models.OrderItemQuantity{{ID: 11, Quantity: 3, Shipped: 3}}, nil),
+					mocks.shipmentRepo.EXPECT().CountUndeliveredShipments(1).Times(1).Return(1, nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"delivered while units are left to ship": {
+			event: models.TrackingEvent{Status: "DELIVERED", OccurredAt: occurredAt},
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{ID: 4, OrderID: 1, Status: "IN_TRANSIT"}, nil),
+					mocks.shipmentRepo.EXPECT().AddTrackingEvent(4, gomock.Any()).Times(1).Return(nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return([]models.OrderItemQuantity{{ID: 11, Quantity: 3, Shipped: 2}}, nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"shipment already delivered": {
+			event: models.TrackingEvent{Status: "IN_TRANSIT", OccurredAt: occurredAt},
+			StubDetails: func(mocks testMocks) {
+				mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{ID: 4, OrderID: 1, Status: "DELIVERED"}, nil)
+			},
+			expectedError: errors.New("shipment is already delivered"),
+		},
+		"shipment that does not exist": {
+			event: models.TrackingEvent{Status: "IN_TRANSIT", OccurredAt: occurredAt},
+			StubDetails: func(mocks testMocks) {
+				mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{}, nil)
+			},
+			expectedError: errors.New("shipment does not exist"),
+		},
+		"unknown status": {
+			event:         models.TrackingEvent{Status: "LOST", OccurredAt: occurredAt},
+			StubDetails:   func(mocks testMocks) {},
+			expectedError: errors.New("invalid tracking status"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shipmentUseCase := mocks.newShipmentUseCase()
+			test.StubDetails(mocks)
+
+			err := shipmentUseCase.AddTrackingEvent(4, test.event)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_RefreshTracking(t *testing.T) {
+
+	pickedUp := time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC)
+	delivered := time.Date(2026, 10, 22, 14, 0, 0, 0, time.UTC)
+	shipment := domain.Shipment{ID: 4, OrderID: 1, Courier: "fake", TrackingNumber: "FK123", Status: "IN_TRANSIT"}
+
+	testData := map[string]struct {
+		StubDetails   func(testMocks)
+		expectedError error
+	}{
+		"only new events are added": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(shipment, nil),
+					mocks.courier.EXPECT().Name().Times(1).Return("fake"),
+					mocks.courier.EXPECT().Track("FK123").Times(1).Return([]models.TrackingEvent{
+						{Status: "BOOKED", OccurredAt: pickedUp.Add(-time.Hour)},
+						{Status: "PICKED_UP", OccurredAt: pickedUp},
+						{Status: "DELIVERED", OccurredAt: delivered},
+					}, nil),
+					mocks.shipmentRepo.EXPECT().CheckTrackingEventExists(4, "PICKED_UP", pickedUp).Times(1).Return(true, nil),
+					mocks.shipmentRepo.EXPECT().CheckTrackingEventExists(4, "DELIVERED", delivered).Times(1).Return(false, nil),
+					mocks.shipmentRepo.EXPECT().AddTrackingEvent(4, models.TrackingEvent{Status: "DELIVERED", OccurredAt: delivered}).Times(1).Return(nil),
+					mocks.shipmentRepo.EXPECT().GetOrderItemQuantities(1).Times(1).Return([]models.OrderItemQuantity{{ID: 11, Quantity: 1, Shipped: 1}}, nil),
+					mocks.shipmentRepo.EXPECT().CountUndeliveredShipments(1).Times(1).Return(0, nil),
+					mocks.orderRepo.EXPECT().CheckOrderStatusByID(1).Times(1).Return("SHIPPED", nil),
+					mocks.orderRepo.EXPECT().EditOrderStatus("DELIVERED", 1).Times(1).Return(nil),
+					mocks.email.EXPECT().SendOrderStatusEmail(1, "DELIVERED").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"shipment with another courier": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(domain.Shipment{ID: 4, OrderID: 1, Courier: "India Post"}, nil),
+					mocks.courier.EXPECT().Name().Times(1).Return("fake"),
+				)
+			},
+			expectedError: errors.New("tracking is not available for this courier, add the events manually"),
+		},
+		"courier could not be reached": {
+			StubDetails: func(mocks testMocks) {
+				gomock.InOrder(
+					mocks.shipmentRepo.EXPECT().GetShipment(4).Times(1).Return(shipment, nil),
+					mocks.courier.EXPECT().Name().Times(1).Return("fake"),
+					mocks.courier.EXPECT().Track("FK123").Times(1).Return(nil, errors.New("timeout")),
+				)
+			},
+			expectedError: errors.New("could not get tracking from the courier: timeout"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shipmentUseCase := mocks.newShipmentUseCase()
+			test.StubDetails(mocks)
+
+			err := shipmentUseCase.RefreshTracking(4)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
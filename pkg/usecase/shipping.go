@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	interfaces "jerseyhub/pkg/repository/interface"
+	"jerseyhub/pkg/utils/models"
+	"mime/multipart"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type shippingUseCase struct {
+	repo interfaces.ShippingRepository
+}
+
+func NewShippingUseCase(repo interfaces.ShippingRepository) *shippingUseCase {
+	return &shippingUseCase{
+		repo: repo,
+	}
+}
+
+func (s *shippingUseCase) AddShippingZone(zone models.ShippingZone) error {
+
+	if len(zone.States) == 0 && len(zone.PinPrefixes) == 0 && !zone.IsDefault {
+		return errors.New("a zone needs states or pin prefixes unless it is the default zone")
+	}
+
+	if err := s.repo.AddShippingZone(zone); err != nil {
+		return errors.New("could not add the shipping zone")
+	}
+
+	return nil
+}
+
+func (s *shippingUseCase) UpdateShippingZone(zone models.ShippingZone) error {
+
+	exists, err := s.repo.CheckShippingZoneExists(zone.ID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("shipping zone does not exist")
+	}
+
+	if len(zone.States) == 0 && len(zone.PinPrefixes) == 0 && !zone.IsDefault {
+		return errors.New("a zone needs states or pin prefixes unless it is the default zone")
+	}
+
+	if err := s.repo.UpdateShippingZone(zone); err != nil {
+		return errors.New("could not update the shipping zone")
+	}
+
+	return nil
+}
+
+func (s *shippingUseCase) DeleteShippingZone(id int) error {
+
+	exists, err := s.repo.CheckShippingZoneExists(id)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("shipping zone does not exist")
+	}
+
+	return s.repo.DeleteShippingZone(id)
+}
+
+func (s *shippingUseCase) GetShippingZones() ([]models.ShippingZone, error) {
+
+	zones, err := s.repo.GetShippingZones()
+	if err != nil {
+		return []models.ShippingZone{}, err
+	}
+
+	for i := range zones {
+		areas, err := s.repo.GetZoneAreas(zones[i].ID)
+		if err != nil {
+			return []models.ShippingZone{}, err
+		}
+
+		for _, area := range areas {
+			if area.PinPrefix != "" {
+				zones[i].PinPrefixes = append(zones[i].PinPrefixes, area.PinPrefix)
+			} else {
+				zones[i].States = append(zones[i].States, area.State)
+			}
+		}
+	}
+
+	return zones, nil
+}
+
+// CalculateShipping charges nothing when no zone covers the address, so checkout keeps working before zones are set up
+func (s *shippingUseCase) CalculateShipping(address models.Address, items int, subtotal float64, cod bool) (models.ShippingCharge, error) {
+
+	zone, err := s.repo.FindShippingZone(address.State, address.Pin)
+	if err != nil {
+		return models.ShippingCharge{}, err
+	}
+
+	if zone.ID == 0 {
+		return models.ShippingCharge{}, nil
+	}
+
+	charge := models.ShippingCharge{
+		ZoneID:   int(zone.ID),
+		ZoneName: zone.Name,
+	}
+
+	if zone.FreeShippingAbove <= 0 || subtotal < zone.FreeShippingAbove {
+		charge.Shipping = zone.FlatRate + zone.PerItemRate*float64(items)
+	}
+
+	if cod {
+		charge.CodSurcharge = zone.CodSurcharge
+	}
+
+	return charge, nil
+}
+
+func (s *shippingUseCase) ShippingForOrder(addressID int, paymentID int, items int, subtotal float64) (models.ShippingCharge, error) {
+
+	address, err := s.repo.GetAddress(addressID)
+	if err != nil {
+		return models.ShippingCharge{}, err
+	}
+
+	if address.Id == 0 {
+		return models.ShippingCharge{}, errors.New("address does not exist")
+	}
+
+	cod, err := s.repo.CheckIfCashOnDelivery(paymentID)
+	if err != nil {
+		return models.ShippingCharge{}, err
+	}
+
+	delivery, err := s.CheckServiceability(address.Pin)
+	if err != nil {
+		return models.ShippingCharge{}, err
+	}
+
+	if !delivery.Serviceable {
+		return models.ShippingCharge{}, errors.New("we do not deliver to this PIN code yet")
+	}
+
+	if cod && !delivery.CodAllowed {
+		return models.ShippingCharge{}, errors.New("cash on delivery is not available for this PIN code")
+	}
+
+	if !cod && !delivery.PrepaidAllowed {
+		return models.ShippingCharge{}, errors.New("only cash on delivery is available for this PIN code")
+	}
+
+	return s.CalculateShipping(address, items, subtotal, cod)
+}
+
+// used for the estimate while the serviceability table is still empty
+const defaultDeliveryDays = 7
+
+// CheckServiceability lets every PIN through until a serviceability table is imported, same as shipping zones
+func (s *shippingUseCase) CheckServiceability(pin string) (models.Serviceability, error) {
+
+	pin = strings.TrimSpace(pin)
+	delivery := models.Serviceability{Pin: pin}
+
+	count, err := s.repo.CountServiceablePins()
+	if err != nil {
+		return models.Serviceability{}, err
+	}
+
+	if count == 0 {
+		delivery.Serviceable = true
+		delivery.CodAllowed = true
+		delivery.PrepaidAllowed = true
+		delivery.DeliveryDays = defaultDeliveryDays
+	} else {
+		serviceable, err := s.repo.FindServiceablePin(pin)
+		if err != nil {
+			return models.Serviceability{}, err
+		}
+
+		if serviceable.Pin == "" {
+			return delivery, nil
+		}
+
+		delivery.Serviceable = serviceable.CodAllowed || serviceable.PrepaidAllowed
+		delivery.CodAllowed = serviceable.CodAllowed
+		delivery.PrepaidAllowed = serviceable.PrepaidAllowed
+		delivery.DeliveryDays = serviceable.DeliveryDays
+	}
+
+	if delivery.Serviceable {
+		year, month, day := time.Now().Date()
+		delivery.EstimatedDelivery = time.Date(year, month, day, 0, 0, 0, 0, time.Local).AddDate(0, 0, delivery.DeliveryDays)
+	}
+
+	return delivery, nil
+}
+
+// ImportServiceablePins reads a csv of pin,cod_allowed,prepaid_allowed,delivery_days. A header row is
+// fine, bad rows are reported back and the rest are imported
+func (s *shippingUseCase) ImportServiceablePins(file *multipart.FileHeader) (models.ServiceabilityImport, error) {
+
+	f, err := file.Open()
+	if err != nil {
+		return models.ServiceabilityImport{}, errors.New("could not open the file")
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var pins []models.ServiceablePin
+	var result models.ServiceabilityImport
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return models.ServiceabilityImport{}, fmt.Errorf("could not read line %d of the file", line)
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "pin") {
+			continue
+		}
+
+		pin, err := parseServiceablePin(record)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("line %d: %s", line, err.Error()))
+			continue
+		}
+
+		pins = append(pins, pin)
+	}
+
+	if len(pins) == 0 {
+		return result, errors.New("no valid rows in the file")
+	}
+
+	if err := s.repo.ImportServiceablePins(pins); err != nil {
+		return models.ServiceabilityImport{}, errors.New("could not import the PIN codes")
+	}
+
+	result.Imported = len(pins)
+	return result, nil
+}
+
+func parseServiceablePin(record []string) (models.ServiceablePin, error) {
+
+	if len(record) != 4 {
+		return models.ServiceablePin{}, errors.New("expected pin,cod_allowed,prepaid_allowed,delivery_days")
+	}
+
+	pin := strings.TrimSpace(record[0])
+	if _, err := strconv.Atoi(pin); err != nil || len(pin) != 6 {
+		return models.ServiceablePin{}, errors.New("pin should be 6 digits")
+	}
+
+	cod, err := parseYesNo(record[1])
+	if err != nil {
+		return models.ServiceablePin{}, errors.New("cod_allowed should be true or false")
+	}
+
+	prepaid, err := parseYesNo(record[2])
+	if err != nil {
+		return models.ServiceablePin{}, errors.New("prepaid_allowed should be true or false")
+	}
+
+	days, err := strconv.Atoi(strings.TrimSpace(record[3]))
+	if err != nil || days < 0 {
+		return models.ServiceablePin{}, errors.New("delivery_days should be a positive number")
+	}
+
+	return models.ServiceablePin{Pin: pin, CodAllowed: cod, PrepaidAllowed: prepaid, DeliveryDays: days}, nil
+}
+
+// spreadsheets tend to export yes/no instead of true/false
+func parseYesNo(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	return strconv.ParseBool(strings.TrimSpace(value))
+}
+
+func (s *shippingUseCase) GetServiceablePins(page int) ([]models.ServiceablePin, error) {
+
+	return s.repo.GetServiceablePins(page)
+}
+
+func (s *shippingUseCase) DeleteServiceablePin(pin string) error {
+
+	deleted, err := s.repo.DeleteServiceablePin(pin)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New("PIN code is not in the serviceable list")
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"testing"
+	"time"
+
+	"jerseyhub/pkg/domain"
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CalculateShipping(t *testing.T) {
+
+	address := models.Address{State: "Kerala", Pin: "688541"}
+	zone := domain.ShippingZone{ID: 2, Name: "South", FlatRate: 40, PerItemRate: 10, FreeShippingAbove: 1000, CodSurcharge: 25}
+
+	testData := map[string]struct {
+		items          int
+		subtotal       float64
+		cod            bool
+		StubDetails    func(*mockrepo.MockShippingRepository)
+		expectedOutput models.ShippingCharge
+		expectedError  error
+	}{
+		"flat rate along with the rate of each item": {
+			items:    3,
+			subtotal: 600,
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(zone, nil)
+			},
+			expectedOutput: models.ShippingCharge{ZoneID: 2, ZoneName: "South", Shipping: 70},
+			expectedError:  nil,
+		},
+		"free shipping above the threshold still charges cod": {
+			items:    3,
+			subtotal: 1000,
+			cod:      true,
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(zone, nil)
+			},
+			expectedOutput: models.ShippingCharge{ZoneID: 2, ZoneName: "South", CodSurcharge: 25},
+			expectedError:  nil,
+		},
+		"zone without a threshold always charges": {
+			items:    1,
+			subtotal: 5000,
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(domain.ShippingZone{ID: 3, Name: "Default", FlatRate: 60, IsDefault: true}, nil)
+			},
+			expectedOutput: models.ShippingCharge{ZoneID: 3, ZoneName: "Default", Shipping: 60},
+			expectedError:  nil,
+		},
+		"no zone covers the address": {
+			items:    1,
+			subtotal: 500,
+			cod:      true,
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(domain.ShippingZone{}, nil)
+			},
+			expectedOutput: models.ShippingCharge{},
+			expectedError:  nil,
+		},
+		"error from repository": {
+			items:    1,
+			subtotal: 500,
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(domain.ShippingZone{}, errors.New("error"))
+			},
+			expectedOutput: models.ShippingCharge{},
+			expectedError:  errors.New("error"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shippingUseCase := mocks.newShippingUseCase()
+			test.StubDetails(mocks.shippingRepo)
+
+			charge, err := shippingUseCase.CalculateShipping(address, test.items, test.subtotal, test.cod)
+			assert.Equal(t, test.expectedOutput, charge)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_ShippingForOrder(t *testing.T) {
+
+	address := models.Address{Id: 4, State: "Kerala", Pin: "688541"}
+	zone := domain.ShippingZone{ID: 2, Name: "South", FlatRate: 40, CodSurcharge: 25}
+
+	testData := map[string]struct {
+		StubDetails    func(*mockrepo.MockShippingRepository)
+		expectedOutput models.ShippingCharge
+		expectedError  error
+	}{
+		"cash on delivery to a serviceable pin": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				gomock.InOrder(
+					shippingRepo.EXPECT().GetAddress(4).Times(1).Return(address, nil),
+					shippingRepo.EXPECT().CheckIfCashOnDelivery(1).Times(1).Return(true, nil),
+					shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(10, nil),
+					shippingRepo.EXPECT().FindServiceablePin("688541").Times(1).Return(domain.ServiceablePin{Pin: "688541", CodAllowed: true, PrepaidAllowed: true, DeliveryDays: 3}, nil),
+					shippingRepo.EXPECT().FindShippingZone("Kerala", "688541").Times(1).Return(zone, nil),
+				)
+			},
+			expectedOutput: models.ShippingCharge{ZoneID: 2, ZoneName: "South", Shipping: 40, CodSurcharge: 25},
+			expectedError:  nil,
+		},
+		"cash on delivery not allowed for the pin": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				gomock.InOrder(
+					shippingRepo.EXPECT().GetAddress(4).Times(1).Return(address, nil),
+					shippingRepo.EXPECT().CheckIfCashOnDelivery(1).Times(1).Return(true, nil),
+					shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(10, nil),
+					shippingRepo.EXPECT().FindServiceablePin("688541").Times(1).Return(domain.ServiceablePin{Pin: "688541", PrepaidAllowed: true, DeliveryDays: 3}, nil),
+				)
+			},
+			expectedOutput: models.ShippingCharge{},
+			expectedError:  errors.New("cash on delivery is not available for this PIN code"),
+		},
+		"pin is not delivered to": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				gomock.InOrder(
+					shippingRepo.EXPECT().GetAddress(4).Times(1).Return(address, nil),
+					shippingRepo.EXPECT().CheckIfCashOnDelivery(1).Times(1).Return(false, nil),
+					shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(10, nil),
+					shippingRepo.EXPECT().FindServiceablePin("688541").Times(1).Return(domain.ServiceablePin{}, nil),
+				)
+			},
+			expectedOutput: models.ShippingCharge{},
+			expectedError:  errors.New("we do not deliver to this PIN code yet"),
+		},
+		"address does not exist": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().GetAddress(4).Times(1).Return(models.Address{}, nil)
+			},
+			expectedOutput: models.ShippingCharge{},
+			expectedError:  errors.New("address does not exist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shippingUseCase := mocks.newShippingUseCase()
+			test.StubDetails(mocks.shippingRepo)
+
+			charge, err := shippingUseCase.ShippingForOrder(4, 1, 2, 500)
+			assert.Equal(t, test.expectedOutput, charge)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+// csvFile builds the file header an uploaded csv arrives as
+func csvFile(t *testing.T, content string) *multipart.FileHeader {
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "pins.csv")
+	assert.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	return form.File["file"][0]
+}
+
+func Test_ImportServiceablePins(t *testing.T) {
+
+	testData := map[string]struct {
+		content        string
+		StubDetails    func(*mockrepo.MockShippingRepository)
+		expectedOutput models.ServiceabilityImport
+		expectedError  error
+	}{
+		"header is skipped and yes/no is read as true/false": {
+			content: "pin,cod_allowed,prepaid_allowed,delivery_days\n688541,yes,no,3\n682001, true, true, 2\n",
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().ImportServiceablePins([]models.ServiceablePin{
+					{Pin: "688541", CodAllowed: true, PrepaidAllowed: false, DeliveryDays: 3},
+					{Pin: "682001", CodAllowed: true, PrepaidAllowed: true, DeliveryDays: 2},
+				}).Times(1).Return(nil)
+			},
+			expectedOutput: models.ServiceabilityImport{Imported: 2},
+			expectedError:  nil,
+		},
+		"bad rows are reported and the rest imported": {
+			content: "68854,yes,yes,3\n688541,maybe,yes,3\n688542,yes,yes,-1\n688543,yes,yes\n688544,n,y,4\n",
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().ImportServiceablePins([]models.ServiceablePin{
+					{Pin: "688544", CodAllowed: false, PrepaidAllowed: true, DeliveryDays: 4},
+				}).Times(1).Return(nil)
+			},
+			expectedOutput: models.ServiceabilityImport{
+				Imported: 1,
+				Skipped: []string{
+					"line 1: pin should be 6 digits",
+					"line 2: cod_allowed should be true or false",
+					"line 3: delivery_days should be a positive number",
+					"line 4: expected pin,cod_allowed,prepaid_allowed,delivery_days",
+				},
+			},
+			expectedError: nil,
+		},
+		"file without a valid row": {
+			content: "pin,cod_allowed,prepaid_allowed,delivery_days\nabcdef,yes,yes,3\n",
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+			},
+			expectedOutput: models.ServiceabilityImport{Skipped: []string{"line 2: pin should be 6 digits"}},
+			expectedError:  errors.New("no valid rows in the file"),
+		},
+		"error from repository": {
+			content: "688541,yes,yes,3\n",
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().ImportServiceablePins(gomock.Any()).Times(1).Return(errors.New("error"))
+			},
+			expectedOutput: models.ServiceabilityImport{},
+			expectedError:  errors.New("could not import the PIN codes"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shippingUseCase := mocks.newShippingUseCase()
+			test.StubDetails(mocks.shippingRepo)
+
+			result, err := shippingUseCase.ImportServiceablePins(csvFile(t, test.content))
+			assert.Equal(t, test.expectedOutput, result)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_CheckServiceability(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails    func(*mockrepo.MockShippingRepository)
+		expectedOutput models.Serviceability
+		expectedError  error
+	}{
+		"every pin goes through before a table is imported": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(0, nil)
+			},
+			expectedOutput: models.Serviceability{Pin: "688541", Serviceable: true, CodAllowed: true, PrepaidAllowed: true, DeliveryDays: defaultDeliveryDays},
+			expectedError:  nil,
+		},
+		"pin from the table": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				gomock.InOrder(
+					shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(2, nil),
+					shippingRepo.EXPECT().FindServiceablePin("688541").Times(1).Return(domain.ServiceablePin{Pin: "688541", PrepaidAllowed: true, DeliveryDays: 3}, nil),
+				)
+			},
+			expectedOutput: models.Serviceability{Pin: "688541", Serviceable: true, PrepaidAllowed: true, DeliveryDays: 3},
+			expectedError:  nil,
+		},
+		"pin missing from the table": {
+			StubDetails: func(shippingRepo *mockrepo.MockShippingRepository) {
+				gomock.InOrder(
+					shippingRepo.EXPECT().CountServiceablePins().Times(1).Return(2, nil),
+					shippingRepo.EXPECT().FindServiceablePin("688541").Times(1).Return(domain.ServiceablePin{}, nil),
+				)
+			},
+			expectedOutput: models.Serviceability{Pin: "688541"},
+			expectedError:  nil,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			shippingUseCase := mocks.newShippingUseCase()
+			test.StubDetails(mocks.shippingRepo)
+
+			delivery, err := shippingUseCase.CheckServiceability(" 688541 ")
+			// the estimate counts the delivery days from today
+			if test.expectedOutput.Serviceable {
+				year, month, day := time.Now().Date()
+				test.expectedOutput.EstimatedDelivery = time.Date(year, month, day, 0, 0, 0, 0, time.Local).AddDate(0, 0, test.expectedOutput.DeliveryDays)
+			}
+			assert.Equal(t, test.expectedOutput, delivery)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -3,11 +3,14 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"time"
+	"unicode"
 
 	"jerseyhub/pkg/config"
 	"jerseyhub/pkg/domain"
 	helper_interface "jerseyhub/pkg/helper/interface"
 	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
 )
 
@@ -18,9 +21,10 @@ type userUseCase struct {
 	inventoryRepository interfaces.InventoryRepository
 	orderRepository     interfaces.OrderRepository
 	helper              helper_interface.Helper
+	emailUseCase        services.EmailUseCase
 }
 
-func NewUserUseCase(repo interfaces.UserRepository, cfg config.Config, otp interfaces.OtpRepository, inv interfaces.InventoryRepository, order interfaces.OrderRepository, h helper_interface.Helper) *userUseCase {
+func NewUserUseCase(repo interfaces.UserRepository, cfg config.Config, otp interfaces.OtpRepository, inv interfaces.InventoryRepository, order interfaces.OrderRepository, h helper_interface.Helper, email services.EmailUseCase) *userUseCase {
 	return &userUseCase{
 		userRepo:            repo,
 		cfg:                 cfg,
@@ -28,12 +32,39 @@ func NewUserUseCase(repo interfaces.UserRepository, cfg config.Config, otp inter
 		inventoryRepository: inv,
 		orderRepository:     order,
 		helper:              h,
+		emailUseCase:        email,
 	}
 }
 
 var InternalError = "Internal Server Error"
 var ErrorHashingPassword = "Error In Hashing Password"
 
+const passwordResetValidity = time.Minute * 30
+
+// validatePassword holds the password rules shared by signup, change password and the reset flows
+func validatePassword(password string) error {
+
+	if len(password) < 8 || len(password) > 20 {
+		return errors.New("password should be between 8 and 20 characters")
+	}
+
+	var hasLetter, hasDigit bool
+	for _, c := range password {
+		switch {
+		case unicode.IsLetter(c):
+			hasLetter = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		}
+	}
+
+	if !hasLetter || !hasDigit {
+		return errors.New("password should contain at least one letter and one number")
+	}
+
+	return nil
+}
+
 func (u *userUseCase) UserSignUp(user models.UserDetails, ref string) (models.TokenUsers, error) {
 	// Check whether the user already exist. If yes, show the error message, since this is signUp
 	userExist := u.userRepo.CheckUserAvailability(user.Email)
@@ -44,6 +75,10 @@ func (u *userUseCase) UserSignUp(user models.UserDetails, ref string) (models.To
 		return models.TokenUsers{}, errors.New("password does not match")
 	}
 
+	if err := validatePassword(user.Password); err != nil {
+		return models.TokenUsers{}, err
+	}
+
 	referenceUser, err := u.userRepo.FindUserFromReference(ref)
 	if err != nil {
 		return models.TokenUsers{}, errors.New("cannot find reference user")
@@ -84,6 +119,12 @@ func (u *userUseCase) UserSignUp(user models.UserDetails, ref string) (models.To
 	if _, err := u.orderRepository.CreateNewWallet(userData.Id); err != nil {
 		return models.TokenUsers{}, errors.New("errror in creating new wallet")
 	}
+
+	// signup should not fail if the mail could not be sent, the user can ask for the link again
+	if err := u.emailUseCase.SendVerificationEmail(userData.Id); err != nil {
+		fmt.Println("could not send verification email:", err)
+	}
+
 	return models.TokenUsers{
 		Users: userData,
 		Token: tokenString,
@@ -145,7 +186,7 @@ func (i *userUseCase) AddAddress(id int, address models.AddAddress) error {
 	if !rslt {
 		result = true
 	} else {
-		result = false
+		result = address.Default
 	}
 
 	err := i.userRepo.AddAddress(id, address, result)
@@ -168,6 +209,67 @@ func (i *userUseCase) GetAddresses(id int) ([]domain.Address, error) {
 
 }
 
+func (i *userUseCase) EditAddress(userID, addressID int, address models.AddAddress) error {
+
+	existing, err := i.userRepo.GetAddress(userID, addressID)
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if existing.Id == 0 {
+		return errors.New("address does not exist")
+	}
+
+	// the default can be moved to another address but not taken away without one
+	isDefault := existing.Default || address.Default
+	if err := i.userRepo.UpdateAddress(userID, addressID, address, isDefault); err != nil {
+		return errors.New("could not update the address")
+	}
+
+	return nil
+}
+
+func (i *userUseCase) DeleteAddress(userID, addressID int) error {
+
+	existing, err := i.userRepo.GetAddress(userID, addressID)
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if existing.Id == 0 {
+		return errors.New("address does not exist")
+	}
+
+	inOrders, err := i.userRepo.CheckIfAddressInOrders(addressID)
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if err := i.userRepo.DeleteAddress(userID, addressID, inOrders); err != nil {
+		return errors.New("could not delete the address")
+	}
+
+	return nil
+}
+
+func (i *userUseCase) SetDefaultAddress(userID, addressID int) error {
+
+	existing, err := i.userRepo.GetAddress(userID, addressID)
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if existing.Id == 0 {
+		return errors.New("address does not exist")
+	}
+
+	if err := i.userRepo.SetDefaultAddress(userID, addressID); err != nil {
+		return errors.New("could not change the default address")
+	}
+
+	return nil
+}
+
 func (i *userUseCase) GetUserDetails(id int) (models.UserDetailsResponse, error) {
 
 	details, err := i.userRepo.GetUserDetails(id)
@@ -195,12 +297,24 @@ func (i *userUseCase) ChangePassword(id int, old string, password string, repass
 		return errors.New("passwords does not match")
 	}
 
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+
 	newpassword, err := i.helper.PasswordHashing(password)
 	if err != nil {
 		return errors.New("error in hashing password")
 	}
 
-	return i.userRepo.ChangePassword(id, string(newpassword))
+	if err := i.userRepo.ChangePassword(id, string(newpassword)); err != nil {
+		return err
+	}
+
+	if err := i.emailUseCase.SendPasswordChangedEmail(id); err != nil {
+		fmt.Println("could not send password changed email:", err)
+	}
+
+	return nil
 
 }
 
@@ -222,6 +336,11 @@ func (u *userUseCase) ForgotPasswordSend(phone string) error {
 }
 
 func (u *userUseCase) ForgotPasswordVerifyAndChange(model models.ForgotVerify) error {
+
+	if err := validatePassword(model.NewPassword); err != nil {
+		return err
+	}
+
 	u.helper.TwilioSetup(u.cfg.ACCOUNTSID, u.cfg.AUTHTOKEN)
 	err := u.helper.TwilioVerifyOTP(u.cfg.SERVICESID, model.Otp, model.Phone)
 	if err != nil {
@@ -243,9 +362,90 @@ func (u *userUseCase) ForgotPasswordVerifyAndChange(model models.ForgotVerify) e
 		return errors.New("could not change password")
 	}
 
+	if err := u.emailUseCase.SendPasswordChangedEmail(id); err != nil {
+		fmt.Println("could not send password changed email:", err)
+	}
+
+	return nil
+}
+
+func (u *userUseCase) ForgotPasswordEmailSend(email string) error {
+
+	user, err := u.userRepo.FindUserByEmail(models.UserLogin{Email: email})
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	// the response is the same whether the account exists or not, so that emails cannot be enumerated
+	if user.Id == 0 {
+		return nil
+	}
+
+	token, err := u.helper.GenerateSecureToken()
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if err := u.userRepo.CreatePasswordReset(int(user.Id), u.helper.HashToken(token), time.Now().Add(passwordResetValidity)); err != nil {
+		return errors.New("could not create password reset")
+	}
+
+	if err := u.emailUseCase.SendPasswordResetEmail(int(user.Id), token); err != nil {
+		return errors.New("could not send password reset email")
+	}
+
+	return nil
+}
+
+func (u *userUseCase) ResetPasswordWithToken(model models.ResetPassword) error {
+
+	if model.Password != model.ConfirmPassword {
+		return errors.New("passwords does not match")
+	}
+
+	if err := validatePassword(model.Password); err != nil {
+		return err
+	}
+
+	reset, err := u.userRepo.FindPasswordReset(u.helper.HashToken(model.Token))
+	if err != nil {
+		return errors.New(InternalError)
+	}
+
+	if reset.ID == 0 || reset.Used {
+		return errors.New("invalid or already used reset link")
+	}
+
+	if time.Now().After(reset.ExpiresAt) {
+		return errors.New("reset link expired")
+	}
+
+	newpassword, err := u.helper.PasswordHashing(model.Password)
+	if err != nil {
+		return errors.New("error in hashing password")
+	}
+
+	if err := u.userRepo.ResetPassword(int(reset.ID), int(reset.UserID), newpassword); err != nil {
+		return errors.New("could not change password")
+	}
+
+	if err := u.emailUseCase.SendPasswordChangedEmail(int(reset.UserID)); err != nil {
+		fmt.Println("could not send password changed email:", err)
+	}
+
 	return nil
 }
 
+func (u *userUseCase) GetSessionsRevokedAt(id int) (time.Time, error) {
+
+	revokedAt, err := u.userRepo.GetSessionsRevokedAt(id)
+	if err != nil {
+		return time.Time{}, errors.New(InternalError)
+	}
+
+	return revokedAt, nil
+}
+
 func (i *userUseCase) EditName(id int, name string) error {
 
 	err := i.userRepo.EditName(id, name)
@@ -264,6 +464,11 @@ func (i *userUseCase) EditEmail(id int, email string) error {
 		return errors.New("could not change")
 	}
 
+	// the new address has to be verified again
+	if err := i.emailUseCase.SendVerificationEmail(id); err != nil {
+		fmt.Println("could not send verification email:", err)
+	}
+
 	return nil
 
 }
@@ -3,17 +3,25 @@ package usecase
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"jerseyhub/pkg/config"
 	"jerseyhub/pkg/domain"
 	"jerseyhub/pkg/mock/mockhelper"
 	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/mock/mockusecase"
 	"jerseyhub/pkg/utils/models"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
+// the repository gets the user with the password already hashed
+func hashedSignup(user models.UserDetails) models.UserDetails {
+	user.Password = "hashedpassword"
+	return user
+}
+
 func Test_UserSignUp(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -23,9 +31,11 @@ func Test_UserSignUp(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+	emailUseCase.EXPECT().SendVerificationEmail(1).AnyTimes().Return(nil)
 
 	testData := map[string]struct {
 		input          models.UserDetails
@@ -38,16 +48,16 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), nil),
-					userRepo.EXPECT().UserSignUp(signupData, gomock.Any().String()).Times(1).Return(
+					userRepo.EXPECT().UserSignUp(hashedSignup(signupData), gomock.Any().String()).Times(1).Return(
 						models.UserDetailsResponse{
 							Id:    1,
 							Name:  signupData.Name,
@@ -82,8 +92,8 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
@@ -99,7 +109,7 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
+				Password:        "jersey123",
 				ConfirmPassword: "shshsh",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
@@ -116,8 +126,8 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
@@ -134,8 +144,8 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
@@ -145,7 +155,7 @@ func Test_UserSignUp(t *testing.T) {
 				)
 			},
 			expectedOutput: models.TokenUsers{},
-			expectedError:  errors.New("error in hashing password"),
+			expectedError:  errors.New(ErrorHashingPassword),
 		},
 
 		"could not generate reference code": {
@@ -153,19 +163,19 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), errors.New("error in creating reference id")),
 				)
 			},
 			expectedOutput: models.TokenUsers{},
-			expectedError:  errors.New("internal server error"),
+			expectedError:  errors.New(InternalError),
 		},
 
 		"could not add the user to database": {
@@ -173,16 +183,16 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), nil),
-					userRepo.EXPECT().UserSignUp(signupData, gomock.Any().String()).Times(1).Return(
+					userRepo.EXPECT().UserSignUp(hashedSignup(signupData), gomock.Any().String()).Times(1).Return(
 						models.UserDetailsResponse{}, errors.New("could not add the user"),
 					),
 				)
@@ -196,16 +206,16 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), nil),
-					userRepo.EXPECT().UserSignUp(signupData, gomock.Any().String()).Times(1).Return(
+					userRepo.EXPECT().UserSignUp(hashedSignup(signupData), gomock.Any().String()).Times(1).Return(
 						models.UserDetailsResponse{
 							Id:    1,
 							Name:  signupData.Name,
@@ -230,16 +240,16 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), nil),
-					userRepo.EXPECT().UserSignUp(signupData, gomock.Any().String()).Times(1).Return(
+					userRepo.EXPECT().UserSignUp(hashedSignup(signupData), gomock.Any().String()).Times(1).Return(
 						models.UserDetailsResponse{
 							Id:    1,
 							Name:  signupData.Name,
@@ -265,16 +275,16 @@ func Test_UserSignUp(t *testing.T) {
 				Name:            "Arun K",
 				Email:           "arthurbishop120@gmail.com",
 				Phone:           "6282246077",
-				Password:        gomock.Any().String(),
-				ConfirmPassword: gomock.Any().String(),
+				Password:        "jersey123",
+				ConfirmPassword: "jersey123",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, signupData models.UserDetails, helper mockhelper.MockHelper) {
 				gomock.InOrder(
 					userRepo.EXPECT().CheckUserAvailability(signupData.Email).Times(1).Return(false),
 					userRepo.EXPECT().FindUserFromReference("12345").Times(1).Return(1, nil),
-					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return(gomock.Any().String(), nil),
+					helper.EXPECT().PasswordHashing(signupData.Password).Times(1).Return("hashedpassword", nil),
 					helper.EXPECT().GenerateRefferalCode().Times(1).Return(gomock.Any().String(), nil),
-					userRepo.EXPECT().UserSignUp(signupData, gomock.Any().String()).Times(1).Return(
+					userRepo.EXPECT().UserSignUp(hashedSignup(signupData), gomock.Any().String()).Times(1).Return(
 						models.UserDetailsResponse{
 							Id:    1,
 							Name:  signupData.Name,
@@ -320,9 +330,10 @@ func Test_LoginHandler(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          models.UserLogin
@@ -398,7 +409,7 @@ func Test_LoginHandler(t *testing.T) {
 				Users: models.UserDetailsResponse{},
 				Token: "ajjsjsjsjsjs.sjsjsjsjsjs.sjsjsjsjs",
 			},
-			expectedError: errors.New("internal error"),
+			expectedError: errors.New(InternalError),
 		},
 		"blocked user is trying to login": {
 			input: models.UserLogin{
@@ -433,7 +444,7 @@ func Test_LoginHandler(t *testing.T) {
 				Users: models.UserDetailsResponse{},
 				Token: "ajjsjsjsjsjs.sjsjsjsjsjs.sjsjsjsjs",
 			},
-			expectedError: errors.New("internal error"),
+			expectedError: errors.New(InternalError),
 		},
 		"incorrect password": {
 			input: models.UserLogin{
@@ -518,9 +529,10 @@ func Test_AddAddress(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          models.AddAddress
@@ -599,9 +611,10 @@ func Test_GetAddresses(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          int
@@ -641,6 +654,118 @@ func Test_GetAddresses(t *testing.T) {
 	}
 }
 
+func Test_DeleteAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mockrepo.NewMockUserRepository(ctrl)
+	orderRepo := mockrepo.NewMockOrderRepository(ctrl)
+	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
+	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
+	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
+	cfg := config.Config{}
+
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+
+	testData := map[string]struct {
+		StubDetails   func(mockrepo.MockUserRepository)
+		expectedError error
+	}{
+		"used by orders is soft deleted": {
+			StubDetails: func(userRepo mockrepo.MockUserRepository) {
+				gomock.InOrder(
+					userRepo.EXPECT().GetAddress(1, 2).Times(1).Return(domain.Address{Id: 2, UserID: 1}, nil),
+					userRepo.EXPECT().CheckIfAddressInOrders(2).Times(1).Return(true, nil),
+					userRepo.EXPECT().DeleteAddress(1, 2, true).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"unused is removed": {
+			StubDetails: func(userRepo mockrepo.MockUserRepository) {
+				gomock.InOrder(
+					userRepo.EXPECT().GetAddress(1, 2).Times(1).Return(domain.Address{Id: 2, UserID: 1}, nil),
+					userRepo.EXPECT().CheckIfAddressInOrders(2).Times(1).Return(false, nil),
+					userRepo.EXPECT().DeleteAddress(1, 2, false).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"address of someone else": {
+			StubDetails: func(userRepo mockrepo.MockUserRepository) {
+				gomock.InOrder(
+					userRepo.EXPECT().GetAddress(1, 2).Times(1).Return(domain.Address{}, nil),
+				)
+			},
+			expectedError: errors.New("address does not exist"),
+		},
+	}
+	for _, test := range testData {
+
+		test.StubDetails(*userRepo)
+
+		err := userUseCase.DeleteAddress(1, 2)
+		assert.Equal(t, test.expectedError, err)
+
+	}
+}
+
+func Test_EditAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mockrepo.NewMockUserRepository(ctrl)
+	orderRepo := mockrepo.NewMockOrderRepository(ctrl)
+	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
+	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
+	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
+	cfg := config.Config{}
+
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+
+	address := models.AddAddress{
+		Name:      "Arun K",
+		HouseName: "nellikkal",
+		Street:    "pallippuram",
+		City:      "cherthala",
+		State:     "kerala",
+		Phone:     "9876543210",
+		Pin:       "688541",
+	}
+
+	testData := map[string]struct {
+		StubDetails   func(mockrepo.MockUserRepository)
+		expectedError error
+	}{
+		"default address stays default": {
+			StubDetails: func(userRepo mockrepo.MockUserRepository) {
+				gomock.InOrder(
+					userRepo.EXPECT().GetAddress(1, 2).Times(1).Return(domain.Address{Id: 2, UserID: 1, Default: true}, nil),
+					userRepo.EXPECT().UpdateAddress(1, 2, address, true).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"error from repository": {
+			StubDetails: func(userRepo mockrepo.MockUserRepository) {
+				gomock.InOrder(
+					userRepo.EXPECT().GetAddress(1, 2).Times(1).Return(domain.Address{Id: 2, UserID: 1}, nil),
+					userRepo.EXPECT().UpdateAddress(1, 2, address, false).Times(1).Return(errors.New("error")),
+				)
+			},
+			expectedError: errors.New("could not update the address"),
+		},
+	}
+	for _, test := range testData {
+
+		test.StubDetails(*userRepo)
+
+		err := userUseCase.EditAddress(1, 2, address)
+		assert.Equal(t, test.expectedError, err)
+
+	}
+}
+
 func Test_GetUserDetails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -650,9 +775,10 @@ func Test_GetUserDetails(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          int
@@ -711,9 +837,11 @@ func Test_ChangePassword(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+	emailUseCase.EXPECT().SendPasswordChangedEmail(1).AnyTimes().Return(nil)
 
 	testData := map[string]struct {
 		input struct {
@@ -740,8 +868,8 @@ func Test_ChangePassword(t *testing.T) {
 			}{
 				ID:         1,
 				Old:        "1234",
-				Password:   "4321",
-				RePassword: "4321",
+				Password:   "jersey4321",
+				RePassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, data struct {
 				ID         int
@@ -768,8 +896,8 @@ func Test_ChangePassword(t *testing.T) {
 			}{
 				ID:         1,
 				Old:        "1234",
-				Password:   "4321",
-				RePassword: "4321",
+				Password:   "jersey4321",
+				RePassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, data struct {
 				ID         int
@@ -782,7 +910,7 @@ func Test_ChangePassword(t *testing.T) {
 				)
 			},
 			expectedOutput: models.UserDetailsResponse{},
-			expectedError:  errors.New("internal error"),
+			expectedError:  errors.New(InternalError),
 		},
 		"hashing problem": {
 			input: struct {
@@ -793,8 +921,8 @@ func Test_ChangePassword(t *testing.T) {
 			}{
 				ID:         1,
 				Old:        "1234",
-				Password:   "4321",
-				RePassword: "4321",
+				Password:   "jersey4321",
+				RePassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, data struct {
 				ID         int
@@ -819,8 +947,8 @@ func Test_ChangePassword(t *testing.T) {
 			}{
 				ID:         1,
 				Old:        "1234",
-				Password:   "4321",
-				RePassword: "4321",
+				Password:   "jersey4321",
+				RePassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, data struct {
 				ID         int
@@ -883,9 +1011,10 @@ func Test_ForgotPasswordSend(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          string
@@ -947,9 +1076,11 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+	emailUseCase.EXPECT().SendPasswordChangedEmail(1).AnyTimes().Return(nil)
 
 	testData := map[string]struct {
 		input          models.ForgotVerify
@@ -961,7 +1092,7 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 			input: models.ForgotVerify{
 				Phone:       "6282246077",
 				Otp:         "1234",
-				NewPassword: "4321",
+				NewPassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, helper mockhelper.MockHelper, data models.ForgotVerify) {
 				gomock.InOrder(
@@ -979,7 +1110,7 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 			input: models.ForgotVerify{
 				Phone:       "6282246077",
 				Otp:         "1234",
-				NewPassword: "4321",
+				NewPassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, helper mockhelper.MockHelper, data models.ForgotVerify) {
 				gomock.InOrder(
@@ -994,7 +1125,7 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 			input: models.ForgotVerify{
 				Phone:       "6282246077",
 				Otp:         "1234",
-				NewPassword: "4321",
+				NewPassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, helper mockhelper.MockHelper, data models.ForgotVerify) {
 				gomock.InOrder(
@@ -1010,7 +1141,7 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 			input: models.ForgotVerify{
 				Phone:       "6282246077",
 				Otp:         "1234",
-				NewPassword: "4321",
+				NewPassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, helper mockhelper.MockHelper, data models.ForgotVerify) {
 				gomock.InOrder(
@@ -1027,7 +1158,7 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 			input: models.ForgotVerify{
 				Phone:       "6282246077",
 				Otp:         "1234",
-				NewPassword: "4321",
+				NewPassword: "jersey4321",
 			},
 			StubDetails: func(userRepo mockrepo.MockUserRepository, orderRepo mockrepo.MockOrderRepository, helper mockhelper.MockHelper, data models.ForgotVerify) {
 				gomock.InOrder(
@@ -1052,6 +1183,139 @@ func Test_ForgotPasswordVerifyAndChange(t *testing.T) {
 	}
 }
 
+func Test_ForgotPasswordEmailSend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mockrepo.NewMockUserRepository(ctrl)
+	orderRepo := mockrepo.NewMockOrderRepository(ctrl)
+	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
+	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
+	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
+	cfg := config.Config{}
+
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+
+	testData := map[string]struct {
+		input         string
+		StubDetails   func(mockrepo.MockUserRepository, mockhelper.MockHelper, mockusecase.MockEmailUseCase, string)
+		expectedError error
+	}{
+		"success": {
+			input: "arthur@gmail.com",
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, emailUseCase mockusecase.MockEmailUseCase, email string) {
+				gomock.InOrder(
+					userRepo.EXPECT().FindUserByEmail(models.UserLogin{Email: email}).Times(1).Return(models.UserSignInResponse{Id: 1, Email: email}, nil),
+					helper.EXPECT().GenerateSecureToken().Times(1).Return("token", nil),
+					helper.EXPECT().HashToken("token").Times(1).Return("hash"),
+					userRepo.EXPECT().CreatePasswordReset(1, "hash", gomock.Any()).Times(1).Return(nil),
+					emailUseCase.EXPECT().SendPasswordResetEmail(1, "token").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"unknown email": {
+			input: "nobody@gmail.com",
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, emailUseCase mockusecase.MockEmailUseCase, email string) {
+				userRepo.EXPECT().FindUserByEmail(models.UserLogin{Email: email}).Times(1).Return(models.UserSignInResponse{}, nil)
+			},
+			expectedError: nil,
+		},
+		"could not create reset": {
+			input: "arthur@gmail.com",
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, emailUseCase mockusecase.MockEmailUseCase, email string) {
+				gomock.InOrder(
+					userRepo.EXPECT().FindUserByEmail(models.UserLogin{Email: email}).Times(1).Return(models.UserSignInResponse{Id: 1, Email: email}, nil),
+					helper.EXPECT().GenerateSecureToken().Times(1).Return("token", nil),
+					helper.EXPECT().HashToken("token").Times(1).Return("hash"),
+					userRepo.EXPECT().CreatePasswordReset(1, "hash", gomock.Any()).Times(1).Return(errors.New("error")),
+				)
+			},
+			expectedError: errors.New("could not create password reset"),
+		},
+	}
+	for _, test := range testData {
+
+		test.StubDetails(*userRepo, *helper, *emailUseCase, test.input)
+
+		err := userUseCase.ForgotPasswordEmailSend(test.input)
+		assert.Equal(t, test.expectedError, err)
+
+	}
+}
+
+func Test_ResetPasswordWithToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mockrepo.NewMockUserRepository(ctrl)
+	orderRepo := mockrepo.NewMockOrderRepository(ctrl)
+	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
+	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
+	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
+	cfg := config.Config{}
+
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+	emailUseCase.EXPECT().SendPasswordChangedEmail(1).AnyTimes().Return(nil)
+
+	testData := map[string]struct {
+		input         models.ResetPassword
+		StubDetails   func(mockrepo.MockUserRepository, mockhelper.MockHelper, models.ResetPassword)
+		expectedError error
+	}{
+		"success": {
+			input: models.ResetPassword{Token: "token", Password: "jersey4321", ConfirmPassword: "jersey4321"},
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, data models.ResetPassword) {
+				gomock.InOrder(
+					helper.EXPECT().HashToken(data.Token).Times(1).Return("hash"),
+					userRepo.EXPECT().FindPasswordReset("hash").Times(1).Return(domain.PasswordReset{ID: 2, UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, nil),
+					helper.EXPECT().PasswordHashing(data.Password).Times(1).Return("hashed", nil),
+					userRepo.EXPECT().ResetPassword(2, 1, "hashed").Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"password mismatch": {
+			input:         models.ResetPassword{Token: "token", Password: "jersey4321", ConfirmPassword: "jersey1234"},
+			StubDetails:   func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, data models.ResetPassword) {},
+			expectedError: errors.New("passwords does not match"),
+		},
+		"weak password": {
+			input:         models.ResetPassword{Token: "token", Password: "4321", ConfirmPassword: "4321"},
+			StubDetails:   func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, data models.ResetPassword) {},
+			expectedError: errors.New("password should be between 8 and 20 characters"),
+		},
+		"used token": {
+			input: models.ResetPassword{Token: "token", Password: "jersey4321", ConfirmPassword: "jersey4321"},
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, data models.ResetPassword) {
+				gomock.InOrder(
+					helper.EXPECT().HashToken(data.Token).Times(1).Return("hash"),
+					userRepo.EXPECT().FindPasswordReset("hash").Times(1).Return(domain.PasswordReset{ID: 2, UserID: 1, Used: true, ExpiresAt: time.Now().Add(time.Minute)}, nil),
+				)
+			},
+			expectedError: errors.New("invalid or already used reset link"),
+		},
+		"expired token": {
+			input: models.ResetPassword{Token: "token", Password: "jersey4321", ConfirmPassword: "jersey4321"},
+			StubDetails: func(userRepo mockrepo.MockUserRepository, helper mockhelper.MockHelper, data models.ResetPassword) {
+				gomock.InOrder(
+					helper.EXPECT().HashToken(data.Token).Times(1).Return("hash"),
+					userRepo.EXPECT().FindPasswordReset("hash").Times(1).Return(domain.PasswordReset{ID: 2, UserID: 1, ExpiresAt: time.Now().Add(-time.Minute)}, nil),
+				)
+			},
+			expectedError: errors.New("reset link expired"),
+		},
+	}
+	for _, test := range testData {
+
+		test.StubDetails(*userRepo, *helper, test.input)
+
+		err := userUseCase.ResetPasswordWithToken(test.input)
+		assert.Equal(t, test.expectedError, err)
+
+	}
+}
+
 func Test_EditName(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -1061,9 +1325,10 @@ func Test_EditName(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input struct {
@@ -1135,9 +1400,11 @@ func Test_EditEmail(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
+	emailUseCase.EXPECT().SendVerificationEmail(1).AnyTimes().Return(nil)
 
 	testData := map[string]struct {
 		input struct {
@@ -1209,9 +1476,10 @@ func Test_EditPhone(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input struct {
@@ -1283,9 +1551,10 @@ func Test_UpdateQuantityAdd(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input struct {
@@ -1357,9 +1626,10 @@ func Test_UpdateQuantityLess(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input struct {
@@ -1431,9 +1701,10 @@ func Test_UpdateQuantityLess(t *testing.T) {
 // 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 // 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 // 	helper := mockhelper.NewMockHelper(ctrl)
+// 	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 // 	cfg := config.Config{}
 
-// 	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+// 	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 // 	testData := map[string]struct {
 // 		input1          int
@@ -1482,9 +1753,10 @@ func Test_GetMyReferenceLink(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          int
@@ -1533,9 +1805,10 @@ func Test_GetCart(t *testing.T) {
 	otpRepo := mockrepo.NewMockOtpRepository(ctrl)
 	inventoryRepo := mockrepo.NewMockInventoryRepository(ctrl)
 	helper := mockhelper.NewMockHelper(ctrl)
+	emailUseCase := mockusecase.NewMockEmailUseCase(ctrl)
 	cfg := config.Config{}
 
-	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper)
+	userUseCase := NewUserUseCase(userRepo, cfg, otpRepo, inventoryRepo, orderRepo, helper, emailUseCase)
 
 	testData := map[string]struct {
 		input          int
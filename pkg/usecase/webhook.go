@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
+	"jerseyhub/pkg/utils/models"
+	webhook_interface "jerseyhub/pkg/webhook/interface"
+)
+
+const (
+	webhookPollInterval = time.Second * 5
+	webhookBatchSize    = 20
+	webhookLease        = time.Minute * 2
+	// 30s, 1m, 2m and so on, the last try is a bit over an hour after the first
+	webhookMaxAttempts = 8
+)
+
+// events partners can listen to, they are fanned out from the outbox
+var webhookEvents = []string{
+	models.EventOrderPlaced,
+	models.EventOrderStatusChanged,
+	models.EventPaymentCaptured,
+	models.EventUserRegistered,
+	models.EventStockChanged,
+}
+
+type webhookEnvelope struct {
+	ID        int             `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type webhookUseCase struct {
+	repository interfaces.WebhookRepository
+	sender     webhook_interface.Sender
+}
+
+func NewWebhookUseCase(repo interfaces.WebhookRepository, sender webhook_interface.Sender, events services.EventUseCase) *webhookUseCase {
+	w := &webhookUseCase{
+		repository: repo,
+		sender:     sender,
+	}
+
+	for _, event := range webhookEvents {
+		events.Subscribe(event, "webhook.fanout", w.fanout)
+	}
+
+	return w
+}
+
+// fanout only queues the deliveries, a slow partner does not hold up the other subscribers
+func (w *webhookUseCase) fanout(event models.Event) error {
+
+	payload, err := json.Marshal(webhookEnvelope{
+		ID:        event.ID,
+		Event:     event.Name,
+		CreatedAt: event.CreatedAt,
+		Data:      json.RawMessage(event.Payload),
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.repository.AddEventDeliveries(event.ID, event.Name, string(payload))
+}
+
+func generateWebhookSecret() (string, error) {
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+func (w *webhookUseCase) RegisterWebhook(webhook models.AddWebhook) (models.NewWebhook, error) {
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return models.NewWebhook{}, errors.New(InternalError)
+	}
+
+	events := strings.Join(webhook.Events, ",")
+	id, err := w.repository.AddWebhook(webhook.URL, secret, events)
+	if err != nil {
+		return models.NewWebhook{}, err
+	}
+
+	return models.NewWebhook{ID: id, URL: webhook.URL, Events: events, Secret: secret}, nil
+}
+
+func (w *webhookUseCase) GetWebhooks() ([]models.WebhookDetails, error) {
+	return w.repository.GetWebhooks()
+}
+
+func (w *webhookUseCase) DeleteWebhook(id int) error {
+
+	deleted, err := w.repository.DeleteWebhook(id)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New("webhook does not exist")
+	}
+
+	return nil
+}
+
+// Ping sends a test delivery straight away and gives back how it went, a ping is never retried
+func (w *webhookUseCase) Ping(id int) (models.WebhookDeliveryDetails, error) {
+
+	webhook, err := w.repository.GetWebhook(id)
+	if err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	if webhook.ID == 0 {
+		return models.WebhookDeliveryDetails{}, errors.New("webhook does not exist")
+	}
+
+	data, err := json.Marshal(map[string]int{"webhook_id": id})
+	if err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	payload, err := json.Marshal(webhookEnvelope{Event: "ping", CreatedAt: time.Now(), Data: data})
+	if err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	// kept out of the worker's reach while it is being sent here
+	deliveryID, err := w.repository.AddDelivery(id, "ping", string(payload), nil, time.Now().Add(webhookLease))
+	if err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	result, sendErr := w.sender.Send(webhook.URL, webhook.Secret, models.WebhookRequest{DeliveryID: deliveryID, Event: "ping", Payload: payload})
+	if sendErr != nil {
+		err = w.repository.FailDelivery(deliveryID, result, sendErr.Error())
+	} else {
+		err = w.repository.MarkDelivered(deliveryID, result)
+	}
+	if err != nil {
+		return models.WebhookDeliveryDetails{}, err
+	}
+
+	return w.repository.GetDelivery(deliveryID)
+}
+
+func (w *webhookUseCase) GetDeliveries(webhookID, page int) ([]models.WebhookDeliveryDetails, error) {
+	return w.repository.GetDeliveries(webhookID, page)
+}
+
+// ReplayDelivery queues the same payload again as a new delivery, the original stays in the log as it was
+func (w *webhookUseCase) ReplayDelivery(id int) (int, error) {
+
+	delivery, err := w.repository.GetDelivery(id)
+	if err != nil {
+		return 0, err
+	}
+
+	if delivery.ID == 0 {
+		return 0, errors.New("delivery does not exist")
+	}
+
+	if delivery.Status == "PENDING" {
+		return 0, errors.New("delivery is still being tried")
+	}
+
+	return w.repository.AddDelivery(delivery.WebhookID, delivery.Event, delivery.Payload, &delivery.ID, time.Now())
+}
+
+// Start runs the delivery worker in the background for as long as the server is up
+func (w *webhookUseCase) Start() {
+
+	go func() {
+		for {
+			if err := w.DeliverPending(); err != nil {
+				fmt.Println("could not deliver webhooks:", err)
+			}
+			time.Sleep(webhookPollInterval)
+		}
+	}()
+}
+
+func (w *webhookUseCase) DeliverPending() error {
+
+	deliveries, err := w.repository.ClaimDueDeliveries(webhookBatchSize, webhookLease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		w.deliver(delivery)
+	}
+
+	return nil
+}
+
+func (w *webhookUseCase) deliver(delivery models.WebhookDelivery) {
+
+	result, sendErr := w.sender.Send(delivery.URL, delivery.Secret, models.WebhookRequest{
+		DeliveryID: delivery.ID,
+		Event:      delivery.Event,
+		Payload:    []byte(delivery.Payload),
+	})
+
+	var err error
+	switch {
+	case sendErr == nil:
+		err = w.repository.MarkDelivered(delivery.ID, result)
+	case delivery.Attempts+1 >= webhookMaxAttempts:
+		err = w.repository.FailDelivery(delivery.ID, result, sendErr.Error())
+	default:
+		next := time.Now().Add(time.Second * 30 << delivery.Attempts)
+		err = w.repository.RetryDelivery(delivery.ID, result, sendErr.Error(), next)
+	}
+
+	if err != nil {
+		fmt.Println("could not update webhook delivery", delivery.ID, ":", err)
+	}
+}
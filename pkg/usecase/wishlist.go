@@ -2,19 +2,27 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	interfaces "jerseyhub/pkg/repository/interface"
+	services "jerseyhub/pkg/usecase/interface"
 	"jerseyhub/pkg/utils/models"
+	"math"
 )
 
+// products with this many or fewer left show as low on stock
+const lowStockLevel = 5
+
 type wishlistUseCase struct {
-	repository interfaces.WishlistRepository
-	offerRepo  interfaces.OfferRepository
+	repository   interfaces.WishlistRepository
+	offerRepo    interfaces.OfferRepository
+	alertUseCase services.AlertUseCase
 }
 
-func NewWishlistUseCase(repo interfaces.WishlistRepository, offer interfaces.OfferRepository) *wishlistUseCase {
+func NewWishlistUseCase(repo interfaces.WishlistRepository, offer interfaces.OfferRepository, alert services.AlertUseCase) *wishlistUseCase {
 	return &wishlistUseCase{
-		repository: repo,
-		offerRepo:  offer,
+		repository:   repo,
+		offerRepo:    offer,
+		alertUseCase: alert,
 	}
 }
 
@@ -33,6 +41,11 @@ func (w *wishlistUseCase) AddToWishlist(userID, inventoryID int) error {
 		return errors.New("could not add to wishlist")
 	}
 
+	// wishlisted products are watched for price drops and restocks
+	if err := w.alertUseCase.WatchWishlistItem(userID, inventoryID); err != nil {
+		fmt.Println("could not add alerts for wishlist item", inventoryID, ":", err)
+	}
+
 	return nil
 }
 
@@ -42,21 +55,25 @@ func (w *wishlistUseCase) RemoveFromWishlist(inventoryID, UserID int) error {
 		return errors.New("could not remove from wishlist")
 	}
 
+	if err := w.alertUseCase.UnwatchWishlistItem(UserID, inventoryID); err != nil {
+		fmt.Println("could not remove alerts for wishlist item", inventoryID, ":", err)
+	}
+
 	return nil
 }
 
-func (w *wishlistUseCase) GetWishList(id int) ([]models.Inventories, error) {
+func (w *wishlistUseCase) GetWishList(id int) ([]models.WishlistItem, error) {
 
 	productDetails, err := w.repository.GetWishList(id)
 	if err != nil {
-		return []models.Inventories{}, err
+		return []models.WishlistItem{}, err
 	}
 
 	//loop inside products and then calculate discounted price of each then return
 	for j := range productDetails {
 		discount_percentage, err := w.offerRepo.FindDiscountPercentage(productDetails[j].CategoryID)
 		if err != nil {
-			return []models.Inventories{}, errors.New("there was some error in finding the discounted prices")
+			return []models.WishlistItem{}, errors.New("there was some error in finding the discounted prices")
 		}
 		var discount float64
 
@@ -65,19 +82,68 @@ func (w *wishlistUseCase) GetWishList(id int) ([]models.Inventories, error) {
 		}
 
 		productDetails[j].DiscountedPrice = productDetails[j].Price - discount
+		productDetails[j].IfPresentAtWishlist = true
+		productDetails[j].IfPresentAtCart = productDetails[j].CartQuantity > 0
+		productDetails[j].StockStatus = stockStatus(productDetails[j].Stock)
+		productDetails[j].PriceStatus = priceStatus(productDetails[j].SavedPrice, productDetails[j].DiscountedPrice)
+	}
 
-		productDetails[j].IfPresentAtWishlist, err = w.repository.CheckIfTheItemIsPresentAtWishlist(id, int(productDetails[j].ID))
-		if err != nil {
-			return []models.Inventories{}, errors.New("error while checking ")
-		}
+	return productDetails, nil
 
-		productDetails[j].IfPresentAtCart, err = w.repository.CheckIfTheItemIsPresentAtCart(id, int(productDetails[j].ID))
-		if err != nil {
-			return []models.Inventories{}, errors.New("error while checking ")
-		}
+}
 
+func stockStatus(stock int) string {
+
+	switch {
+	case stock <= 0:
+		return "OUT_OF_STOCK"
+	case stock <= lowStockLevel:
+		return "LOW_STOCK"
+	default:
+		return "IN_STOCK"
 	}
+}
 
-	return productDetails, nil
+// priceStatus compares to the cent, the saved price is rounded that way
+func priceStatus(saved *float64, price float64) string {
+
+	if saved == nil {
+		return ""
+	}
+
+	switch now, then := math.Round(price*100), math.Round(*saved*100); {
+	case now < then:
+		return "DROPPED"
+	case now > then:
+		return "RISEN"
+	default:
+		return "SAME"
+	}
+}
+
+// MoveToWishlist parks a product from the cart in the wishlist, where it is watched like any wishlisted product
+func (w *wishlistUseCase) MoveToWishlist(userID, inventoryID int) error {
+
+	if err := w.repository.MoveToWishlist(userID, inventoryID); err != nil {
+		return err
+	}
+
+	if err := w.alertUseCase.WatchWishlistItem(userID, inventoryID); err != nil {
+		fmt.Println("could not add alerts for wishlist item", inventoryID, ":", err)
+	}
+
+	return nil
+}
+
+func (w *wishlistUseCase) MoveToCart(userID, inventoryID int) error {
 
+	if err := w.repository.MoveToCart(userID, inventoryID); err != nil {
+		return err
+	}
+
+	if err := w.alertUseCase.UnwatchWishlistItem(userID, inventoryID); err != nil {
+		fmt.Println("could not remove alerts for wishlist item", inventoryID, ":", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"jerseyhub/pkg/mock/mockrepo"
+	"jerseyhub/pkg/mock/mockusecase"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetWishList(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	mocks := newTestMocks(ctrl)
+	wishlistRepo, offerRepo := mocks.wishlistRepo, mocks.offerRepo
+	wishlistUseCase := mocks.newWishlistUseCase()
+
+	savedLower, savedSame, savedHigher := float64(800), float64(899.999), float64(1000)
+
+	gomock.InOrder(
+		wishlistRepo.EXPECT().GetWishList(5).Times(1).Return([]models.WishlistItem{
+			{Inventories: models.Inventories{ID: 3, CategoryID: 1, Price: 1000, Stock: 20}, SavedPrice: &savedLower, CartQuantity: 1},
+			{Inventories: models.Inventories{ID: 4, CategoryID: 1, Price: 1000, Stock: 5}, SavedPrice: &savedSame},
+			{Inventories: models.Inventories{ID: 6, CategoryID: 1, Price: 1000, Stock: 0}, SavedPrice: &savedHigher},
+			{Inventories: models.Inventories{ID: 7, CategoryID: 1, Price: 1000, Stock: 6}},
+		}, nil),
+		offerRepo.EXPECT().FindDiscountPercentage(1).Times(4).Return(10, nil),
+	)
+
+	items, err := wishlistUseCase.GetWishList(5)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.WishlistItem{
+		{Inventories: models.Inventories{ID: 3, CategoryID: 1, Price: 1000, Stock: 20, DiscountedPrice: 900, IfPresentAtWishlist: true, IfPresentAtCart: true}, StockStatus: "IN_STOCK", SavedPrice: &savedLower, PriceStatus: "RISEN", CartQuantity: 1},
+		{Inventories: models.Inventories{ID: 4, CategoryID: 1, Price: 1000, Stock: 5, DiscountedPrice: 900, IfPresentAtWishlist: true}, StockStatus: "LOW_STOCK", SavedPrice: &savedSame, PriceStatus: "SAME"},
+		{Inventories: models.Inventories{ID: 6, CategoryID: 1, Price: 1000, Stock: 0, DiscountedPrice: 900, IfPresentAtWishlist: true}, StockStatus: "OUT_OF_STOCK", SavedPrice: &savedHigher, PriceStatus: "DROPPED"},
+		{Inventories: models.Inventories{ID: 7, CategoryID: 1, Price: 1000, Stock: 6, DiscountedPrice: 900, IfPresentAtWishlist: true}, StockStatus: "IN_STOCK"},
+	}, items)
+}
+
+func Test_MoveToWishlist(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(*mockrepo.MockWishlistRepository, *mockusecase.MockAlertUseCase)
+		expectedError error
+	}{
+		"moved and watched": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				gomock.InOrder(
+					wishlistRepo.EXPECT().MoveToWishlist(5, 3).Times(1).Return(nil),
+					alert.EXPECT().WatchWishlistItem(5, 3).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"alerts failing do not undo the move": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				gomock.InOrder(
+					wishlistRepo.EXPECT().MoveToWishlist(5, 3).Times(1).Return(nil),
+					alert.EXPECT().WatchWishlistItem(5, 3).Times(1).Return(errors.New("could not add alerts")),
+				)
+			},
+			expectedError: nil,
+		},
+		"product not in the cart": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				wishlistRepo.EXPECT().MoveToWishlist(5, 3).Times(1).Return(errors.New("product is not in the cart"))
+			},
+			expectedError: errors.New("product is not in the cart"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			wishlistUseCase := mocks.newWishlistUseCase()
+			test.StubDetails(mocks.wishlistRepo, mocks.alert)
+
+			err := wishlistUseCase.MoveToWishlist(5, 3)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_MoveToCart(t *testing.T) {
+
+	testData := map[string]struct {
+		StubDetails   func(*mockrepo.MockWishlistRepository, *mockusecase.MockAlertUseCase)
+		expectedError error
+	}{
+		"moved and no longer watched": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				gomock.InOrder(
+					wishlistRepo.EXPECT().MoveToCart(5, 3).Times(1).Return(nil),
+					alert.EXPECT().UnwatchWishlistItem(5, 3).Times(1).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"out of stock stays in the wishlist": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				wishlistRepo.EXPECT().MoveToCart(5, 3).Times(1).Return(errors.New("out of stock"))
+			},
+			expectedError: errors.New("out of stock"),
+		},
+		"product not in the wishlist": {
+			StubDetails: func(wishlistRepo *mockrepo.MockWishlistRepository, alert *mockusecase.MockAlertUseCase) {
+				wishlistRepo.EXPECT().MoveToCart(5, 3).Times(1).Return(errors.New("product is not in the wishlist"))
+			},
+			expectedError: errors.New("product is not in the wishlist"),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mocks := newTestMocks(ctrl)
+			wishlistUseCase := mocks.newWishlistUseCase()
+			test.StubDetails(mocks.wishlistRepo, mocks.alert)
+
+			err := wishlistUseCase.MoveToCart(5, 3)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Next(t *testing.T) {
+
+	// a monday
+	from := time.Date(2024, time.January, 15, 10, 7, 30, 0, time.UTC)
+
+	testData := map[string]struct {
+		spec     string
+		expected time.Time
+	}{
+		"every minute": {
+			spec:     "* * * * *",
+			expected: time.Date(2024, time.January, 15, 10, 8, 0, 0, time.UTC),
+		},
+		"every fifteen minutes": {
+			spec:     "*/15 * * * *",
+			expected: time.Date(2024, time.January, 15, 10, 15, 0, 0, time.UTC),
+		},
+		"daily rolls over to the next day": {
+			spec:     "30 3 * * *",
+			expected: time.Date(2024, time.January, 16, 3, 30, 0, 0, time.UTC),
+		},
+		"list of hours": {
+			spec:     "0 9,18 * * *",
+			expected: time.Date(2024, time.January, 15, 18, 0, 0, 0, time.UTC),
+		},
+		"weekends only": {
+			spec:     "0 8 * * 6-7",
+			expected: time.Date(2024, time.January, 20, 8, 0, 0, 0, time.UTC),
+		},
+		"either day field matches when both are set": {
+			spec:     "0 0 1 * 3",
+			expected: time.Date(2024, time.January, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"next month": {
+			spec:     "0 0 1 2 *",
+			expected: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"leap day": {
+			spec:     "0 0 29 2 *",
+			expected: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := Parse(test.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, schedule.Next(from))
+		})
+	}
+}
+
+func Test_ParseErrors(t *testing.T) {
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type AdminLogin struct {
 	Email    string `json:"email,omitempty" validate:"required"`
 	Password string `json:"password" validate:"min=8,max=20"`
@@ -11,12 +13,27 @@ type AdminDetailsResponse struct {
 	Email string `json:"email" `
 }
 
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type TwoFactorEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type TwoFactorPolicy struct {
+	Required bool `json:"required"`
+}
+
 type NewPaymentMethod struct {
-	PaymentMethod string `json:"payment_method"`
+	PaymentMethod  string `json:"payment_method"`
+	CashOnDelivery bool   `json:"cash_on_delivery"`
 }
 
 type Coupons struct {
-	Coupon       string `json:"coupon" gorm:"unique;not null"`
-	DiscountRate int    `json:"discount_rate" gorm:"not null"`
-	Valid        bool   `json:"valid" gorm:"default:true"`
+	Coupon       string     `json:"coupon" gorm:"unique;not null"`
+	DiscountRate int        `json:"discount_rate" gorm:"not null"`
+	Valid        bool       `json:"valid" gorm:"default:true"`
+	ExpiresAt    *time.Time `json:"expires_at"`
 }
@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// AbandonedCart is a cart nobody touched for a while, Reminders counts the reminders since it was last touched
+type AbandonedCart struct {
+	CartID       int       `json:"cart_id"`
+	UserID       int       `json:"user_id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Items        int       `json:"items"`
+	Value        float64   `json:"value"`
+	LastActivity time.Time `json:"last_activity"`
+	Reminders    int       `json:"reminders"`
+}
+
+type CartReminder struct {
+	CartID    int
+	UserID    int
+	Step      int
+	CartValue float64
+	Sent      bool
+	CouponID  *int
+	Coupon    string
+}
+
+type CartRecoverySummary struct {
+	AbandonedCarts int     `json:"abandoned_carts"`
+	AbandonedValue float64 `json:"abandoned_value"`
+	RemindersSent  int     `json:"reminders_sent"`
+	Recovered      int     `json:"recovered_orders"`
+	RecoveredValue float64 `json:"recovered_value"`
+	// share of the reminders sent that led to an order, in percent
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+type AbandonedCartReport struct {
+	Summary CartRecoverySummary `json:"summary"`
+	Carts   []AbandonedCart     `json:"carts"`
+}
+
+type AddToGuestCart struct {
+	InventoryID int `json:"inventory_id" validate:"required"`
+	// added on top of what is already in the cart, 1 when left out
+	Quantity int `json:"quantity" validate:"gte=0"`
+}
+
+// GuestCart is sent back with the token the visitor has to keep sending as X-Cart-Token
+type GuestCart struct {
+	Token           string    `json:"cart_token"`
+	Items           []GetCart `json:"items"`
+	TotalPrice      float64   `json:"total_price"`
+	DiscountedPrice float64   `json:"discounted_price"`
+}
+
+type SetCartQuantity struct {
+	// 0 takes the product out of the cart
+	Quantity int `json:"quantity" validate:"gte=0"`
+}
+
+type CartItemStatus struct {
+	InventoryID   int
+	Quantity      int
+	Stock         int
+	Price         float64
+	AddedPrice    *float64
+	Discount      int
+	AddedDiscount *int
+}
@@ -0,0 +1,19 @@
+package models
+
+type EmailRecipient struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type EmailLinkData struct {
+	Name string
+	Link string
+}
+
+type OrderEmailDetails struct {
+	OrderID int     `json:"order_id"`
+	Name    string  `json:"name"`
+	Email   string  `json:"email"`
+	Amount  float64 `json:"amount"`
+}
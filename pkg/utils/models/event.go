@@ -0,0 +1,108 @@
+package models
+
+import "time"
+
+// domain events written to the outbox
+const (
+	EventOrderPlaced        = "OrderPlaced"
+	EventOrderStatusChanged = "OrderStatusChanged"
+	EventPaymentCaptured    = "PaymentCaptured"
+	EventUserRegistered     = "UserRegistered"
+	EventStockChanged       = "StockChanged"
+)
+
+// Event is an outbox row handed to the subscribers, Payload is one of the event structs below as json
+type Event struct {
+	ID        int
+	Name      string
+	Payload   string
+	Attempts  int
+	CreatedAt time.Time
+}
+
+type OrderPlacedEvent struct {
+	OrderID    int     `json:"order_id"`
+	UserID     int     `json:"user_id"`
+	FinalPrice float64 `json:"final_price"`
+}
+
+type OrderStatusChangedEvent struct {
+	OrderID int    `json:"order_id"`
+	UserID  int    `json:"user_id"`
+	Status  string `json:"status"`
+}
+
+type PaymentCapturedEvent struct {
+	OrderID   int    `json:"order_id"`
+	UserID    int    `json:"user_id"`
+	PaymentID string `json:"payment_id"`
+}
+
+type UserRegisteredEvent struct {
+	UserID int `json:"user_id"`
+}
+
+type StockChangedEvent struct {
+	InventoryID int `json:"inventory_id"`
+	Change      int `json:"change"`
+	Stock       int `json:"stock"`
+}
+
+type AddWebhook struct {
+	URL    string   `json:"url" validate:"required,url"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=OrderPlaced OrderStatusChanged PaymentCaptured UserRegistered StockChanged"`
+}
+
+type WebhookDetails struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Events    string    `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewWebhook is the only time the secret is shown
+type NewWebhook struct {
+	ID     int    `json:"id"`
+	URL    string `json:"url"`
+	Events string `json:"events"`
+	Secret string `json:"secret"`
+}
+
+// WebhookDelivery is a claimed delivery along with where it goes
+type WebhookDelivery struct {
+	ID       int
+	Event    string
+	Payload  string
+	Attempts int
+	URL      string
+	Secret   string
+}
+
+type WebhookDeliveryDetails struct {
+	ID            int        `json:"id"`
+	WebhookID     int        `json:"webhook_id"`
+	Event         string     `json:"event"`
+	OutboxEventID *int       `json:"outbox_event_id"`
+	ReplayOfID    *int       `json:"replay_of_id"`
+	Payload       string     `json:"payload"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	ResponseCode  int        `json:"response_code"`
+	ResponseBody  string     `json:"response_body"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+}
+
+type WebhookRequest struct {
+	DeliveryID int
+	Event      string
+	Payload    []byte
+}
+
+type WebhookResult struct {
+	StatusCode int
+	Body       string
+}
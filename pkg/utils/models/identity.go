@@ -0,0 +1,12 @@
+package models
+
+type OIDCClaims struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+type SocialLoginURL struct {
+	URL string `json:"url"`
+}
@@ -26,6 +26,21 @@ type Inventories struct {
 	IfPresentAtWishlist bool    `json:"if_present_at_wishlist"`
 	IfPresentAtCart     bool    `json:"if_present_at_cart"`
 	DiscountedPrice     float64 `json:"discounted_price"`
+	AverageRating       float64 `json:"average_rating"`
+	RatingCount         int     `json:"rating_count"`
+	// only filled on the product details page
+	Questions []QuestionDetails `json:"questions,omitempty" gorm:"-"`
+}
+
+// WishlistItem is a wishlisted product with how its stock and price stand against when it was saved
+type WishlistItem struct {
+	Inventories
+	// IN_STOCK, LOW_STOCK or OUT_OF_STOCK
+	StockStatus string   `json:"stock_status"`
+	SavedPrice  *float64 `json:"saved_price,omitempty"`
+	// SAME, DROPPED or RISEN against the saved price, left out for items saved before prices were kept
+	PriceStatus  string `json:"price_status,omitempty"`
+	CartQuantity int    `json:"cart_quantity"`
 }
 
 type AddInventories struct {
@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+type CategoryTax struct {
+	CategoryID int     `json:"category_id" validate:"required"`
+	Category   string  `json:"category"`
+	HsnCode    string  `json:"hsn_code" validate:"required,numeric,min=4,max=8"`
+	GstRate    float64 `json:"gst_rate" validate:"gte=0,lte=28"`
+}
+
+// ItemTax is the GST worked out for one order line, the amount charged is inclusive of the tax
+type ItemTax struct {
+	HsnCode      string
+	GstRate      float64
+	TaxableValue float64
+	Cgst         float64
+	Sgst         float64
+	Igst         float64
+}
+
+type InvoiceCustomer struct {
+	UserID    int
+	Name      string
+	Email     string
+	State     string
+	OrderedAt time.Time
+}
+
+type Invoice struct {
+	InvoiceNumber string
+	InvoiceDate   time.Time
+	SellerName    string
+	SellerAddress string
+	SellerGstin   string
+	SellerState   string
+	Customer      InvoiceCustomer
+	Interstate    bool
+	Order         IndividualOrderDetails
+	Items         []InvoiceItem
+	TotalTaxable  float64
+	TotalCgst     float64
+	TotalSgst     float64
+	TotalIgst     float64
+	Discount      float64
+}
+
+type InvoiceItem struct {
+	ProductName  string
+	HsnCode      string
+	Quantity     int
+	TaxableValue float64
+	GstRate      float64
+	Cgst         float64
+	Sgst         float64
+	Igst         float64
+	Total        float64
+}
+
+type InvoiceDocument struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
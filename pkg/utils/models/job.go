@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Job is a claimed job handed to its handler, Payload is whatever it was queued with as json
+type Job struct {
+	ID          int
+	Name        string
+	Payload     string
+	Attempts    int
+	MaxAttempts int
+}
+
+type JobDetails struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Payload     string     `json:"payload"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	LastError   string     `json:"last_error"`
+	RunAt       time.Time  `json:"run_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+}
+
+// JobStatusCount is how many jobs of a kind are in a status
+type JobStatusCount struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+type JobSchedule struct {
+	Name    string    `json:"name"`
+	Spec    string    `json:"spec"`
+	NextRun time.Time `json:"next_run"`
+}
+
+type JobStatus struct {
+	Counts    []JobStatusCount `json:"counts"`
+	Schedules []JobSchedule    `json:"schedules"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// Notification is what the dispatcher sends out on every channel, InAppOnly is for events that already have their own mail
+type Notification struct {
+	Category  string
+	Title     string
+	Message   string
+	Link      string
+	DedupeKey string
+	InAppOnly bool
+}
+
+type NotificationPreference struct {
+	Category string `json:"category" validate:"required,oneof=ORDER PAYMENT WALLET PRODUCT_ALERT CART"`
+	Enabled  bool   `json:"enabled"`
+	Email    bool   `json:"email"`
+}
+
+type AddProductAlert struct {
+	InventoryID int    `json:"inventory_id" validate:"required"`
+	Kind        string `json:"kind" validate:"required,oneof=BACK_IN_STOCK PRICE_DROP"`
+}
+
+type ProductAlertDetails struct {
+	ID             int        `json:"id"`
+	InventoryID    int        `json:"inventory_id"`
+	ProductName    string     `json:"product_name"`
+	Kind           string     `json:"kind"`
+	Price          float64    `json:"price"`
+	Active         bool       `json:"active"`
+	LastNotifiedAt *time.Time `json:"last_notified_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// AlertProduct is a product with the price the user actually pays after offers
+type AlertProduct struct {
+	ID          int     `json:"id"`
+	ProductName string  `json:"product_name"`
+	Stock       int     `json:"stock"`
+	Price       float64 `json:"price"`
+}
+
+// TriggeredAlert is an alert whose condition holds, Price is the current offer price of the product
+type TriggeredAlert struct {
+	ID             int
+	UserID         int
+	InventoryID    int
+	ProductName    string
+	OldPrice       float64
+	Price          float64
+	LastNotifiedAt *time.Time
+}
@@ -1,6 +1,9 @@
 package models
 
+import "time"
+
 type OfferMaking struct {
-	CategoryID int `json:"category_id"`
-	Discount   int `json:"discount"`
+	CategoryID int        `json:"category_id"`
+	Discount   int        `json:"discount"`
+	ExpiresAt  *time.Time `json:"expires_at"`
 }
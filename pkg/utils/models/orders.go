@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type OrderDetails struct {
 	ID            int     `json:"order_id"`
 	UserName      string  `json:"name"`
@@ -31,25 +33,85 @@ type OrderPaymentDetails struct {
 	FinalPrice float64 `json:"final_price"`
 }
 
+// OrderPayment is what decides whether an order can still be paid for
+type OrderPayment struct {
+	OrderID        int
+	UserID         int
+	Username       string
+	FinalPrice     float64
+	OrderStatus    string
+	PaymentStatus  string
+	CashOnDelivery bool
+	CreatedAt      time.Time
+}
+
+type RetryPayment struct {
+	OrderID    int       `json:"order_id"`
+	RazorID    string    `json:"razor_id"`
+	FinalPrice float64   `json:"final_price"`
+	PayBefore  time.Time `json:"pay_before"`
+}
+
 type EditOrderStatus struct {
 	OrderID int    `json:"order_id"`
 	Status  string `json:"order_status"`
 }
 
 type IndividualOrderDetails struct {
-	OrderID       int
-	Address       string
-	Phone         string
-	Products      []ProductDetails `gorm:"-"`
-	TotalAmount   float64
-	CouponUsed    string
-	OrderStatus   string
-	PaymentStatus string
+	OrderID        int
+	Address        string
+	Phone          string
+	Products       []ProductDetails `gorm:"-"`
+	TotalAmount    float64
+	ShippingCharge float64
+	CodCharge      float64
+	CouponUsed     string
+	OrderStatus    string
+	PaymentStatus  string
+	Shipments      []ShipmentDetails `gorm:"-"`
 }
 
 type ProductDetails struct {
-	ProductName string
-	Image       string
+	OrderItemID      int
+	ProductName      string
+	Image            string
+	Quantity         int
+	Amount           float64
+	HsnCode          string
+	GstRate          float64
+	TaxableValue     float64
+	Cgst             float64
+	Sgst             float64
+	Igst             float64
+	ItemStatus       string
+	CanceledQuantity int
+	ReturnedQuantity int
+	RefundedAmount   float64
+}
+
+type OrderItemRequest struct {
+	OrderItemID int `json:"order_item_id" validate:"required"`
+	Quantity    int `json:"quantity" validate:"required,gt=0"`
+}
+
+type OrderItemsRequest struct {
+	Items []OrderItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+// OrderItemState is what is left of an order line for canceling or returning
+type OrderItemState struct {
+	ID               int
+	Quantity         int
+	CanceledQuantity int
+	ReturnedQuantity int
+	Shipped          int
+	TotalPrice       float64
+	LinePaid         float64
+	RefundedAmount   float64
+}
+
+type OrderItemChange struct {
+	OrderItemID int
 	Quantity    int
-	Amount      float64
+	Refund      float64
 }
@@ -10,6 +10,7 @@ type VerifyData struct {
 }
 
 type PaymentMethod struct {
-	ID           uint   `json:"id"`
-	Payment_Name string `json:"payment_name"`
+	ID             uint   `json:"id"`
+	Payment_Name   string `json:"payment_name"`
+	CashOnDelivery bool   `json:"cash_on_delivery"`
 }
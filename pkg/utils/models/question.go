@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+type AddQuestion struct {
+	InventoryID int    `json:"inventory_id" validate:"required"`
+	Question    string `json:"question" validate:"required,max=500"`
+}
+
+type AddAnswer struct {
+	Answer string `json:"answer" validate:"required,max=2000"`
+}
+
+type QuestionDetails struct {
+	ID          int             `json:"id"`
+	InventoryID int             `json:"inventory_id"`
+	ProductName string          `json:"product_name"`
+	UserID      int             `json:"user_id"`
+	Username    string          `json:"name"`
+	Question    string          `json:"question"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Answers     []AnswerDetails `json:"answers" gorm:"-"`
+}
+
+type AnswerDetails struct {
+	ID         int       `json:"id"`
+	QuestionID int       `json:"question_id"`
+	UserID     int       `json:"user_id"`
+	Username   string    `json:"name"`
+	ByAdmin    bool      `json:"by_admin"`
+	Answer     string    `json:"answer"`
+	Upvotes    int       `json:"upvotes"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+type ReturnRequest struct {
+	ReasonCode string `json:"reason_code" validate:"required"`
+	Comments   string `json:"comments"`
+	// without items everything in the order which can still be returned is asked for
+	Items []OrderItemRequest `json:"items" validate:"dive"`
+}
+
+type ReturnItem struct {
+	OrderItemID int    `json:"order_item_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
+}
+
+type ReturnRequestDetails struct {
+	ID           int        `json:"id"`
+	OrderID      int        `json:"order_id"`
+	UserID       int        `json:"user_id"`
+	ReasonCode   string     `json:"reason_code"`
+	Comments     string     `json:"comments"`
+	Status       string     `json:"status"`
+	AdminNote    string     `json:"admin_note"`
+	PickupDate   *time.Time `json:"pickup_date"`
+	RefundAmount float64    `json:"refund_amount"`
+	Kind         string     `json:"kind"`
+	// only set on exchanges
+	ExchangeInventoryID *int         `json:"exchange_inventory_id"`
+	PriceDifference     float64      `json:"price_difference"`
+	ReplacementOrderID  *int         `json:"replacement_order_id"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+	Items               []ReturnItem `json:"items" gorm:"-"`
+	Images              []string     `json:"images" gorm:"-"`
+}
+
+type ReturnDecision struct {
+	Note string `json:"note"`
+}
+
+type ReturnPickup struct {
+	PickupDate time.Time `json:"pickup_date" validate:"required"`
+}
+
+type ReturnInspection struct {
+	Passed *bool  `json:"passed" validate:"required"`
+	Note   string `json:"note"`
+}
+
+type ExchangeRequest struct {
+	OrderItemID int `json:"order_item_id" validate:"required"`
+	Quantity    int `json:"quantity" validate:"required,gt=0"`
+	// another size of the same product
+	InventoryID int    `json:"inventory_id" validate:"required"`
+	Comments    string `json:"comments"`
+}
+
+type ExchangeProduct struct {
+	InventoryID int
+	ProductName string
+	CategoryID  int
+	Size        string
+	Stock       int
+	Price       float64
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type AddReview struct {
+	InventoryID int    `json:"inventory_id" validate:"required"`
+	Rating      int    `json:"rating" validate:"required,min=1,max=5"`
+	Title       string `json:"title" validate:"max=100"`
+	Comment     string `json:"comment" validate:"max=2000"`
+}
+
+type EditReview struct {
+	Rating  int    `json:"rating" validate:"required,min=1,max=5"`
+	Title   string `json:"title" validate:"max=100"`
+	Comment string `json:"comment" validate:"max=2000"`
+}
+
+type ReviewDetails struct {
+	ID          int        `json:"id"`
+	InventoryID int        `json:"inventory_id"`
+	ProductName string     `json:"product_name"`
+	UserID      int        `json:"user_id"`
+	Username    string     `json:"name"`
+	Rating      int        `json:"rating"`
+	Title       string     `json:"title"`
+	Comment     string     `json:"comment"`
+	Status      string     `json:"status"`
+	AdminReply  string     `json:"admin_reply"`
+	RepliedAt   *time.Time `json:"replied_at"`
+	Helpful     int        `json:"helpful"`
+	CreatedAt   time.Time  `json:"created_at"`
+	// only buyers can review, this goes false if they returned the item afterwards
+	VerifiedPurchase bool     `json:"verified_purchase"`
+	Images           []string `json:"images" gorm:"-"`
+}
+
+type ProductReviews struct {
+	AverageRating float64         `json:"average_rating"`
+	RatingCount   int             `json:"rating_count"`
+	Reviews       []ReviewDetails `json:"reviews" gorm:"-"`
+}
+
+type ReviewReply struct {
+	Reply string `json:"reply" validate:"required,max=2000"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+type CreateShipment struct {
+	// courier and tracking number are only given when the parcel was booked outside the app,
+	// otherwise the configured courier books it
+	Courier          string                `json:"courier"`
+	TrackingNumber   string                `json:"tracking_number"`
+	ExpectedDelivery time.Time             `json:"expected_delivery"`
+	Items            []ShipmentItemRequest `json:"items"`
+}
+
+type ShipmentItemRequest struct {
+	OrderItemID int `json:"order_item_id"`
+	Quantity    int `json:"quantity"`
+}
+
+type OrderItemQuantity struct {
+	ID       int
+	Quantity int
+	Shipped  int
+}
+
+type CourierShipmentRequest struct {
+	OrderID   int
+	Name      string
+	HouseName string
+	Street    string
+	City      string
+	State     string
+	Pin       string
+	Phone     string
+	Items     int
+}
+
+type CourierBooking struct {
+	Courier          string
+	TrackingNumber   string
+	ExpectedDelivery time.Time
+}
+
+type TrackingEvent struct {
+	Status      string    `json:"status" validate:"required"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+type ShipmentDetails struct {
+	ID               int                   `json:"id"`
+	Courier          string                `json:"courier"`
+	TrackingNumber   string                `json:"tracking_number"`
+	Status           string                `json:"status"`
+	ExpectedDelivery time.Time             `json:"expected_delivery"`
+	Items            []ShipmentItemDetails `json:"items" gorm:"-"`
+	Events           []TrackingEvent       `json:"events" gorm:"-"`
+}
+
+type ShipmentItemDetails struct {
+	OrderItemID int    `json:"order_item_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
+}
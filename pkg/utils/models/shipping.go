@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+type ShippingZone struct {
+	ID                int      `json:"id"`
+	Name              string   `json:"name" validate:"required"`
+	States            []string `json:"states" gorm:"-"`
+	PinPrefixes       []string `json:"pin_prefixes" gorm:"-"`
+	FlatRate          float64  `json:"flat_rate" validate:"gte=0"`
+	PerItemRate       float64  `json:"per_item_rate" validate:"gte=0"`
+	FreeShippingAbove float64  `json:"free_shipping_above" validate:"gte=0"`
+	CodSurcharge      float64  `json:"cod_surcharge" validate:"gte=0"`
+	IsDefault         bool     `json:"is_default"`
+}
+
+type ShippingCharge struct {
+	ZoneID       int     `json:"zone_id"`
+	ZoneName     string  `json:"zone_name"`
+	Shipping     float64 `json:"shipping"`
+	CodSurcharge float64 `json:"cod_surcharge"`
+}
+
+type ServiceablePin struct {
+	Pin            string `json:"pin" validate:"required,numeric,len=6"`
+	CodAllowed     bool   `json:"cod_allowed"`
+	PrepaidAllowed bool   `json:"prepaid_allowed"`
+	DeliveryDays   int    `json:"delivery_days" validate:"gte=0"`
+}
+
+type ServiceabilityImport struct {
+	Imported int      `json:"imported"`
+	Skipped  []string `json:"skipped"`
+}
+
+type Serviceability struct {
+	Pin               string    `json:"pin"`
+	Serviceable       bool      `json:"serviceable"`
+	CodAllowed        bool      `json:"cod_allowed"`
+	PrepaidAllowed    bool      `json:"prepaid_allowed"`
+	DeliveryDays      int       `json:"delivery_days"`
+	EstimatedDelivery time.Time `json:"estimated_delivery"`
+}
@@ -17,6 +17,9 @@ type Address struct {
 	City      string `json:"city" validate:"required"`
 	State     string `json:"state" validate:"required"`
 	Pin       string `json:"pin" validate:"required"`
+	Default   bool   `json:"default"`
+	// filled in at checkout
+	Serviceable bool `json:"serviceable" gorm:"-"`
 }
 
 // user details along with embedded token which can be used by the user to access protected routes
@@ -63,6 +66,7 @@ type AddAddress struct {
 	State     string `json:"state" validate:"required"`
 	Phone     string `json:"phone" validate:"require"`
 	Pin       string `json:"pin" validate:"required"`
+	Default   bool   `json:"default"`
 }
 
 type ChangePassword struct {
@@ -81,6 +85,16 @@ type ForgotVerify struct {
 	NewPassword string `json:"newpassword"`
 }
 
+type ForgotPasswordEmail struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPassword struct {
+	Token           string `json:"token" validate:"required"`
+	Password        string `json:"password" validate:"required"`
+	ConfirmPassword string `json:"confirm_password" validate:"required"`
+}
+
 type EditName struct {
 	Name string `json:"name"`
 }
@@ -102,6 +116,11 @@ type GetCart struct {
 	StockAvailable  int     `json:"stock"`
 	Total           float64 `json:"total_price"`
 	DiscountedPrice float64 `json:"discounted_price"`
+	// set on the lines that need a look before checking out, out of stock is also fewer left than asked for
+	PriceChanged bool `json:"price_changed,omitempty"`
+	OfferChanged bool `json:"offer_changed,omitempty"`
+	OutOfStock   bool `json:"out_of_stock,omitempty"`
+	OverLimit    bool `json:"over_limit,omitempty"`
 }
 
 type CheckOut struct {
@@ -111,6 +130,12 @@ type CheckOut struct {
 	PaymentMethods  []PaymentMethod
 	TotalPrice      float64
 	DiscountedPrice float64
+	// shipping is calculated for this address, the COD surcharge is only added when paying by cash on delivery
+	AddressID  int
+	Shipping   ShippingCharge
+	GrandTotal float64
+	// payment methods the PIN of the address does not allow are left out
+	Delivery Serviceability
 }
 
 type Search struct {
@@ -0,0 +1,7 @@
+package interfaces
+
+import "jerseyhub/pkg/utils/models"
+
+type Sender interface {
+	Send(url string, secret string, request models.WebhookRequest) (models.WebhookResult, error)
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	cfg "jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+	interfaces "jerseyhub/pkg/webhook/interface"
+)
+
+// headers every delivery carries, receivers check the signature against their copy of the secret
+const (
+	EventHeader     = "X-Jerseyhub-Event"
+	DeliveryHeader  = "X-Jerseyhub-Delivery"
+	TimestampHeader = "X-Jerseyhub-Timestamp"
+	SignatureHeader = "X-Jerseyhub-Signature"
+)
+
+// only this much of the response is kept in the delivery log
+const maxResponseBody = 2048
+
+type sender struct {
+	client *http.Client
+}
+
+func NewSender(config cfg.Config) interfaces.Sender {
+	return &sender{client: &http.Client{Timeout: time.Second * 10}}
+}
+
+// Sign is the hex HMAC-SHA256 of "timestamp.body", the timestamp is signed so an old delivery can not be replayed by someone else
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the payload once, anything other than a 2xx answer is an error
+func (s *sender) Send(url string, secret string, request models.WebhookRequest) (models.WebhookResult, error) {
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(request.Payload))
+	if err != nil {
+		return models.WebhookResult{}, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "jerseyhub-webhooks")
+	req.Header.Set(EventHeader, request.Event)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(request.DeliveryID))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, request.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return models.WebhookResult{}, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	result := models.WebhookResult{StatusCode: resp.StatusCode, Body: string(body)}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return result, fmt.Errorf("endpoint answered %d", resp.StatusCode)
+	}
+
+	return result, nil
+}
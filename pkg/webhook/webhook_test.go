@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"jerseyhub/pkg/config"
+	"jerseyhub/pkg/utils/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// receiver is a local endpoint that checks the signature the way a partner would
+type receiver struct {
+	server   *httptest.Server
+	secret   string
+	status   int
+	received []string
+	headers  http.Header
+}
+
+func newReceiver(t *testing.T, secret string, status int) *receiver {
+	r := &receiver{secret: secret, status: status}
+
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r.headers = req.Header
+		if Sign(r.secret, req.Header.Get(TimestampHeader), body) != req.Header.Get(SignatureHeader) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		r.received = append(r.received, string(body))
+		w.WriteHeader(r.status)
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+func Test_SendDelivery(t *testing.T) {
+
+	request := models.WebhookRequest{DeliveryID: 7, Event: "OrderPlaced", Payload: []byte(`{"id":1,"event":"OrderPlaced","data":{"order_id":3}}`)}
+
+	testData := map[string]struct {
+		receiverSecret string
+		status         int
+		expectedCode   int
+		expectedError  bool
+		delivered      int
+	}{
+		"signed delivery is accepted": {
+			receiverSecret: "secret",
+			status:         http.StatusOK,
+			expectedCode:   http.StatusOK,
+			delivered:      1,
+		},
+		"wrong secret fails the signature check": {
+			receiverSecret: "another secret",
+			status:         http.StatusOK,
+			expectedCode:   http.StatusUnauthorized,
+			expectedError:  true,
+		},
+		"server error is a failed delivery": {
+			receiverSecret: "secret",
+			status:         http.StatusInternalServerError,
+			expectedCode:   http.StatusInternalServerError,
+			expectedError:  true,
+			delivered:      1,
+		},
+	}
+
+	for name, test := range testData {
+		t.Run(name, func(t *testing.T) {
+			r := newReceiver(t, test.receiverSecret, test.status)
+
+			result, err := NewSender(config.Config{}).Send(r.server.URL, "secret", request)
+
+			assert.Equal(t, test.expectedError, err != nil)
+			assert.Equal(t, test.expectedCode, result.StatusCode)
+			assert.Len(t, r.received, test.delivered)
+			assert.Equal(t, "OrderPlaced", r.headers.Get(EventHeader))
+			assert.Equal(t, "7", r.headers.Get(DeliveryHeader))
+			if test.delivered > 0 {
+				assert.Equal(t, string(request.Payload), r.received[0])
+			}
+		})
+	}
+}
+
+func Test_SendUnreachableEndpoint(t *testing.T) {
+
+	r := newReceiver(t, "secret", http.StatusOK)
+	url := r.server.URL
+	r.server.Close()
+
+	_, err := NewSender(config.Config{}).Send(url, "secret", models.WebhookRequest{Event: "ping", Payload: []byte(`{}`)})
+
+	assert.Error(t, err)
+}